@@ -0,0 +1,38 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Создание таблицы schedules для запуска сценариев по расписанию(разово в run_at или по cron-выражению)
+create table if not exists schedules
+(
+    schedule_id          bigserial
+        primary key,
+    project_id           bigint                            not null,
+    scenario_id          bigint                            not null
+        constraint schedules_scenarios_fkey
+            references scenarios
+            on delete cascade,
+    title                text      default ''              not null,
+    cron                 text      default ''              not null,
+    run_at               timestamp,
+    next_run_at          timestamp,
+    last_run_at          timestamp,
+    last_run_id          bigint    default 0               not null,
+    last_message         text      default ''              not null,
+    percentage_of_target bigint    default 100             not null,
+    user_name            text      default '-'             not null,
+    preferred_user_name  text      default '-'             not null,
+    enabled              boolean   default true            not null,
+    created_at           timestamp default now()           not null,
+    updated_at           timestamp default now()           not null,
+    deleted_at           timestamp
+);
+
+create index if not exists schedules_next_run_at_idx on schedules (next_run_at) where enabled;
+
+comment on table schedules is 'Scheduled and recurring scenario runs';
+comment on column schedules.cron is 'Cron expression (5 fields), empty for a one-time run at run_at';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists schedules;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+create type stop_metric as enum ('METRIC_RT_95_P_UNSPECIFIED', 'METRIC_RT_90_P', 'METRIC_RT_99_P', 'METRIC_RT_MAX', 'METRIC_RPS', 'METRIC_FAILED', 'METRIC_FAILED_RATE');
+create type stop_operator as enum ('OPERATOR_GREATER_UNSPECIFIED', 'OPERATOR_LESS');
+
+-- Создание таблицы stop_conditions для автоматической остановки Run`а при нарушении SLO
+create table if not exists stop_conditions
+(
+    stop_condition_id    bigserial
+        primary key,
+    scenario_id          bigint                                                   not null
+        constraint stop_conditions_scenarios_fkey
+            references scenarios
+            on delete cascade,
+    script_id            bigint           default 0                               not null,
+    simple_script        boolean          default false                           not null,
+    metric               stop_metric      default 'METRIC_RT_95_P_UNSPECIFIED'    not null,
+    operator             stop_operator    default 'OPERATOR_GREATER_UNSPECIFIED'  not null,
+    threshold            double precision default 0                               not null,
+    duration_sec         bigint           default 0                               not null,
+    enabled              boolean          default true                            not null,
+    created_at           timestamp        default now()                           not null,
+    updated_at           timestamp        default now()                           not null,
+    deleted_at           timestamp
+);
+
+create index if not exists stop_conditions_scenario_id_idx on stop_conditions (scenario_id);
+
+comment on table stop_conditions is 'SLO stop conditions of the scenario, a breach stops the Run';
+comment on column stop_conditions.script_id is '0 - condition for the whole scenario, otherwise for a script(or simple script) of the scenario';
+comment on column stop_conditions.duration_sec is 'How long the threshold must be breached before the Run is stopped';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists stop_conditions;
+drop type if exists stop_metric cascade;
+drop type if exists stop_operator cascade;
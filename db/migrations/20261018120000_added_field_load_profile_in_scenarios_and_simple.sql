@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление поля "load_profile"(список стадий нагрузки) в таблицы scenarios и simple_scripts
+ALTER TABLE IF EXISTS scenarios
+    ADD COLUMN IF NOT EXISTS load_profile TEXT NOT NULL DEFAULT '[]';
+
+ALTER TABLE IF EXISTS simple_scripts
+    ADD COLUMN IF NOT EXISTS load_profile TEXT NOT NULL DEFAULT '[]';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS scenarios
+    DROP COLUMN IF EXISTS load_profile;
+
+ALTER TABLE IF EXISTS simple_scripts
+    DROP COLUMN IF EXISTS load_profile;
@@ -0,0 +1,45 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+create type capacity_search_status as enum ('STATUS_SEARCHING_UNSPECIFIED', 'STATUS_LIMIT_REACHED', 'STATUS_MAX_PERCENTAGE_REACHED', 'STATUS_INTERRUPTED');
+
+-- Создание таблицы capacity_searches для автоматического поиска предельной нагрузки(точки отказа)
+create table if not exists capacity_searches
+(
+    capacity_search_id   bigserial
+        primary key,
+    run_id               bigint                                                        not null
+        constraint capacity_searches_runs_fkey
+            references runs
+            on delete cascade,
+    scenario_id          bigint                                                        not null,
+    start_percentage     bigint                 default 10                             not null,
+    step_percentage      bigint                 default 10                             not null,
+    max_percentage       bigint                 default 300                            not null,
+    step_duration_sec    bigint                 default 300                            not null,
+    max_rt_95_p          bigint                 default 0                              not null,
+    max_failed_rate      double precision       default 0                              not null,
+    status               capacity_search_status default 'STATUS_SEARCHING_UNSPECIFIED' not null,
+    current_percentage   bigint                 default 0                              not null,
+    passed_percentage    bigint                 default 0                              not null,
+    passed_rps           bigint                 default 0                              not null,
+    step_started_at      timestamp              default now()                          not null,
+    info                 text                   default ''                             not null,
+    created_at           timestamp              default now()                          not null,
+    updated_at           timestamp              default now()                          not null,
+    deleted_at           timestamp
+);
+
+create unique index if not exists capacity_searches_run_id_idx on capacity_searches (run_id);
+
+comment on table capacity_searches is 'Automated capacity search: the load is increased step by step while the limits are met';
+comment on column capacity_searches.max_rt_95_p is 'Latency limit(rt95p, ms), 0 - not checked';
+comment on column capacity_searches.max_failed_rate is 'Error limit(percentage of failed requests), 0 - not checked';
+comment on column capacity_searches.passed_percentage is 'The highest percentage of target that passed within the limits';
+comment on column capacity_searches.passed_rps is 'RPS at the highest passing step';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists capacity_searches;
+drop type if exists capacity_search_status cascade;
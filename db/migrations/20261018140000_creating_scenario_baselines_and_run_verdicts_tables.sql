@@ -0,0 +1,56 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+create type run_verdict as enum ('VERDICT_UNSPECIFIED', 'VERDICT_PASS', 'VERDICT_FAIL');
+
+-- Создание таблицы scenario_baselines: базовый Run сценария, с которым сравниваются последующие завершенные Run`ы
+create table if not exists scenario_baselines
+(
+    scenario_baseline_id bigserial
+        primary key,
+    scenario_id          bigint                  not null
+        constraint scenario_baselines_scenarios_fkey
+            references scenarios
+            on delete cascade,
+    run_id               bigint                  not null
+        constraint scenario_baselines_runs_fkey
+            references runs
+            on delete cascade,
+    tolerances           text      default '[]'  not null,
+    created_at           timestamp default now() not null,
+    updated_at           timestamp default now() not null,
+    deleted_at           timestamp
+);
+
+create unique index if not exists scenario_baselines_scenario_id_idx on scenario_baselines (scenario_id);
+
+comment on table scenario_baselines is 'Baseline run of the scenario, later finished runs are compared against it';
+comment on column scenario_baselines.tolerances is 'JSON array of allowed metric degradations, empty - default tolerances';
+
+-- Создание таблицы run_verdicts: результат сравнения завершенного Run`а с базовым
+create table if not exists run_verdicts
+(
+    run_verdict_id       bigserial
+        primary key,
+    run_id               bigint                                    not null
+        constraint run_verdicts_runs_fkey
+            references runs
+            on delete cascade,
+    baseline_run_id      bigint                                    not null,
+    verdict              run_verdict default 'VERDICT_UNSPECIFIED' not null,
+    info                 text        default ''                    not null,
+    created_at           timestamp   default now()                 not null,
+    updated_at           timestamp   default now()                 not null,
+    deleted_at           timestamp
+);
+
+create unique index if not exists run_verdicts_run_id_idx on run_verdicts (run_id);
+
+comment on table run_verdicts is 'PASS/FAIL verdict of the finished run against the scenario baseline';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists run_verdicts;
+drop table if exists scenario_baselines;
+drop type if exists run_verdict cascade;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Аренда команд: команда захватывается через select ... for update skip locked,
+-- обработчик продлевает аренду, команду с истекшей арендой может забрать любая реплика
+alter table command
+    add column if not exists lease_owner      text      default ''  not null,
+    add column if not exists lease_expires_at timestamp,
+    add column if not exists attempts         bigint    default 0   not null;
+
+-- команды, которые обрабатывались до появления аренды, сразу доступны для перехвата
+update command set lease_expires_at = now() where status = 'STATUS_PROCESSED' and lease_expires_at is null;
+
+create index if not exists command_status_lease_expires_at_idx on command (status, lease_expires_at);
+
+comment on column command.lease_owner is 'Processor that holds the lease of the command';
+comment on column command.lease_expires_at is 'The command may be taken over by another processor after this time';
+comment on column command.attempts is 'How many times the command was claimed for processing';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop index if exists command_status_lease_expires_at_idx;
+
+alter table command
+    drop column if exists lease_owner,
+    drop column if exists lease_expires_at,
+    drop column if exists attempts;
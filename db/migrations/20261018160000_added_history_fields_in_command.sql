@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- История команд: завершенные команды больше не удаляются(кроме успешных TYPE_UPDATE),
+-- сохраняется кто создал команду, когда она начала и закончила обрабатываться
+alter table command
+    add column if not exists user_name   text default '' not null,
+    add column if not exists started_at  timestamp,
+    add column if not exists finished_at timestamp;
+
+create index if not exists command_run_id_idx on command (run_id);
+create index if not exists command_created_at_idx on command (created_at);
+
+comment on column command.user_name is 'User who initiated the command(x-identification header or the user of the run)';
+comment on column command.started_at is 'When the command was first claimed by a processor';
+comment on column command.finished_at is 'When the command got its final status(COMPLETED or FAILED)';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop index if exists command_created_at_idx;
+drop index if exists command_run_id_idx;
+
+alter table command
+    drop column if exists user_name,
+    drop column if exists started_at,
+    drop column if exists finished_at;
@@ -0,0 +1,38 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+alter type estatus add value if not exists 'STATUS_PAUSED';
+alter type cmdtype add value if not exists 'TYPE_PAUSE_SCENARIO';
+alter type cmdtype add value if not exists 'TYPE_RESUME_SCENARIO';
+
+-- Создание таблицы run_pauses: приостановки Run`а, с уровнем нагрузки скриптов на момент паузы
+create table if not exists run_pauses
+(
+    run_pause_id         bigserial
+        primary key,
+    run_id               bigint                  not null
+        constraint run_pauses_runs_fkey
+            references runs
+            on delete cascade,
+    percentage_of_target bigint    default 0     not null,
+    script_runs          text      default '[]'  not null,
+    user_name            text      default ''    not null,
+    paused_at            timestamp default now() not null,
+    resumed_at           timestamp,
+    created_at           timestamp default now() not null,
+    updated_at           timestamp default now() not null,
+    deleted_at           timestamp
+);
+
+create index if not exists run_pauses_run_id_idx on run_pauses (run_id);
+
+comment on table run_pauses is 'Pauses of the run, the script runs are restarted with the same load on resume';
+comment on column run_pauses.script_runs is 'JSON array of the script runs stopped by the pause with their RPS and agent';
+comment on column run_pauses.resumed_at is 'Empty while the run is paused';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists run_pauses;
+-- значения enum`ов estatus и cmdtype не удаляются, postgres этого не поддерживает
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление поля "max_scripts"(лимит одновременно запущенных на агенте скриптов) в таблицу agent
+ALTER TABLE IF EXISTS agent
+    ADD COLUMN IF NOT EXISTS max_scripts BIGINT NOT NULL DEFAULT 0;
+
+comment on column agent.max_scripts is 'Limit of script runs placed on the agent at the same time, 0 - AGENT_MAX_SCRIPTS from the config';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS agent
+    DROP COLUMN IF EXISTS max_scripts;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление полей состояния агента, которые заполняет AgentsTracker по результатам проверок
+ALTER TABLE IF EXISTS agent
+    ADD COLUMN IF NOT EXISTS last_seen_at  TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS failure_count BIGINT  NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS quarantined   BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_error    TEXT    NOT NULL DEFAULT '';
+
+comment on column agent.last_seen_at is 'Time of the last successful health probe';
+comment on column agent.failure_count is 'Consecutive failed health probes';
+comment on column agent.quarantined is 'The agent is excluded from script placement until a successful health probe';
+comment on column agent.last_error is 'Error of the last failed health probe';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS agent
+    DROP COLUMN IF EXISTS last_seen_at,
+    DROP COLUMN IF EXISTS failure_count,
+    DROP COLUMN IF EXISTS quarantined,
+    DROP COLUMN IF EXISTS last_error;
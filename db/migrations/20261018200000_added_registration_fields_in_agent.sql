@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление полей саморегистрации агента(RegisterAgent/Heartbeat)
+ALTER TABLE IF EXISTS agent
+    ADD COLUMN IF NOT EXISTS version           TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS self_registered   BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_heartbeat_at TIMESTAMP;
+
+comment on column agent.version is 'Agent version reported on registration and heartbeat';
+comment on column agent.self_registered is 'The agent registered itself, it expires without heartbeats';
+comment on column agent.last_heartbeat_at is 'Time of the last agent heartbeat';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS agent
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS self_registered,
+    DROP COLUMN IF EXISTS last_heartbeat_at;
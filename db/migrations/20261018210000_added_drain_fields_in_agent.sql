@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление полей вывода агента на обслуживание(DrainAgent)
+ALTER TABLE IF EXISTS agent
+    ADD COLUMN IF NOT EXISTS draining         BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS drain_migrate    BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS drain_started_at TIMESTAMP;
+
+comment on column agent.draining is 'The agent gets no new script runs and is disabled once it is empty';
+comment on column agent.drain_migrate is 'Running script runs of the draining agent are restarted on another agent with the same tag';
+comment on column agent.drain_started_at is 'Time the draining started';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS agent
+    DROP COLUMN IF EXISTS draining,
+    DROP COLUMN IF EXISTS drain_migrate,
+    DROP COLUMN IF EXISTS drain_started_at;
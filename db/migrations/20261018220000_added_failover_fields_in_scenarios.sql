@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление политики перезапуска ScriptRun`ов на другом агенте при потере агента
+ALTER TABLE IF EXISTS scenarios
+    ADD COLUMN IF NOT EXISTS failover_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS max_failovers    INTEGER NOT NULL DEFAULT 0;
+
+comment on column scenarios.failover_enabled is 'Script runs of a lost agent are restarted on another agent with the same tag';
+comment on column scenarios.max_failovers is 'Failover limit per script run, 0 - the SCRIPT_RUN_MAX_FAILOVERS config value';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS scenarios
+    DROP COLUMN IF EXISTS failover_enabled,
+    DROP COLUMN IF EXISTS max_failovers;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Создание таблицы agent_reservations: бронирование агентов тега на интервал времени под крупные запуски
+create table if not exists agent_reservations
+(
+    agent_reservation_id bigserial
+        primary key,
+    tag                  text      default ''    not null,
+    agents_count         bigint    default 0     not null,
+    user_name            text      default ''    not null,
+    scenario_id          bigint
+        constraint agent_reservations_scenarios_fkey
+            references scenarios
+            on delete cascade,
+    comment              text      default ''    not null,
+    starts_at            timestamp               not null,
+    ends_at              timestamp               not null,
+    created_at           timestamp default now() not null,
+    updated_at           timestamp default now() not null,
+    deleted_at           timestamp
+);
+
+create index if not exists agent_reservations_tag_ends_at_idx on agent_reservations (tag, ends_at);
+
+comment on table agent_reservations is 'Agents of a tag booked for a time window, runs of other users fail if the rest of the pool is too small';
+comment on column agent_reservations.agents_count is 'Number of booked agents of the tag';
+comment on column agent_reservations.scenario_id is 'Scenario the agents are booked for, its runs use the reservation regardless of the user';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists agent_reservations;
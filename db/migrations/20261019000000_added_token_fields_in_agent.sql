@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление токена агента(SetToken), токен хранится зашифрованным ключом AGENT_TOKEN_KEY
+ALTER TABLE IF EXISTS agent
+    ADD COLUMN IF NOT EXISTS token_encrypted  TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS token_rotated_at TIMESTAMP;
+
+comment on column agent.token_encrypted is 'Agent access token encrypted with AES-GCM, empty - the token is not issued';
+comment on column agent.token_rotated_at is 'Time the token was pushed to the agent';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS agent
+    DROP COLUMN IF EXISTS token_encrypted,
+    DROP COLUMN IF EXISTS token_rotated_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Добавление gRPC простых скриптов(k6 grpc): протокол, сервис, метод и набор дескрипторов
+ALTER TABLE IF EXISTS simple_scripts
+    ADD COLUMN IF NOT EXISTS protocol       TEXT NOT NULL DEFAULT 'PROTOCOL_HTTP_UNSPECIFIED',
+    ADD COLUMN IF NOT EXISTS grpc_service   TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS grpc_method    TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS descriptor_url TEXT NOT NULL DEFAULT '';
+
+comment on column simple_scripts.protocol is 'Script protocol: PROTOCOL_HTTP_UNSPECIFIED or PROTOCOL_GRPC';
+comment on column simple_scripts.grpc_service is 'Full gRPC service name (package.Service)';
+comment on column simple_scripts.grpc_method is 'gRPC method name';
+comment on column simple_scripts.descriptor_url is 'Protoset URL in S3, empty - server reflection is used';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS simple_scripts
+    DROP COLUMN IF EXISTS protocol,
+    DROP COLUMN IF EXISTS grpc_service,
+    DROP COLUMN IF EXISTS grpc_method,
+    DROP COLUMN IF EXISTS descriptor_url;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Поиск предельной нагрузки: лимит должен нарушаться breach_sustain_sec подряд, одиночный выброс шаг не завершает
+ALTER TABLE IF EXISTS capacity_searches
+    ADD COLUMN IF NOT EXISTS breach_sustain_sec BIGINT NOT NULL DEFAULT 30;
+
+comment on column capacity_searches.breach_sustain_sec is 'How long(sec) a limit must be breached continuously to fail the step; the last passing step is held for the same time before the run is stopped';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS capacity_searches
+    DROP COLUMN IF EXISTS breach_sustain_sec;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Создание таблицы script_run_migrations: захват переноса ScriptRun`а на другой агент, один перенос на запуск(pid) скрипта
+create table if not exists script_run_migrations
+(
+    script_run_migration_id bigserial
+        primary key,
+    run_id                  bigint                  not null
+        constraint script_run_migrations_runs_fkey
+            references runs
+            on delete cascade,
+    run_script_id           bigint                  not null,
+    pid                     bigint                  not null,
+    agent_host              text      default ''    not null,
+    reason                  text      default ''    not null,
+    owner                   text      default ''    not null,
+    agent_stopped           boolean   default false not null,
+    created_at              timestamp default now() not null
+);
+
+create unique index if not exists script_run_migrations_run_id_run_script_id_pid_uindex
+    on script_run_migrations (run_id, run_script_id, pid);
+
+create index if not exists script_run_migrations_agent_stopped_idx
+    on script_run_migrations (agent_host) where agent_stopped = false;
+
+comment on table script_run_migrations is 'Claims of ScriptRun migrations, only the replica that inserted the row restarts the script';
+comment on column script_run_migrations.pid is 'Pid of the script on the agent it is migrated from';
+comment on column script_run_migrations.agent_host is 'Agent (host:port) the script is migrated from';
+comment on column script_run_migrations.owner is 'Host of the replica that migrates the script';
+comment on column script_run_migrations.agent_stopped is 'The script is stopped on the old agent, otherwise it is stopped when the agent comes back';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+drop table if exists script_run_migrations;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+
+-- Накопленное число запросов(итераций) скрипт-рана, вместе с failed дает долю ошибок за Run
+ALTER TABLE IF EXISTS statistic
+    ADD COLUMN IF NOT EXISTS requests BIGINT NOT NULL DEFAULT 0;
+
+comment on column statistic.requests is 'k6 iteration counter of the script run since its start, cumulative like failed; 0 for statistics saved before the column was added';
+
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+
+ALTER TABLE IF EXISTS statistic
+    DROP COLUMN IF EXISTS requests;
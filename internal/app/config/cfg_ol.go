@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"strconv"
@@ -55,6 +56,10 @@ type Config struct {
 	LogLevel                string        `env:"LOG_LEVEL"`
 
 	SSHKey string `env:"SSH_KEY" default:"-"`
+	// known_hosts с ключами хостов агентов, без него остановка по SSH(EmergencyStop.ssh_fallback) не выполняется
+	SSHKnownHostsFile string `env:"SSH_KNOWN_HOSTS_FILE"`
+	// Таймаут остановки задач на всех агентах при EmergencyStop
+	EmergencyStopTimeout time.Duration `env:"EMERGENCY_STOP_TIMEOUT" default:"30s"`
 
 	PrometheusEndpoint string `env:"PROMETHEUS_ENDPOINT"  default:"-"`
 	DefaultHeaders     string `env:"DEFAULT_HEADERS"      default:""`
@@ -101,31 +106,89 @@ type Config struct {
 	JobCmdProcessorFrequency      time.Duration `env:"JOB_CMD_PROCESSOR_FREQUENCY"      default:"5s"`
 	JobStatisticTrackingFrequency time.Duration `env:"JOB_STATISTIC_TRACKING_FREQUENCY" default:"1.5s"`
 	JobAgentTrackingFrequency     time.Duration `env:"JOB_AGENT_TRACKING_FREQUENCY"     default:"1.5s"`
+	JobSchedulerFrequency         time.Duration `env:"JOB_SCHEDULER_FREQUENCY"          default:"30s"`
+	JobCmdCleanupFrequency        time.Duration `env:"JOB_CMD_CLEANUP_FREQUENCY"        default:"1h"`
 
 	MaksURLsInOneScriptRun           int32 `env:"MAKS_URLS_IN_ONE_SCRIPT_RUN"           default:"9"`
 	TestToProdDifferenceOrchesterLog int32 `env:"TEST_TO_PROD_DIFFERENCE_ORCHESTER_LOG" default:"10"`
 
 	CmdProcessorsCount int `env:"CMD_PROCESSORS_COUNT" default:"1"`
-	CmdChanLength      int `env:"CMD_CHANEL_LENGTH"    default:"1"`
+	// Аренда команды: обработчик продлевает ее каждые CmdLeaseDuration/3,
+	// после истечения команду может забрать любая реплика(не более CmdMaxAttempts раз)
+	CmdLeaseDuration time.Duration `env:"CMD_LEASE_DURATION" default:"1m"`
+	CmdMaxAttempts   int32         `env:"CMD_MAX_ATTEMPTS"   default:"3"`
+	// Сколько хранится история завершенных(COMPLETED/FAILED) команд
+	CmdRetention time.Duration `env:"CMD_RETENTION" default:"720h"`
+
+	// Выбор агента для запуска скрипта: least-loaded, bin-packing, spread.
+	// Агент, достигший любого из лимитов, для размещения не используется
+	AgentPlacementStrategy string `env:"AGENT_PLACEMENT_STRATEGY" default:"least-loaded"`
+	AgentMaxCPUUsed        int32  `env:"AGENT_MAX_CPU_USED"       default:"85"`
+	AgentMaxMemUsed        int32  `env:"AGENT_MAX_MEM_USED"       default:"85"`
+	AgentMaxPortsUsed      int32  `env:"AGENT_MAX_PORTS_USED"     default:"95"`
+	// 0 - без ограничения, у агента может быть задан собственный лимит(agent.max_scripts)
+	AgentMaxScripts int32 `env:"AGENT_MAX_SCRIPTS" default:"0"`
+	// Оценка прироста загрузки агента(в процентах) от скрипта, размещенного, но еще не попавшего в метрики агента
+	AgentScriptLoadEstimate int32 `env:"AGENT_SCRIPT_LOAD_ESTIMATE" default:"10"`
+
+	// Проверка агентов AgentsTracker`ом: после AgentQuarantineFailures неудачных проверок подряд
+	// агент исключается из размещения(карантин) до первой успешной проверки
+	AgentProbeTimeout       time.Duration `env:"AGENT_PROBE_TIMEOUT"       default:"3s"`
+	AgentQuarantineFailures int32         `env:"AGENT_QUARANTINE_FAILURES" default:"3"`
+
+	// Саморегистрация агентов: агент присылает Heartbeat каждые AgentHeartbeatInterval,
+	// без Heartbeat`а дольше AgentHeartbeatTTL агент исключается из размещения до следующего Heartbeat`а
+	AgentHeartbeatInterval time.Duration `env:"AGENT_HEARTBEAT_INTERVAL" default:"10s"`
+	AgentHeartbeatTTL      time.Duration `env:"AGENT_HEARTBEAT_TTL"      default:"30s"`
+	// Общий секрет регистрации: если задан, RegisterAgent/Heartbeat без него отклоняются, а новые агенты включаются сразу.
+	// Без секрета новые агенты регистрируются выключенными до включения пользователем
+	AgentRegistrationSecret string `env:"AGENT_REGISTRATION_SECRET"`
+	// Саморегистрированный агент без Heartbeat`а дольше AgentExpiredRemoveAfter удаляется
+	AgentExpiredRemoveAfter time.Duration `env:"AGENT_EXPIRED_REMOVE_AFTER" default:"24h"`
+
+	// Вызовы агентов по gRPC: таймаут и повторы(только для идемпотентных вызовов).
+	// AgentHTTPFallback - старые агенты без gRPC вызываются по HTTP(start, getStatus, getAllTasks), кроме AgentTLSEnabled
+	AgentCallTimeout  time.Duration `env:"AGENT_CALL_TIMEOUT"  default:"10s"`
+	AgentCallRetries  int           `env:"AGENT_CALL_RETRIES"  default:"2"`
+	AgentHTTPFallback bool          `env:"AGENT_HTTP_FALLBACK" default:"true"`
+	// TLS соединения с агентами, без AgentTLSCAFile используются системные сертификаты
+	AgentTLSEnabled            bool   `env:"AGENT_TLS_ENABLED"              default:"false"`
+	AgentTLSCAFile             string `env:"AGENT_TLS_CA_FILE"`
+	AgentTLSServerName         string `env:"AGENT_TLS_SERVER_NAME"`
+	AgentTLSInsecureSkipVerify bool   `env:"AGENT_TLS_INSECURE_SKIP_VERIFY" default:"false"`
+	// Токены агентов(SetToken) выдаются только при AgentTLSEnabled: AgentTokenKey - base64 ключ AES-256 для хранения
+	// токенов в БД, пустой - токены не выдаются.
+	// Токен старше AgentTokenTTL перевыпускается AgentsTracker`ом, 0 - только по RotateAgentTokens
+	AgentTokenKey string        `env:"AGENT_TOKEN_KEY"`
+	AgentTokenTTL time.Duration `env:"AGENT_TOKEN_TTL" default:"720h"`
+	// Лимит перезапусков ScriptRun`а на другом агенте для сценариев с failover_enabled без своего лимита
+	ScriptRunMaxFailovers int32 `env:"SCRIPT_RUN_MAX_FAILOVERS" default:"3"`
 
 	MakeAmmoFilesMap    int `env:"MAKE_AMMO_FILES_MAP"    default:"1000"`
 	MaxStaticAmmoLength int `env:"MAX_STATIC_AMMO_LENGTH" default:"5000"`
+	// Хосты(host или host:port), с которых загружаются файлы импорта по URL(spec_url) помимо бакета MINIO_BUCKET,
+	// пустой - только бакет
+	ImportAllowedHosts []string `env:"IMPORT_ALLOWED_HOSTS"`
 
 	SendMetricsToPrometheus bool `env:"SEND_METRICS_TO_PROM"`
 
 	MinioClient          *minio.Client          `json:"-"`
 	FileToUploadChan     chan *ammo.File        `json:"-"`
 	RunReportCollectChan chan *models.RunReport `json:"-"`
-	CommandChan          chan *models.Command   `json:"-"`
 }
 
 func new(ctx context.Context) {
 	cfg = &Config{}
+	// без .env(тесты, переменные задаются окружением) используются переменные окружения и значения по умолчанию
 	if err := godotenv.Load(); err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
-	}
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Fatalf("Error loading .env file: %v", err)
+		}
 
-	log.Printf("Successfully loaded .env file")
+		log.Printf("The .env file is not found, the environment variables are used")
+	} else {
+		log.Printf("Successfully loaded .env file")
+	}
 	// Print all environment variables for debugging
 	if err := env.Parse(cfg); err != nil {
 		log.Fatalf("Failed to parse environment variables: %v", err)
@@ -139,7 +202,6 @@ func new(ctx context.Context) {
 	cfg.Hostname = hostname
 	cfg.FileToUploadChan = make(chan *ammo.File, 20)
 	cfg.RunReportCollectChan = make(chan *models.RunReport, 20)
-	cfg.CommandChan = make(chan *models.Command, cfg.CmdChanLength)
 	cfg.MinioClient, err = InitMinioCli(ctx,
 		cfg.MinioEndpoint, cfg.MinioAccessKeyID, cfg.MinioSecretAccessKey, cfg.MinioBucket, cfg.MinioSecure)
 	if err != nil {
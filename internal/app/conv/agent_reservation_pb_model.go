@@ -0,0 +1,53 @@
+package conv
+
+import (
+	"github.com/aarondl/null/v8"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ModelToPBAgentReservation время брони хранится в БД в UTC без часового пояса
+func ModelToPBAgentReservation(mReservation *models.AgentReservation) *pb.AgentReservation {
+	if mReservation == nil {
+		return nil
+	}
+
+	return &pb.AgentReservation{
+		ReservationId: mReservation.AgentReservationID,
+		Tag:           mReservation.Tag,
+		AgentsCount:   mReservation.AgentsCount,
+		UserName:      mReservation.UserName,
+		ScenarioId:    mReservation.ScenarioID.Int32,
+		Comment:       mReservation.Comment,
+		StartsAt:      timestamppb.New(mReservation.StartsAt),
+		EndsAt:        timestamppb.New(mReservation.EndsAt),
+		CreatedAt:     timestamppb.New(mReservation.CreatedAt),
+	}
+}
+
+func ModelToPBAgentReservations(mReservations models.AgentReservationSlice) []*pb.AgentReservation {
+	reservations := make([]*pb.AgentReservation, 0, len(mReservations))
+	for _, mReservation := range mReservations {
+		reservations = append(reservations, ModelToPBAgentReservation(mReservation))
+	}
+
+	return reservations
+}
+
+func PBToModelAgentReservation(reservation *pb.AgentReservation) *models.AgentReservation {
+	mReservation := &models.AgentReservation{
+		AgentReservationID: reservation.GetReservationId(),
+		Tag:                reservation.GetTag(),
+		AgentsCount:        reservation.GetAgentsCount(),
+		UserName:           reservation.GetUserName(),
+		Comment:            reservation.GetComment(),
+		StartsAt:           reservation.GetStartsAt().AsTime().UTC(),
+		EndsAt:             reservation.GetEndsAt().AsTime().UTC(),
+	}
+	if reservation.GetScenarioId() > 0 {
+		mReservation.ScenarioID = null.Int32From(reservation.GetScenarioId())
+	}
+
+	return mReservation
+}
@@ -0,0 +1,82 @@
+package conv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ModelToPBScenarioBaseline допуски хранятся в БД как json-массив, поэтому конвертация без ModelToPb
+func ModelToPBScenarioBaseline(ctx context.Context, mBaseline *models.ScenarioBaseline) (
+	baseline *pb.ScenarioBaseline, message string) {
+	if mBaseline == nil {
+		return nil, "model scenario baseline is nil"
+	}
+
+	baseline = &pb.ScenarioBaseline{
+		ScenarioId: mBaseline.ScenarioID,
+		RunId:      mBaseline.RunID,
+		CreatedAt:  timestamppb.New(mBaseline.CreatedAt),
+		UpdatedAt:  timestamppb.New(mBaseline.UpdatedAt),
+	}
+
+	if mBaseline.Tolerances != "" {
+		if err := json.Unmarshal([]byte(mBaseline.Tolerances), &baseline.Tolerances); err != nil {
+			message = fmt.Sprintf("ScenarioBaseline tolerances is not valid json: '%v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	}
+
+	return baseline, message
+}
+
+func PBToModelScenarioBaseline(ctx context.Context, baseline *pb.ScenarioBaseline) (
+	mBaseline *models.ScenarioBaseline, message string) {
+	if baseline == nil {
+		return nil, "pb scenario baseline is nil"
+	}
+
+	tolerances := baseline.GetTolerances()
+	if tolerances == nil {
+		tolerances = []*pb.BaselineTolerance{}
+	}
+
+	tmpBytes, err := json.Marshal(tolerances)
+	if err != nil {
+		message = fmt.Sprintf("ScenarioBaseline tolerances marshal error: '%v'", err)
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return &models.ScenarioBaseline{
+		ScenarioID: baseline.GetScenarioId(),
+		RunID:      baseline.GetRunId(),
+		Tolerances: string(tmpBytes),
+	}, message
+}
+
+func ModelToPBRunVerdict(ctx context.Context, mRunVerdict *models.RunVerdict) (
+	runVerdict *pb.RunVerdict, message string) {
+	runVerdict = &pb.RunVerdict{}
+	if mRunVerdict != nil {
+		err := ModelToPb(ctx, *mRunVerdict, runVerdict)
+		if err != nil {
+			message = fmt.Sprintf("RunVerdict model to pb: '%+v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "model run verdict is nil"
+	}
+
+	return runVerdict, message
+}
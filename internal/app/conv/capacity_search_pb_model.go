@@ -0,0 +1,28 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+func ModelToPBCapacitySearch(ctx context.Context, mCapacitySearch *models.CapacitySearch) (
+	capacitySearch *pb.CapacitySearch, message string) {
+	capacitySearch = &pb.CapacitySearch{}
+	if mCapacitySearch != nil {
+		err := ModelToPb(ctx, *mCapacitySearch, capacitySearch)
+		if err != nil {
+			message = fmt.Sprintf("CapacitySearch model to pb: '%+v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "model capacity search is nil"
+	}
+
+	return capacitySearch, message
+}
@@ -25,6 +25,9 @@ func ModelToPBCmd(ctx context.Context, mCmd *models.Command) (pbCmd *pb.Command,
 	pbCmd.Status = pb.Command_Status(pb.Command_Status_value[mCmd.Status])
 	pbCmd.ErrorDescription = mCmd.ErrorDescription
 	pbCmd.PercentageOfTarget = mCmd.PercentageOfTarget.Int32
+	if mCmd.StartedAt.Valid && mCmd.FinishedAt.Valid {
+		pbCmd.DurationMs = mCmd.FinishedAt.Time.Sub(mCmd.StartedAt.Time).Milliseconds()
+	}
 	logger.Debugf(ctx, "-2--ModelToPBCmd mCmd  '%+v'", mCmd)
 	logger.Debugf(ctx, "-2--ModelToPBCmd pbCmd '%+v'", pbCmd)
 
@@ -0,0 +1,67 @@
+package conv
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+)
+
+// modelToPbLoadProfile профиль нагрузки хранится в БД как json-массив стадий, стадии - protojson
+func modelToPbLoadProfile(ctx context.Context, mLoadProfile string) (loadProfile []*pb.LoadStage, err error) {
+	if mLoadProfile == "" {
+		return loadProfile, nil
+	}
+
+	var mStages []json.RawMessage
+	if err = json.Unmarshal([]byte(mLoadProfile), &mStages); err != nil {
+		err = errors.Wrapf(err, "mLoadProfile is not valid json: '%v'", mLoadProfile)
+		logger.Errorf(ctx, err.Error())
+
+		return nil, err
+	}
+
+	loadProfile = make([]*pb.LoadStage, 0, len(mStages))
+
+	for _, mStage := range mStages {
+		stage := &pb.LoadStage{}
+		if err = unmarshalOptions.Unmarshal(mStage, stage); err != nil {
+			err = errors.Wrapf(err, "mLoadProfile stage is not valid: '%v'", string(mStage))
+			logger.Errorf(ctx, err.Error())
+
+			return nil, err
+		}
+
+		loadProfile = append(loadProfile, stage)
+	}
+
+	return loadProfile, nil
+}
+
+func pbToModelLoadProfile(ctx context.Context, loadProfile []*pb.LoadStage) (mLoadProfile string, err error) {
+	mStages := make([]json.RawMessage, 0, len(loadProfile))
+
+	for _, stage := range loadProfile {
+		mStage, er := marshalOptions.Marshal(stage)
+		if er != nil {
+			err = errors.Wrap(er, "failed to marshal load stage")
+			logger.Errorf(ctx, err.Error())
+
+			return "[]", err
+		}
+
+		mStages = append(mStages, mStage)
+	}
+
+	tmpBytes, err := json.Marshal(mStages)
+	if err != nil {
+		err = errors.Wrap(err, "failed to marshal loadProfile")
+		logger.Errorf(ctx, err.Error())
+
+		return "[]", err
+	}
+
+	return string(tmpBytes), nil
+}
@@ -0,0 +1,80 @@
+package conv
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLoadProfile(t *testing.T) {
+	loadProfile := []*pb.LoadStage{
+		{PercentageOfTarget: 50, Duration: "1m"},
+		{Rps: 200, Duration: "30s"},
+		{Duration: "10s"},
+	}
+
+	mLoadProfile, err := pbToModelLoadProfile(context.Background(), loadProfile)
+	if err != nil {
+		t.Fatalf("pbToModelLoadProfile() error = %v", err)
+	}
+
+	got, err := modelToPbLoadProfile(context.Background(), mLoadProfile)
+	if err != nil {
+		t.Fatalf("modelToPbLoadProfile() error = %v", err)
+	}
+
+	if len(got) != len(loadProfile) {
+		t.Fatalf("modelToPbLoadProfile() = %v stages, want %v", len(got), len(loadProfile))
+	}
+
+	for i := range got {
+		if !proto.Equal(got[i], loadProfile[i]) {
+			t.Errorf("modelToPbLoadProfile()[%v] = %v, want %v", i, got[i], loadProfile[i])
+		}
+	}
+}
+
+func TestModelToPbLoadProfile(t *testing.T) {
+	tests := []struct {
+		name         string
+		mLoadProfile string
+		want         []*pb.LoadStage
+		wantErr      bool
+	}{
+		{name: "empty"},
+		{name: "no stages", mLoadProfile: "[]", want: []*pb.LoadStage{}},
+		{
+			// профили, сохраненные через encoding/json, без нулевых полей
+			name:         "omitted fields",
+			mLoadProfile: `[{"percentage_of_target":50,"duration":"1m"},{"rps":200,"duration":"30s"}]`,
+			want:         []*pb.LoadStage{{PercentageOfTarget: 50, Duration: "1m"}, {Rps: 200, Duration: "30s"}},
+		},
+		{
+			name:         "json names",
+			mLoadProfile: `[{"percentageOfTarget":50,"duration":"1m"}]`,
+			want:         []*pb.LoadStage{{PercentageOfTarget: 50, Duration: "1m"}},
+		},
+		{name: "not array", mLoadProfile: `{"duration":"1m"}`, wantErr: true},
+		{name: "invalid stage", mLoadProfile: `[{"rps":"fast"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := modelToPbLoadProfile(context.Background(), tt.mLoadProfile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("modelToPbLoadProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.want) || (got == nil) != (tt.want == nil) {
+				t.Fatalf("modelToPbLoadProfile() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("modelToPbLoadProfile()[%v] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package conv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ModelToPBRunPause скрипты паузы хранятся в БД как json-массив, поэтому конвертация без ModelToPb
+func ModelToPBRunPause(ctx context.Context, mRunPause *models.RunPause) (runPause *pb.RunPause, message string) {
+	if mRunPause == nil {
+		return nil, "model run pause is nil"
+	}
+
+	runPause = &pb.RunPause{
+		RunPauseId:         mRunPause.RunPauseID,
+		RunId:              mRunPause.RunID,
+		PercentageOfTarget: mRunPause.PercentageOfTarget,
+		UserName:           mRunPause.UserName,
+		PausedAt:           timestamppb.New(mRunPause.PausedAt),
+	}
+	if mRunPause.ResumedAt.Valid {
+		runPause.ResumedAt = timestamppb.New(mRunPause.ResumedAt.Time)
+	}
+
+	if mRunPause.ScriptRuns != "" {
+		if err := json.Unmarshal([]byte(mRunPause.ScriptRuns), &runPause.ScriptRuns); err != nil {
+			message = fmt.Sprintf("RunPause script runs is not valid json: '%v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	}
+
+	return runPause, message
+}
+
+func PBToModelRunPause(ctx context.Context, runPause *pb.RunPause) (mRunPause *models.RunPause, message string) {
+	if runPause == nil {
+		return nil, "pb run pause is nil"
+	}
+
+	scriptRuns := runPause.GetScriptRuns()
+	if scriptRuns == nil {
+		scriptRuns = []*pb.PausedScriptRun{}
+	}
+
+	tmpBytes, err := json.Marshal(scriptRuns)
+	if err != nil {
+		message = fmt.Sprintf("RunPause script runs marshal error: '%v'", err)
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return &models.RunPause{
+		RunID:              runPause.GetRunId(),
+		PercentageOfTarget: runPause.GetPercentageOfTarget(),
+		ScriptRuns:         string(tmpBytes),
+		UserName:           runPause.GetUserName(),
+	}, message
+}
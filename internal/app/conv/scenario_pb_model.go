@@ -23,6 +23,11 @@ func ModelToPBScenario(ctx context.Context, mScenario *models.Scenario) (pbScena
 		logger.Errorf(ctx, "Scenario model to pb: '%v'", err)
 	}
 
+	pbScenario.LoadProfile, err = modelToPbLoadProfile(ctx, mScenario.LoadProfile)
+	if err != nil {
+		logger.Errorf(ctx, "Scenario LoadProfile model to pb: '%v'", err)
+	}
+
 	pbScenario.Selectors, err = modelToPbScenarioSelector(ctx, mScenario.Selectors)
 	if err != nil {
 		logger.Errorf(ctx, "ScenarioSelector model to pb: '%v'", err)
@@ -85,5 +90,10 @@ func PBToModelScenario(ctx context.Context, scenario *pb.Scenario) (mScenario *m
 
 	mScenario.Selectors = string(tmpSBytes)
 
+	mScenario.LoadProfile, err = pbToModelLoadProfile(ctx, scenario.LoadProfile)
+	if err != nil {
+		return mScenario, err.Error()
+	}
+
 	return mScenario, message
 }
@@ -0,0 +1,44 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+func ModelToPBSchedule(ctx context.Context, mSchedule *models.Schedule) (schedule *pb.Schedule, message string) {
+	schedule = &pb.Schedule{}
+	if mSchedule != nil {
+		err := ModelToPb(ctx, *mSchedule, schedule)
+		if err != nil {
+			message = fmt.Sprintf("Schedule model to pb: '%+v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "model schedule is nil"
+	}
+
+	return schedule, message
+}
+
+func PBScheduleToModel(ctx context.Context, schedule *pb.Schedule) (mSchedule *models.Schedule, message string) {
+	mSchedule = &models.Schedule{}
+	if schedule != nil {
+		message = PbToModel(ctx, mSchedule, schedule)
+		if message != "" {
+			message = fmt.Sprintf("Schedule pb to model: '%v'", message)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "pb schedule is nil"
+	}
+
+	return mSchedule, message
+}
@@ -58,6 +58,11 @@ func ModelToPBSimpleScript(ctx context.Context, mSimpleScript *models.SimpleScri
 			logger.Errorf(ctx, "modelToPb AdditionalEnv error: %+v -> %+v", err, mSimpleScript.AdditionalEnv)
 			protoSimpleScript.AdditionalEnv = map[string]string{}
 		}
+
+		protoSimpleScript.LoadProfile, err = modelToPbLoadProfile(ctx, mSimpleScript.LoadProfile)
+		if err != nil {
+			return protoSimpleScript, err.Error()
+		}
 	} else {
 		message = "mSimpleScript is nil"
 	}
@@ -172,5 +177,10 @@ func PbToSimpleScriptModel(ctx context.Context,
 
 	mSimpleScript.AdditionalEnv = string(tmpAdditionalEnvBytes)
 
+	mSimpleScript.LoadProfile, err = pbToModelLoadProfile(ctx, protoSimpleScript.LoadProfile)
+	if err != nil {
+		return mSimpleScript, err.Error()
+	}
+
 	return mSimpleScript, message
 }
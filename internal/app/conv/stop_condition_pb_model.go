@@ -0,0 +1,44 @@
+package conv
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+func ModelToPBStopCondition(ctx context.Context, mStopCondition *models.StopCondition) (stopCondition *pb.StopCondition, message string) {
+	stopCondition = &pb.StopCondition{}
+	if mStopCondition != nil {
+		err := ModelToPb(ctx, *mStopCondition, stopCondition)
+		if err != nil {
+			message = fmt.Sprintf("StopCondition model to pb: '%+v'", err)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "model stop condition is nil"
+	}
+
+	return stopCondition, message
+}
+
+func PBStopConditionToModel(ctx context.Context, stopCondition *pb.StopCondition) (mStopCondition *models.StopCondition, message string) {
+	mStopCondition = &models.StopCondition{}
+	if stopCondition != nil {
+		message = PbToModel(ctx, mStopCondition, stopCondition)
+		if message != "" {
+			message = fmt.Sprintf("StopCondition pb to model: '%v'", message)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+	} else {
+		return nil, "pb stop condition is nil"
+	}
+
+	return mStopCondition, message
+}
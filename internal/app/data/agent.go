@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sort"
+	"strings"
+	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
@@ -48,6 +51,9 @@ func (s *Store) SetMAgent(ctx context.Context, mAgent *models.Agent) (agentID in
 	errInsert = mAgent.Insert(ctx, s.db, boil.Blacklist(
 		models.AgentColumns.DeletedAt,
 		models.AgentColumns.AgentID,
+		// токен выдается агенту только Manager`ом(RotateToken)
+		models.AgentColumns.TokenEncrypted,
+		models.AgentColumns.TokenRotatedAt,
 	))
 	if errInsert != nil {
 		errInsert = errors.Wrap(errInsert, "Set MAgent mAgents error insert")
@@ -143,9 +149,21 @@ func (s *Store) UpdateMAgent(ctx context.Context, mAgent *models.Agent) (returnM
 	}
 
 	if mAgent != nil {
+		// состояние агента обновляет только AgentsTracker(UpdateMAgentHealth)
 		_, err = mAgent.Update(ctx, s.db, boil.Blacklist(
 			models.AgentColumns.CreatedAt,
 			models.AgentColumns.DeletedAt,
+			models.AgentColumns.LastSeenAt,
+			models.AgentColumns.FailureCount,
+			models.AgentColumns.Quarantined,
+			models.AgentColumns.LastError,
+			models.AgentColumns.SelfRegistered,
+			models.AgentColumns.LastHeartbeatAt,
+			models.AgentColumns.Draining,
+			models.AgentColumns.DrainMigrate,
+			models.AgentColumns.DrainStartedAt,
+			models.AgentColumns.TokenEncrypted,
+			models.AgentColumns.TokenRotatedAt,
 		))
 		if err != nil {
 			message := fmt.Sprintf("Error update mAgent, update to db: '%v'", err.Error())
@@ -158,6 +176,119 @@ func (s *Store) UpdateMAgent(ctx context.Context, mAgent *models.Agent) (returnM
 	return mAgent, err
 }
 
+// UpdateMAgentHealth Обновление метрик и состояния агента по результату проверки,
+// остальные свойства(enabled, tags, ...) могли измениться пользователем во время проверки
+func (s *Store) UpdateMAgentHealth(ctx context.Context, mAgent *models.Agent) (err error) {
+	_, err = mAgent.Update(ctx, s.db, boil.Whitelist(
+		models.AgentColumns.CPUUsed,
+		models.AgentColumns.MemUsed,
+		models.AgentColumns.PortsUsed,
+		models.AgentColumns.TotalLoading,
+		models.AgentColumns.LastSeenAt,
+		models.AgentColumns.FailureCount,
+		models.AgentColumns.Quarantined,
+		models.AgentColumns.LastError,
+		models.AgentColumns.UpdatedAt,
+	))
+	if err != nil {
+		return errors.Wrapf(err, "Error update health of mAgent '%v'", mAgent.HostName)
+	}
+
+	return nil
+}
+
+// GetMAgentByHost агент по адресу, nil - агент не найден
+func (s *Store) GetMAgentByHost(ctx context.Context, hostName string, port string) (mAgent *models.Agent, err error) {
+	mAgent, err = models.Agents(
+		models.AgentWhere.HostName.EQ(hostName),
+		models.AgentWhere.Port.EQ(port),
+		qm.OrderBy(models.AgentColumns.AgentID),
+	).One(ctx, s.db)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "Error select mAgent '%v:%v'", hostName, port)
+	}
+
+	return mAgent, nil
+}
+
+// UpdateMAgentRegistration Обновление свойств, которые агент сообщает сам(RegisterAgent/Heartbeat)
+func (s *Store) UpdateMAgentRegistration(ctx context.Context, mAgent *models.Agent) (err error) {
+	_, err = mAgent.Update(ctx, s.db, boil.Whitelist(
+		models.AgentColumns.Tags,
+		models.AgentColumns.Version,
+		models.AgentColumns.MaxScripts,
+		models.AgentColumns.SelfRegistered,
+		models.AgentColumns.LastHeartbeatAt,
+		models.AgentColumns.UpdatedAt,
+	))
+	if err != nil {
+		return errors.Wrapf(err, "Error update registration of mAgent '%v'", mAgent.HostName)
+	}
+
+	return nil
+}
+
+// UpdateMAgentDrain Обновление вывода агента на обслуживание(DrainAgent) и его включенности
+func (s *Store) UpdateMAgentDrain(ctx context.Context, mAgent *models.Agent) (err error) {
+	_, err = mAgent.Update(ctx, s.db, boil.Whitelist(
+		models.AgentColumns.Enabled,
+		models.AgentColumns.Draining,
+		models.AgentColumns.DrainMigrate,
+		models.AgentColumns.DrainStartedAt,
+		models.AgentColumns.UpdatedAt,
+	))
+	if err != nil {
+		return errors.Wrapf(err, "Error update draining of mAgent '%v'", mAgent.HostName)
+	}
+
+	return nil
+}
+
+// UpdateMAgentTokenIf обновление токена агента, только если токен в БД не менялся с чтения mAgent:
+// token_encrypted и token_rotated_at совпадают с mAgent. false - токен изменил другой экземпляр бэкенда.
+// Поля mAgent обновляются только при успехе
+func (s *Store) UpdateMAgentTokenIf(ctx context.Context, mAgent *models.Agent,
+	tokenEncrypted string, tokenRotatedAt null.Time) (bool, error) {
+	rowsAff, err := models.Agents(
+		models.AgentWhere.AgentID.EQ(mAgent.AgentID),
+		models.AgentWhere.TokenEncrypted.EQ(mAgent.TokenEncrypted),
+		qm.Where(models.AgentColumns.TokenRotatedAt+" is not distinct from ?", mAgent.TokenRotatedAt),
+	).UpdateAll(ctx, s.db, models.M{
+		models.AgentColumns.TokenEncrypted: tokenEncrypted,
+		models.AgentColumns.TokenRotatedAt: tokenRotatedAt,
+		models.AgentColumns.UpdatedAt:      time.Now().UTC(),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Error update token of mAgent '%v'", mAgent.HostName)
+	}
+
+	if rowsAff != 1 {
+		return false, nil
+	}
+
+	mAgent.TokenEncrypted, mAgent.TokenRotatedAt = tokenEncrypted, tokenRotatedAt
+
+	return true, nil
+}
+
+// GetMAgentsExpiredBefore саморегистрированные агенты без Heartbeat`а с before
+func (s *Store) GetMAgentsExpiredBefore(ctx context.Context, before time.Time) (mAgents []*models.Agent, err error) {
+	mAgents, err = models.Agents(
+		models.AgentWhere.SelfRegistered.EQ(true),
+		qm.Where("("+models.AgentColumns.LastHeartbeatAt+" is null or "+models.AgentColumns.LastHeartbeatAt+" < ?)",
+			before),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error select expired mAgents")
+	}
+
+	return mAgents, nil
+}
+
 func (s *Store) DeleteAgent(ctx context.Context, mAgent *models.Agent) error {
 	_, err := mAgent.Delete(ctx, s.db)
 	return err
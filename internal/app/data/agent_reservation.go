@@ -0,0 +1,133 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+)
+
+// agentReservationLockKey пространство advisory-блокировок бронирования, вторая часть ключа - хеш тега
+const agentReservationLockKey int32 = 7_002
+
+// GetMAgentReservation бронь по идентификатору, nil - бронь не найдена
+func (s *Store) GetMAgentReservation(ctx context.Context, reservationID int32) (
+	mReservation *models.AgentReservation, err error) {
+	mReservation, err = models.AgentReservations(
+		models.AgentReservationWhere.AgentReservationID.EQ(reservationID),
+	).One(ctx, s.db)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "Error fetch reservation '%v'", reservationID)
+	}
+
+	return mReservation, nil
+}
+
+func (s *Store) DeleteMAgentReservation(ctx context.Context, mReservation *models.AgentReservation) error {
+	if _, err := mReservation.Delete(ctx, s.db); err != nil {
+		return errors.Wrapf(err, "Error delete reservation '%v'", mReservation.AgentReservationID)
+	}
+
+	return nil
+}
+
+// GetMAgentReservations брони тега(пустой тег - всех тегов), activeOnly - только не закончившиеся
+func (s *Store) GetMAgentReservations(ctx context.Context, tag string, activeOnly bool) (
+	mReservations models.AgentReservationSlice, err error) {
+	mods := []qm.QueryMod{qm.OrderBy(models.AgentReservationColumns.StartsAt)}
+	if tag != "" {
+		mods = append(mods, models.AgentReservationWhere.Tag.EQ(tag))
+	}
+
+	if activeOnly {
+		mods = append(mods, models.AgentReservationWhere.EndsAt.GT(time.Now().UTC()))
+	}
+
+	mReservations, err = models.AgentReservations(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error fetch reservations of tag '%v'", tag)
+	}
+
+	return mReservations, nil
+}
+
+// GetOverlappingMAgentReservations брони тега(пустой тег - всех тегов), пересекающиеся с интервалом [from, to]
+func (s *Store) GetOverlappingMAgentReservations(ctx context.Context, tag string, from time.Time, to time.Time) (
+	mReservations models.AgentReservationSlice, err error) {
+	mods := []qm.QueryMod{
+		models.AgentReservationWhere.StartsAt.LTE(to),
+		models.AgentReservationWhere.EndsAt.GT(from),
+	}
+	if tag != "" {
+		mods = append(mods, models.AgentReservationWhere.Tag.EQ(tag))
+	}
+
+	mReservations, err = models.AgentReservations(mods...).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error fetch reservations of tag '%v' from '%v' to '%v'", tag, from, to)
+	}
+
+	return mReservations, nil
+}
+
+// CreateMAgentReservation создание брони в транзакции под advisory-блокировкой тега, поэтому
+// параллельные бронирования одного тега не превысят число агентов. check получает брони тега,
+// пересекающиеся с новой, ошибка check отменяет создание
+func (s *Store) CreateMAgentReservation(ctx context.Context, mReservation *models.AgentReservation,
+	check func(mOverlapping models.AgentReservationSlice) error) (_ *models.AgentReservation, err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error begin transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			if er := tx.Rollback(); er != nil {
+				logger.Errorf(ctx, "CreateMAgentReservation rollback error: %v", er)
+			}
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, "select pg_advisory_xact_lock($1, hashtext($2))",
+		agentReservationLockKey, mReservation.Tag); err != nil {
+		return nil, errors.Wrapf(err, "Error lock reservations of tag '%v'", mReservation.Tag)
+	}
+
+	mOverlapping, err := models.AgentReservations(
+		models.AgentReservationWhere.Tag.EQ(mReservation.Tag),
+		models.AgentReservationWhere.StartsAt.LTE(mReservation.EndsAt),
+		models.AgentReservationWhere.EndsAt.GT(mReservation.StartsAt),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error fetch reservations of tag '%v' from '%v' to '%v'",
+			mReservation.Tag, mReservation.StartsAt, mReservation.EndsAt)
+	}
+
+	if err = check(mOverlapping); err != nil {
+		return nil, err
+	}
+
+	if err = mReservation.Insert(ctx, tx, boil.Blacklist(
+		models.AgentReservationColumns.AgentReservationID,
+		models.AgentReservationColumns.CreatedAt,
+		models.AgentReservationColumns.UpdatedAt,
+		models.AgentReservationColumns.DeletedAt,
+	)); err != nil {
+		return nil, errors.Wrapf(err, "Error insert reservation of tag '%v'", mReservation.Tag)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "Error commit reservation")
+	}
+
+	return mReservation, nil
+}
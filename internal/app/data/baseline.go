@@ -0,0 +1,115 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/pkg/errors"
+)
+
+// GetMScenarioBaseline если базовый Run не задан - nil без ошибки
+func (s *Store) GetMScenarioBaseline(ctx context.Context, scenarioID int32) (
+	mBaseline *models.ScenarioBaseline, err error) {
+	mBaseline, err = models.ScenarioBaselines(
+		models.ScenarioBaselineWhere.ScenarioID.EQ(scenarioID),
+	).One(ctx, s.db)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, nil
+		}
+		err = errors.Wrapf(err, "Error fetch baseline of scenario '%v'", scenarioID)
+	}
+
+	return mBaseline, err
+}
+
+// SetMScenarioBaseline у сценария один базовый Run, повторная установка заменяет Run и допуски
+func (s *Store) SetMScenarioBaseline(ctx context.Context, mBaseline *models.ScenarioBaseline) (
+	*models.ScenarioBaseline, error) {
+	err := mBaseline.Upsert(ctx, s.db, true,
+		[]string{models.ScenarioBaselineColumns.ScenarioID},
+		boil.Whitelist(
+			models.ScenarioBaselineColumns.RunID,
+			models.ScenarioBaselineColumns.Tolerances,
+			models.ScenarioBaselineColumns.UpdatedAt,
+		),
+		boil.Blacklist(
+			models.ScenarioBaselineColumns.ScenarioBaselineID,
+			models.ScenarioBaselineColumns.DeletedAt,
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error upsert baseline of scenario '%v'", mBaseline.ScenarioID)
+	}
+
+	return mBaseline, nil
+}
+
+func (s *Store) DeleteMScenarioBaseline(ctx context.Context, scenarioID int32) (err error) {
+	_, err = models.ScenarioBaselines(
+		models.ScenarioBaselineWhere.ScenarioID.EQ(scenarioID),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		err = errors.Wrapf(err, "Error delete baseline of scenario '%v'", scenarioID)
+	}
+
+	return err
+}
+
+// GetMRunsAwaitingVerdict остановленные Run`ы сценариев с базовым Run`ом, которые еще не получили вердикт.
+// Run берется в работу не раньше, чем через settle после остановки(дособирается статистика),
+// и не позже maxAge, чтобы установка базового Run`а не запускала сравнение всей истории
+func (s *Store) GetMRunsAwaitingVerdict(ctx context.Context, settle time.Duration, maxAge time.Duration) (
+	mRuns models.RunSlice, err error) {
+	now := time.Now().UTC()
+	mRuns, err = models.Runs(
+		models.RunWhere.Status.EQ(models.EstatusSTATUS_STOPPED_UNSPECIFIED),
+		models.RunWhere.UpdatedAt.LT(now.Add(-settle)),
+		models.RunWhere.UpdatedAt.GT(now.Add(-maxAge)),
+		qm.Where("exists (select 1 from scenario_baselines b"+
+			" where b.scenario_id = runs.scenario_id and b.run_id < runs.run_id)"),
+		qm.Where("not exists (select 1 from run_verdicts v where v.run_id = runs.run_id)"),
+		qm.OrderBy(models.RunColumns.RunID),
+	).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrap(err, "Error fetch runs awaiting verdict")
+	}
+
+	return mRuns, err
+}
+
+// CreateMRunVerdict сохранение вердикта; если вердикт уже сохранен(другим подом) - запись не изменяется
+func (s *Store) CreateMRunVerdict(ctx context.Context, mRunVerdict *models.RunVerdict) (err error) {
+	err = mRunVerdict.Upsert(ctx, s.db, false,
+		[]string{models.RunVerdictColumns.RunID},
+		boil.None(),
+		boil.Blacklist(
+			models.RunVerdictColumns.RunVerdictID,
+			models.RunVerdictColumns.DeletedAt,
+		),
+	)
+	if err != nil {
+		err = errors.Wrapf(err, "Error insert verdict of run '%v'", mRunVerdict.RunID)
+	}
+
+	return err
+}
+
+// GetMRunVerdict если вердикта нет - nil без ошибки
+func (s *Store) GetMRunVerdict(ctx context.Context, runID int32) (mRunVerdict *models.RunVerdict, err error) {
+	mRunVerdict, err = models.RunVerdicts(
+		models.RunVerdictWhere.RunID.EQ(runID),
+	).One(ctx, s.db)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, nil
+		}
+		err = errors.Wrapf(err, "Error fetch verdict of run '%v'", runID)
+	}
+
+	return mRunVerdict, err
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/pkg/errors"
+)
+
+func (s *Store) CreateMCapacitySearch(ctx context.Context, mCapacitySearch *models.CapacitySearch) (
+	*models.CapacitySearch, error) {
+	err := mCapacitySearch.Insert(ctx, s.db, boil.Blacklist(
+		models.CapacitySearchColumns.CapacitySearchID,
+		models.CapacitySearchColumns.CreatedAt,
+		models.CapacitySearchColumns.UpdatedAt,
+		models.CapacitySearchColumns.DeletedAt,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "Error insert to db capacity search")
+	}
+
+	return mCapacitySearch, err
+}
+
+func (s *Store) GetMCapacitySearchByRun(ctx context.Context, runID int32) (
+	mCapacitySearch *models.CapacitySearch, err error) {
+	mCapacitySearch, err = models.CapacitySearches(
+		models.CapacitySearchWhere.RunID.EQ(runID),
+	).One(ctx, s.db)
+	if err != nil {
+		err = errors.Wrapf(err, "Error fetch capacity search of run '%v'", runID)
+	}
+
+	return mCapacitySearch, err
+}
+
+// GetSearchingMCapacitySearches получение незавершенных поисков предельной нагрузки
+func (s *Store) GetSearchingMCapacitySearches(ctx context.Context) (
+	mCapacitySearches models.CapacitySearchSlice, err error) {
+	mCapacitySearches, err = models.CapacitySearches(
+		models.CapacitySearchWhere.Status.EQ(models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED),
+	).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrap(err, "Error fetch searching capacity searches")
+	}
+
+	return mCapacitySearches, err
+}
+
+// UpdateMCapacitySearchStep сохранение перехода поиска на следующий шаг(или завершения поиска).
+// Обновление проходит только если шаг не изменился с момента выборки(current_percentage),
+// поэтому при нескольких подах шаг выполнит только один из них.
+func (s *Store) UpdateMCapacitySearchStep(ctx context.Context,
+	mCapacitySearch *models.CapacitySearch, fromPercentage int32) (claimed bool, err error) {
+	mCapacitySearch.UpdatedAt = time.Now().UTC()
+
+	rowsAff, err := models.CapacitySearches(
+		models.CapacitySearchWhere.CapacitySearchID.EQ(mCapacitySearch.CapacitySearchID),
+		models.CapacitySearchWhere.CurrentPercentage.EQ(fromPercentage),
+		models.CapacitySearchWhere.Status.EQ(models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED),
+	).UpdateAll(ctx, s.db, models.M{
+		models.CapacitySearchColumns.Status:            mCapacitySearch.Status,
+		models.CapacitySearchColumns.CurrentPercentage: mCapacitySearch.CurrentPercentage,
+		models.CapacitySearchColumns.PassedPercentage:  mCapacitySearch.PassedPercentage,
+		models.CapacitySearchColumns.PassedRPS:         mCapacitySearch.PassedRPS,
+		models.CapacitySearchColumns.StepStartedAt:     mCapacitySearch.StepStartedAt,
+		models.CapacitySearchColumns.Info:              mCapacitySearch.Info,
+		models.CapacitySearchColumns.UpdatedAt:         mCapacitySearch.UpdatedAt,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Error update capacity search '%v'", mCapacitySearch.CapacitySearchID)
+	}
+
+	return rowsAff == 1, err
+}
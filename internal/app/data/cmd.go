@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -40,6 +41,7 @@ func (s *Store) createMCommand(ctx context.Context,
 		RunID:              runID,
 		Status:             models.CmdstatusSTATUS_CREATED_UNSPECIFIED,
 		Hostname:           config.Get(ctx).Hostname,
+		UserName:           undecided.UserFromContext(ctx),
 		ScriptIds:          scriptIDs,
 		PercentageOfTarget: null.Int32From(percentageOfTarget),
 		IncreaseRPS:        increaseRPS,
@@ -73,9 +75,27 @@ func (s *Store) updateMCommand(ctx context.Context, cmd *models.Command) bool {
 		cmd.ErrorDescription = fmt.Sprintf("%v", fmt.Sprintf("%.250s...", cmd.ErrorDescription))
 	}
 
-	_, err := cmd.Update(ctx, s.db, boil.Blacklist(
-		models.CommandColumns.DeletedAt,
-	))
+	// команду обновляет только владелец аренды: если ее перехватил другой обработчик, его результат не затирается.
+	// Аренду продлевает только ExtendMCommandLease, значения в cmd могут быть устаревшими
+	cmd.UpdatedAt = null.TimeFrom(time.Now().UTC())
+	rowsAff, err := models.Commands(
+		models.CommandWhere.CommandID.EQ(cmd.CommandID),
+		models.CommandWhere.LeaseOwner.EQ(cmd.LeaseOwner),
+	).UpdateAll(ctx, s.db, models.M{
+		models.CommandColumns.Type:               cmd.Type,
+		models.CommandColumns.Scope:              cmd.Scope,
+		models.CommandColumns.RunID:              cmd.RunID,
+		models.CommandColumns.Status:             cmd.Status,
+		models.CommandColumns.ErrorDescription:   cmd.ErrorDescription,
+		models.CommandColumns.Hostname:           cmd.Hostname,
+		models.CommandColumns.UpdatedAt:          cmd.UpdatedAt,
+		models.CommandColumns.ScriptIds:          cmd.ScriptIds,
+		models.CommandColumns.PercentageOfTarget: cmd.PercentageOfTarget,
+		models.CommandColumns.IncreaseRPS:        cmd.IncreaseRPS,
+		models.CommandColumns.UserName:           cmd.UserName,
+		models.CommandColumns.StartedAt:          cmd.StartedAt,
+		models.CommandColumns.FinishedAt:         cmd.FinishedAt,
+	})
 	if err != nil {
 		logger.Errorf(ctx, "Error updateMCommand(CommandID:'%v' ERROR:'%+v')", cmd.CommandID, err)
 		cmd.ErrorDescription = "error updating the status command"
@@ -83,117 +103,144 @@ func (s *Store) updateMCommand(ctx context.Context, cmd *models.Command) bool {
 		return false
 	}
 
+	if rowsAff == 0 {
+		logger.Warnf(ctx, "updateMCommand: the lease of command '%v' is lost by '%v'", cmd.CommandID, cmd.LeaseOwner)
+
+		return false
+	}
+
 	return true
 }
 
-// GetMCommandToProcess Функция получения команды для обработки КомандПроцессором
-func (s *Store) GetMCommandToProcess(ctx context.Context, hostname string) (cmd *models.Command, status bool) {
+// commandPriorityOrder порядок обработки команд:
+// -> TYPE_STOP_SCENARIO, TYPE_PAUSE_SCENARIO, TYPE_STOP_SCRIPT, TYPE_RUN_SCRIPT, TYPE_RUN_SIMPLE_SCRIPT, TYPE_RUN_SCENARIO,
+// TYPE_RESUME_SCENARIO, TYPE_ADJUSTMENT, TYPE_INCREASE ->
+// потом все остальные(CmdtypeTYPE_UPDATE), внутри типа - по порядку создания
+var commandPriorityOrder = fmt.Sprintf("case %v"+
+	" when '%v' then 0 when '%v' then 1 when '%v' then 2 when '%v' then 3 when '%v' then 4"+
+	" when '%v' then 5 when '%v' then 6 when '%v' then 7 when '%v' then 8 else 9 end, %v",
+	models.CommandColumns.Type,
+	models.CmdtypeTYPE_STOP_SCENARIO, models.CmdtypeTYPE_PAUSE_SCENARIO, models.CmdtypeTYPE_STOP_SCRIPT,
+	models.CmdtypeTYPE_RUN_SCRIPT, models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT, models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED,
+	models.CmdtypeTYPE_RESUME_SCENARIO, models.CmdtypeTYPE_ADJUSTMENT, models.CmdtypeTYPE_INCREASE,
+	models.CommandColumns.CommandID)
+
+//	ClaimMCommand захват команды в аренду для обработки КомандПроцессором.
+//
+// Берется новая команда или команда с истекшей арендой(реплика, которая ее обрабатывала, упала;
+// команда без аренды обрабатывалась до ее появления),
+// строка блокируется через for update skip locked, поэтому одну команду не захватят две реплики.
+// Команды с истекшей арендой, исчерпавшие maxAttempts, переводятся в STATUS_FAILED.
+// Если команд нет - cmd == nil без ошибки
+func (s *Store) ClaimMCommand(ctx context.Context, owner string, lease time.Duration, maxAttempts int32) (
+	cmd *models.Command, err error) {
+	if config.Get(ctx).ENV != config.EnvInfra {
+		defer undecided.InfoTimer(ctx, "ClaimMCommand")()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error begin transaction")
+	}
+
+	committed := false
 	defer func() {
-		if err := recover(); err != nil {
-			logger.Errorf(ctx, "GetMCommandToProcess failed: '%+v'", err)
+		if !committed {
+			if er := tx.Rollback(); er != nil {
+				logger.Errorf(ctx, "ClaimMCommand rollback error: %v", er)
+			}
 		}
 	}()
 
-	if config.Get(ctx).ENV != config.EnvInfra {
-		defer undecided.InfoTimer(ctx, "GetMCommandToProcess")()
+	now := time.Now().UTC()
+
+	_, err = tx.ExecContext(ctx,
+		"update command set status = $1, updated_at = $2, finished_at = $2,"+
+			" error_description = trim(both '; ' from error_description || '; ' || $3)"+
+			" where status = $4 and (lease_expires_at is null or lease_expires_at < $2) and attempts >= $5",
+		models.CmdstatusSTATUS_FAILED, now,
+		fmt.Sprintf("the lease has expired, attempts exhausted(%v)", maxAttempts),
+		models.CmdstatusSTATUS_PROCESSED, maxAttempts,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error fail exhausted commands")
 	}
 
-	cmd, err := s.getPriorityMCommand(ctx, hostname)
+	cmd, err = models.Commands(
+		qm.Where("(status = ? or (status = ? and (lease_expires_at is null or lease_expires_at < ?)))",
+			models.CmdstatusSTATUS_CREATED_UNSPECIFIED, models.CmdstatusSTATUS_PROCESSED, now),
+		qm.OrderBy(commandPriorityOrder),
+		qm.For("update skip locked"),
+	).One(ctx, tx)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows in result set") {
 			logger.Infof(ctx, "Select 'Command' no rows in result set")
-		} else {
-			logger.Errorf(ctx, "Select 'Command' ERROR:'%+v'", err)
+			committed = true
+
+			return nil, tx.Commit()
 		}
 
-		return cmd, false
+		return nil, errors.Wrap(err, "Error select command to process")
 	}
 
-	logger.Infof(ctx, "Command returning '%v', '%v', '%+v', ", cmd.Type, cmd.CommandID, cmd)
-
-	return cmd, true
-}
-
-//	getPriorityMCommand Проверяем какие команды нужно запросить в порядке приоритета
-//
-// -> TYPE_STOP_SCENARIO, TYPE_STOP_SCRIPT, TYPE_RUN_SCRIPT, TYPE_RUN_SIMPLE_SCRIPT, TYPE_RUN_SCENARIO, TYPE_ADJUSTMENT, TYPE_INCREASE ->
-// потом все остальные(CmdtypeTYPE_UPDATE)
-func (s *Store) getPriorityMCommand(ctx context.Context, hostname string) (cmd *models.Command, err error) {
-	qms := append([]qm.QueryMod{},
-		models.CommandWhere.Status.EQ(models.CmdstatusSTATUS_CREATED_UNSPECIFIED),
-	)
+	if cmd.Status == models.CmdstatusSTATUS_PROCESSED {
+		logger.Warnf(ctx, "Taking over the command '%v' with the expired lease of '%v'", cmd.CommandID, cmd.LeaseOwner)
+	}
 
-	// в infra всегда один под, по этому не ограничивать выборку по хосту
-	if config.Get(ctx).ENV != config.EnvInfra {
-		qms = append(qms,
-			models.CommandWhere.Hostname.EQ(hostname),
-		)
+	leaseMCommand(cmd, owner, lease, now)
+
+	if _, err = cmd.Update(ctx, tx, boil.Whitelist(
+		models.CommandColumns.Status,
+		models.CommandColumns.LeaseOwner,
+		models.CommandColumns.LeaseExpiresAt,
+		models.CommandColumns.Attempts,
+		models.CommandColumns.StartedAt,
+		models.CommandColumns.UpdatedAt,
+	)); err != nil {
+		return nil, errors.Wrapf(err, "Error claim command '%v'", cmd.CommandID)
 	}
 
-	var exists bool
-	// sequential search for commands by priority
-	if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_STOP_SCENARIO, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_STOP_SCENARIO)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_STOP_SCENARIO),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_STOP_SCRIPT, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_STOP_SCRIPT)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_STOP_SCRIPT),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_RUN_SCRIPT, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_RUN_SCRIPT)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_RUN_SCRIPT),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_ADJUSTMENT, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_ADJUSTMENT)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_ADJUSTMENT),
-		)
-	} else if exists, err = s.checkingForThePresenceOfACommandByTheType(ctx,
-		models.CmdtypeTYPE_INCREASE, &qms); exists {
-		logger.Infof(ctx, "Exists command type: '%v'", models.CmdtypeTYPE_INCREASE)
-		qms = append(qms,
-			models.CommandWhere.Type.EQ(models.CmdtypeTYPE_INCREASE),
-		)
-	} else {
-		logger.Info(ctx, "__--__--No priority commands found--__--__")
+	committed = true
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "Error commit claim of command '%v'", cmd.CommandID)
 	}
 
-	if err != nil {
-		logger.Errorf(ctx, "GetMCommandToProcess 'exists error'='%v'", err)
+	logger.Infof(ctx, "Command claimed '%v', '%v', '%+v', ", cmd.Type, cmd.CommandID, cmd)
+
+	return cmd, nil
+}
+
+// leaseMCommand выдача команды в аренду owner`у, каждая выдача(в т.ч. перехват истекшей аренды) - попытка
+func leaseMCommand(cmd *models.Command, owner string, lease time.Duration, now time.Time) {
+	cmd.Status = models.CmdstatusSTATUS_PROCESSED
+	cmd.LeaseOwner = owner
+	cmd.LeaseExpiresAt = null.TimeFrom(now.Add(lease))
+	cmd.Attempts++
+	cmd.UpdatedAt = null.TimeFrom(now)
+	if !cmd.StartedAt.Valid {
+		cmd.StartedAt = null.TimeFrom(now)
 	}
+}
 
-	cmd, err = models.Commands(append(qms, qm.OrderBy(models.CommandColumns.CommandID))...).One(ctx, s.db)
-	if cmd != nil {
-		s.UpdateStatusMCommand(ctx, cmd, models.CmdstatusSTATUS_PROCESSED, "")
+// ExtendMCommandLease продление аренды команды, false - аренда потеряна(команду забрал другой обработчик)
+func (s *Store) ExtendMCommandLease(ctx context.Context, cmd *models.Command, lease time.Duration) (bool, error) {
+	rowsAff, err := models.Commands(
+		models.CommandWhere.CommandID.EQ(cmd.CommandID),
+		models.CommandWhere.Status.EQ(models.CmdstatusSTATUS_PROCESSED),
+		models.CommandWhere.LeaseOwner.EQ(cmd.LeaseOwner),
+	).UpdateAll(ctx, s.db, models.M{
+		models.CommandColumns.LeaseExpiresAt: time.Now().UTC().Add(lease),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Error extend lease of command '%v'", cmd.CommandID)
 	}
 
-	return cmd, err
+	return rowsAff == 1, nil
 }
 
-func (s *Store) CountNewCmd(ctx context.Context, hostname string) (int64, error) {
+func (s *Store) CountNewCmd(ctx context.Context) (int64, error) {
 	countNewCmd, er := models.Commands(
 		models.CommandWhere.Status.EQ(models.CmdstatusSTATUS_CREATED_UNSPECIFIED),
-		models.CommandWhere.Hostname.EQ(hostname),
 	).Count(ctx, s.db)
 
 	return countNewCmd, er
@@ -240,15 +287,47 @@ func (s *Store) UpdateStatusMCommand(
 	}
 
 	cmd.Status = status
+	if status == models.CmdstatusSTATUS_COMPLETED || status == models.CmdstatusSTATUS_FAILED {
+		cmd.FinishedAt = null.TimeFrom(time.Now().UTC())
+	}
 
 	return s.updateMCommand(context.WithoutCancel(ctx), cmd)
 }
 
-func (s *Store) DeleteMCmd(ctx context.Context, cmd *models.Command) bool {
+//	FinishMCmd завершение обработки команды.
+//
+// Команда остается в истории(ListCommands) с финальным статусом, удаляются только успешные TYPE_UPDATE:
+// они создаются заново на каждый цикл наблюдения за Run`ом и историю не несут
+func (s *Store) FinishMCmd(ctx context.Context, cmd *models.Command) bool {
+	if cmd.Type != models.CmdtypeTYPE_UPDATE {
+		return true
+	}
+
+	return s.deleteMCmd(ctx, cmd)
+}
+
+// DeleteFinishedMCommands удаление истории команд, завершенных(COMPLETED/FAILED) раньше before
+func (s *Store) DeleteFinishedMCommands(ctx context.Context, before time.Time) (int64, error) {
+	rowsAff, err := models.Commands(
+		models.CommandWhere.Status.IN([]string{models.CmdstatusSTATUS_COMPLETED, models.CmdstatusSTATUS_FAILED}),
+		models.CommandWhere.FinishedAt.LT(null.TimeFrom(before)),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error delete finished commands")
+	}
+
+	return rowsAff, nil
+}
+
+func (s *Store) deleteMCmd(ctx context.Context, cmd *models.Command) bool {
 	if cmd.Status == models.CmdstatusSTATUS_COMPLETED && cmd.ErrorDescription == "" {
-		_, err := cmd.Delete(ctx, s.db)
+		// команду, перехваченную другим обработчиком, удалять нельзя
+		_, err := models.Commands(
+			models.CommandWhere.CommandID.EQ(cmd.CommandID),
+			models.CommandWhere.LeaseOwner.EQ(cmd.LeaseOwner),
+		).DeleteAll(ctx, s.db)
 		if err != nil {
-			logger.Warnf(ctx, "DeleteMCmd: RunID:'%v' error:'%+v'", cmd.RunID, err)
+			logger.Warnf(ctx, "deleteMCmd: RunID:'%v' error:'%+v'", cmd.RunID, err)
 			cmd.ErrorDescription = err.Error()
 
 			return false
@@ -296,23 +375,15 @@ func (s *Store) StopObservingForStatusRun(ctx context.Context, runID int32) bool
 			"The redundant update command will be deleted. CommandID(%v), RunID(%v), Status(%v), ErrorDescription(%v)",
 			cmd.CommandID, cmd.RunID, cmd.Status, cmd.ErrorDescription)
 
-		return s.DeleteMCmd(ctx, cmd)
+		// команда еще не обрабатывалась, в истории она не нужна
+		cmd.Status = models.CmdstatusSTATUS_COMPLETED
+
+		return s.deleteMCmd(ctx, cmd)
 	}
 
 	return true
 }
 
-//	checkingForThePresenceOfACommandByTheType Метод для проверки наличия команды по типу команды
-//
-// qms - дополняет указанным типом массив QueryMod,
-// используется для ограничения выполнения запроса, на получение найденной команды
-func (s *Store) checkingForThePresenceOfACommandByTheType(ctx context.Context, cmdType string, qms *[]qm.QueryMod) (
-	bool, error) {
-	return models.Commands(
-		append(*qms, models.CommandWhere.Type.EQ(cmdType))...,
-	).Exists(ctx, s.db)
-}
-
 //	checkingForThePresenceOfACommandByTheRunID Метод для проверки наличия команды по RunID
 //
 // qms - дополняет указанным типом массив QueryMod,
@@ -340,6 +411,18 @@ func (s *Store) NewMCmdStopScenario(ctx context.Context, runID int32) (
 		models.CmdscopeSCOPE_ALL_UNSPECIFIED, runID, nil, 0, -1)
 }
 
+func (s *Store) NewMCmdPauseScenario(ctx context.Context, runID int32) (
+	pbCommand *models.Command, err error) {
+	return s.createMCommand(ctx, models.CmdtypeTYPE_PAUSE_SCENARIO,
+		models.CmdscopeSCOPE_ALL_UNSPECIFIED, runID, nil, 0, -1)
+}
+
+func (s *Store) NewMCmdResumeScenario(ctx context.Context, runID int32) (
+	pbCommand *models.Command, err error) {
+	return s.createMCommand(ctx, models.CmdtypeTYPE_RESUME_SCENARIO,
+		models.CmdscopeSCOPE_ALL_UNSPECIFIED, runID, nil, 0, -1)
+}
+
 func (s *Store) NewMCmdRunScenarioWithRpsAdjustment(ctx context.Context, runID int32, percentageOfTarget int32) (
 	pbCommand *models.Command, err error) {
 	return s.createMCommand(ctx, models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED,
@@ -388,3 +471,55 @@ func (s *Store) GetRunCommand(ctx context.Context, runID int32) (returnRun *mode
 
 	return mRun.Commands(qm.OrderBy(fmt.Sprint(models.CommandColumns.CommandID, " desc"))).One(ctx, s.db)
 }
+
+// CommandsFilter фильтр истории команд, пустые поля не ограничивают выборку
+type CommandsFilter struct {
+	RunID       int32
+	Types       []string
+	Statuses    []string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// GetMCommandsPaging история команд, новые сверху
+func (s *Store) GetMCommandsPaging(ctx context.Context, filter CommandsFilter, limit int32, offset int32) (
+	mCommands models.CommandSlice, totalPages int64, err error) {
+	var qMods []qm.QueryMod
+	if filter.RunID != 0 {
+		qMods = append(qMods, models.CommandWhere.RunID.EQ(filter.RunID))
+	}
+
+	if len(filter.Types) > 0 {
+		qMods = append(qMods, models.CommandWhere.Type.IN(filter.Types))
+	}
+
+	if len(filter.Statuses) > 0 {
+		qMods = append(qMods, models.CommandWhere.Status.IN(filter.Statuses))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		qMods = append(qMods, models.CommandWhere.CreatedAt.GTE(filter.CreatedFrom))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		qMods = append(qMods, models.CommandWhere.CreatedAt.LT(filter.CreatedTo))
+	}
+
+	numberLines, err := models.Commands(qMods...).Count(ctx, s.db)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error counting commands")
+	}
+
+	qMods = append(qMods,
+		qm.Limit(int(limit)),
+		qm.Offset(int(offset)),
+		qm.OrderBy(fmt.Sprint(models.CommandColumns.CommandID, " desc")),
+	)
+
+	mCommands, err = models.Commands(qMods...).All(ctx, s.db)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Error fetch commands")
+	}
+
+	return mCommands, CalculateTotalPages(numberLines, limit), nil
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/null/v8"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestLeaseMCommand(t *testing.T) {
+	now := time.Now().UTC()
+	lease := time.Minute
+
+	t.Run("new command", func(t *testing.T) {
+		cmd := &models.Command{Status: models.CmdstatusSTATUS_CREATED_UNSPECIFIED}
+
+		leaseMCommand(cmd, "replica-1", lease, now)
+
+		if cmd.Status != models.CmdstatusSTATUS_PROCESSED || cmd.LeaseOwner != "replica-1" || cmd.Attempts != 1 {
+			t.Errorf("leaseMCommand() = %+v", cmd)
+		}
+
+		if !cmd.LeaseExpiresAt.Time.Equal(now.Add(lease)) || !cmd.StartedAt.Time.Equal(now) {
+			t.Errorf("leaseMCommand() lease_expires_at = %v, started_at = %v", cmd.LeaseExpiresAt, cmd.StartedAt)
+		}
+	})
+
+	t.Run("expired lease is taken over", func(t *testing.T) {
+		startedAt := now.Add(-10 * time.Minute)
+		cmd := &models.Command{
+			Status:         models.CmdstatusSTATUS_PROCESSED,
+			LeaseOwner:     "replica-1",
+			LeaseExpiresAt: null.TimeFrom(now.Add(-time.Second)),
+			Attempts:       1,
+			StartedAt:      null.TimeFrom(startedAt),
+		}
+
+		leaseMCommand(cmd, "replica-2", lease, now)
+
+		if cmd.LeaseOwner != "replica-2" || cmd.Attempts != 2 {
+			t.Errorf("leaseMCommand() owner = %v, attempts = %v", cmd.LeaseOwner, cmd.Attempts)
+		}
+
+		// время начала обработки остается от первой попытки
+		if !cmd.StartedAt.Time.Equal(startedAt) {
+			t.Errorf("leaseMCommand() started_at = %v, want %v", cmd.StartedAt.Time, startedAt)
+		}
+	})
+}
+
+func TestCommandPriorityOrder(t *testing.T) {
+	ordered := []string{
+		models.CmdtypeTYPE_STOP_SCENARIO,
+		models.CmdtypeTYPE_PAUSE_SCENARIO,
+		models.CmdtypeTYPE_STOP_SCRIPT,
+		models.CmdtypeTYPE_RUN_SCRIPT,
+		models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT,
+		models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED,
+		models.CmdtypeTYPE_RESUME_SCENARIO,
+		models.CmdtypeTYPE_ADJUSTMENT,
+		models.CmdtypeTYPE_INCREASE,
+	}
+
+	previous := -1
+	for _, cmdType := range ordered {
+		i := strings.Index(commandPriorityOrder, "'"+cmdType+"'")
+		if i <= previous {
+			t.Fatalf("commandPriorityOrder: '%v' is out of order in %v", cmdType, commandPriorityOrder)
+		}
+		previous = i
+	}
+
+	if strings.Contains(commandPriorityOrder, "'"+models.CmdtypeTYPE_UPDATE+"'") {
+		t.Errorf("commandPriorityOrder: TYPE_UPDATE must be processed after all other commands")
+	}
+
+	if !strings.HasSuffix(commandPriorityOrder, "end, "+models.CommandColumns.CommandID) {
+		t.Errorf("commandPriorityOrder: commands of one type must be ordered by creation: %v", commandPriorityOrder)
+	}
+}
+
+func TestUpdateStatusMCommand(t *testing.T) {
+	tests := []struct {
+		name        string
+		rowsAff     int64
+		wantUpdated bool
+	}{
+		{name: "lease owner", rowsAff: 1, wantUpdated: true},
+		// аренда истекла и команду перехватил другой обработчик
+		{name: "lease is lost", rowsAff: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			args := make([]driver.Value, 0, 15)
+			for range 13 {
+				args = append(args, sqlmock.AnyArg())
+			}
+
+			mock.ExpectExec(`UPDATE "command" SET .* WHERE \("command"\."command_id" = \$\d+\) ` +
+				`AND \("command"\."lease_owner" = \$\d+\)`).
+				WithArgs(append(args, 7, "replica-1")...).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAff))
+
+			cmd := &models.Command{CommandID: 7, Status: models.CmdstatusSTATUS_PROCESSED, LeaseOwner: "replica-1"}
+			s := NewStore(sqlx.NewDb(db, "postgres"))
+
+			updated := s.UpdateStatusMCommand(context.Background(), cmd, models.CmdstatusSTATUS_COMPLETED, "")
+			if updated != tt.wantUpdated {
+				t.Errorf("UpdateStatusMCommand() = %v, want %v", updated, tt.wantUpdated)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Leader лидерство реплики в фоновой задаче через сессионный advisory lock Postgres.
+// Блокировка держится, пока жива сессия conn: упавшая реплика теряет лидерство вместе с соединением
+type Leader struct {
+	store *Store
+	key   int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// NewLeader key - идентификатор advisory lock задачи, у каждой задачи свой
+func (s *Store) NewLeader(key int64) *Leader {
+	return &Leader{store: s, key: key}
+}
+
+// Acquire захват или проверка лидерства, вызывается перед каждым циклом задачи.
+// false без ошибки - лидер другая реплика
+func (l *Leader) Acquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+
+		// сессия потеряна вместе с блокировкой
+		_ = l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.store.db.Conn(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "Error get connection for leader lock")
+	}
+
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "select pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		_ = conn.Close()
+
+		return false, errors.Wrapf(err, "Error try leader lock '%v'", l.key)
+	}
+
+	if !locked {
+		_ = conn.Close()
+
+		return false, nil
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -14,6 +15,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+const maxRunInfoLength = 4096
+
 func (s *Store) GetAllMRunningFromDB(
 	ctx context.Context,
 	projectID int32,
@@ -64,7 +67,7 @@ func (s *Store) GetAllMRunningFromDB(
 	return mRuns, totalPages, err
 }
 
-// GetCountActiveMRunning Функция подсчета запущенных или запускаемых ранов по условиям:
+// GetCountActiveMRunning Функция подсчета запущенных(в т.ч. приостановленных) или запускаемых ранов по условиям:
 // если задан projectID - ищем по projectID, иначе
 // если задан scenarioID - ищем по scenarioID, иначе
 // ищем общее кол-во запущенных ранов
@@ -73,7 +76,7 @@ func (s *Store) GetCountActiveMRunning(ctx context.Context, projectID int32, sce
 	if projectID != 0 {
 		countMRunning, errRunning := models.Runs(
 			models.RunWhere.ProjectID.EQ(projectID),
-			models.RunWhere.Status.EQ(models.EstatusSTATUS_RUNNING),
+			models.RunWhere.Status.IN([]string{models.EstatusSTATUS_RUNNING, models.EstatusSTATUS_PAUSED}),
 		).Count(ctx, s.db)
 		countMPrepared, errPrepared := models.Runs(
 			models.RunWhere.ProjectID.EQ(projectID),
@@ -88,7 +91,7 @@ func (s *Store) GetCountActiveMRunning(ctx context.Context, projectID int32, sce
 	} else if scenarioID != 0 {
 		countMRunning, errRunning := models.Runs(
 			models.RunWhere.ScenarioID.EQ(scenarioID),
-			models.RunWhere.Status.EQ(models.EstatusSTATUS_RUNNING),
+			models.RunWhere.Status.IN([]string{models.EstatusSTATUS_RUNNING, models.EstatusSTATUS_PAUSED}),
 		).Count(ctx, s.db)
 		countMPrepared, errPrepared := models.Runs(
 			models.RunWhere.ScenarioID.EQ(scenarioID),
@@ -102,7 +105,7 @@ func (s *Store) GetCountActiveMRunning(ctx context.Context, projectID int32, sce
 		}
 	} else {
 		countMRunning, errRunning := models.Runs(
-			models.RunWhere.Status.EQ(models.EstatusSTATUS_RUNNING),
+			models.RunWhere.Status.IN([]string{models.EstatusSTATUS_RUNNING, models.EstatusSTATUS_PAUSED}),
 		).Count(ctx, s.db)
 		countMPrepared, errPrepared := models.Runs(
 			models.RunWhere.Status.EQ(models.EstatusSTATUS_PREPARED),
@@ -118,15 +121,36 @@ func (s *Store) GetCountActiveMRunning(ctx context.Context, projectID int32, sce
 	return countMRuns, err
 }
 
+// GetActiveMRuns раны, скрипты которых могут выполняться на агентах
+func (s *Store) GetActiveMRuns(ctx context.Context) (mRuns []*models.Run, err error) {
+	mRuns, err = models.Runs(
+		models.RunWhere.Status.IN([]string{
+			models.EstatusSTATUS_PREPARED, models.EstatusSTATUS_RUNNING, models.EstatusSTATUS_STOPPING,
+		}),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error fetch active runs")
+	}
+
+	return mRuns, nil
+}
+
+// GetPausedMRuns раны на паузе: их скрипты остановлены, но Run не завершен
+func (s *Store) GetPausedMRuns(ctx context.Context) (mRuns []*models.Run, err error) {
+	mRuns, err = models.Runs(
+		models.RunWhere.Status.EQ(models.EstatusSTATUS_PAUSED),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error fetch paused runs")
+	}
+
+	return mRuns, nil
+}
+
 func (s *Store) UpdateMRunningInTheDB(ctx context.Context, mRun *models.Run) (
 	returnMRun *models.Run, err error) {
 	if mRun != nil {
-		if mRun.Info != "" { //fixme: не делать логики в слое БД!!!!
-			percent := 0.2
-			length := len(mRun.Info)
-			twentyPercentLength := int(float64(length) * percent)
-			mRun.Info = mRun.Info[length-twentyPercentLength:]
-		}
+		mRun.Info = trimRunInfo(mRun.Info) //fixme: не делать логики в слое БД!!!!
 		_, err = mRun.Update(ctx, s.db, boil.Blacklist(
 			models.RunColumns.CreatedAt,
 			models.RunColumns.DeletedAt,
@@ -142,6 +166,36 @@ func (s *Store) UpdateMRunningInTheDB(ctx context.Context, mRun *models.Run) (
 	return mRun, err
 }
 
+// AppendMRunInfo добавление сообщения в Info Run`а, остальные поля не перезаписываются
+func (s *Store) AppendMRunInfo(ctx context.Context, runID int32, info string) (err error) {
+	mRun, err := s.GetMRunning(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	mRun.Info = trimRunInfo(fmt.Sprintf("%s %s; ", mRun.Info, info))
+	if _, err = mRun.Update(ctx, s.db, boil.Whitelist(models.RunColumns.Info)); err != nil {
+		err = errors.Wrapf(err, "Error append info to run '%v'", runID)
+		logger.Errorf(ctx, err.Error())
+	}
+
+	return err
+}
+
+// trimRunInfo Info ограничивается последними maxRunInfoLength символами, чтобы поле не росло бесконечно
+func trimRunInfo(info string) string {
+	if len(info) > maxRunInfoLength {
+		start := len(info) - maxRunInfoLength
+		for start < len(info) && !utf8.RuneStart(info[start]) {
+			start++
+		}
+
+		return info[start:]
+	}
+
+	return info
+}
+
 func (s *Store) GetMRunning(ctx context.Context, runID int32) (returnRun *models.Run, err error) {
 	mRun, err := models.Runs(
 
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+)
+
+func (s *Store) CreateMRunPause(ctx context.Context, mRunPause *models.RunPause) (*models.RunPause, error) {
+	err := mRunPause.Insert(ctx, s.db, boil.Blacklist(
+		models.RunPauseColumns.RunPauseID,
+		models.RunPauseColumns.PausedAt,
+		models.RunPauseColumns.ResumedAt,
+		models.RunPauseColumns.CreatedAt,
+		models.RunPauseColumns.UpdatedAt,
+		models.RunPauseColumns.DeletedAt,
+	))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error insert pause of run '%v'", mRunPause.RunID)
+	}
+
+	return mRunPause, err
+}
+
+// GetMActiveRunPause последняя не завершенная пауза Run`а, если Run не на паузе - nil без ошибки
+func (s *Store) GetMActiveRunPause(ctx context.Context, runID int32) (mRunPause *models.RunPause, err error) {
+	mRunPause, err = models.RunPauses(
+		models.RunPauseWhere.RunID.EQ(runID),
+		models.RunPauseWhere.ResumedAt.IsNull(),
+		qm.OrderBy(models.RunPauseColumns.RunPauseID+" desc"),
+	).One(ctx, s.db)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, nil
+		}
+		err = errors.Wrapf(err, "Error fetch active pause of run '%v'", runID)
+	}
+
+	return mRunPause, err
+}
+
+// ResumeMRunPause завершение паузы. Обновление проходит только для незавершенной паузы,
+// поэтому продолжить Run по одной паузе можно только один раз
+func (s *Store) ResumeMRunPause(ctx context.Context, mRunPause *models.RunPause) (claimed bool, err error) {
+	now := time.Now().UTC()
+
+	rowsAff, err := models.RunPauses(
+		models.RunPauseWhere.RunPauseID.EQ(mRunPause.RunPauseID),
+		models.RunPauseWhere.ResumedAt.IsNull(),
+	).UpdateAll(ctx, s.db, models.M{
+		models.RunPauseColumns.ResumedAt: now,
+		models.RunPauseColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Error resume pause '%v' of run '%v'", mRunPause.RunPauseID, mRunPause.RunID)
+	}
+
+	if rowsAff > 0 {
+		mRunPause.ResumedAt.SetValid(now)
+		mRunPause.UpdatedAt = now
+	}
+
+	return rowsAff > 0, nil
+}
+
+// CloseMActiveRunPause завершение незакрытой паузы остановленного Run`а: продолжить остановленный Run нельзя
+func (s *Store) CloseMActiveRunPause(ctx context.Context, runID int32) error {
+	mRunPause, err := s.GetMActiveRunPause(ctx, runID)
+	if err != nil || mRunPause == nil {
+		return err
+	}
+
+	if _, err = s.ResumeMRunPause(ctx, mRunPause); err != nil {
+		return err
+	}
+
+	logger.Infof(ctx, "The pause '%v' of stopped run '%v' is closed", mRunPause.RunPauseID, runID)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+)
+
+// GetAllMSchedules получение расписаний, если scenarioID == 0 возвращаются расписания всех сценариев
+func (s *Store) GetAllMSchedules(ctx context.Context, scenarioID int32, limit int32, offset int32) (
+	mSchedules []*models.Schedule, totalPages int64, err error) {
+	var qMods []qm.QueryMod
+	if scenarioID != 0 {
+		qMods = append(qMods, models.ScheduleWhere.ScenarioID.EQ(scenarioID))
+	}
+
+	numberLines, err := models.Schedules(qMods...).Count(ctx, s.db)
+	if err != nil {
+		err = errors.Wrapf(err, "Error counting schedules")
+		logger.Error(ctx, "Error select count Schedules: ", err)
+	}
+
+	qMods = append(qMods,
+		qm.Limit(int(limit)),
+		qm.Offset(int(offset)),
+		qm.OrderBy("schedule_id DESC"),
+	)
+
+	mSchedules, err = models.Schedules(qMods...).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrap(err, "Error fetch schedules")
+		logger.Errorf(ctx, "Error select schedules: %v", err)
+	}
+
+	totalPages = CalculateTotalPages(numberLines, limit)
+
+	return mSchedules, totalPages, err
+}
+
+func (s *Store) GetCountSchedules(ctx context.Context, scenarioID int32) (countSchedules int64, err error) {
+	var qMods []qm.QueryMod
+	if scenarioID != 0 {
+		qMods = append(qMods, models.ScheduleWhere.ScenarioID.EQ(scenarioID))
+	}
+
+	return models.Schedules(qMods...).Count(ctx, s.db)
+}
+
+func (s *Store) GetMSchedule(ctx context.Context, scheduleID int32) (mSchedule *models.Schedule, err error) {
+	mSchedule, err = models.FindSchedule(ctx, s.db, scheduleID)
+	if err != nil {
+		err = errors.Wrapf(err, "Error get mSchedule '%v'", scheduleID)
+		logger.Warnf(ctx, err.Error())
+
+		return nil, err
+	}
+
+	return mSchedule, err
+}
+
+func (s *Store) CreateMSchedule(ctx context.Context, mSchedule *models.Schedule) (*models.Schedule, error) {
+	err := mSchedule.Insert(ctx, s.db, boil.Blacklist(
+		models.ScheduleColumns.ScheduleID,
+		models.ScheduleColumns.CreatedAt,
+		models.ScheduleColumns.UpdatedAt,
+		models.ScheduleColumns.DeletedAt,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "Error insert to db schedule")
+	}
+
+	return mSchedule, err
+}
+
+func (s *Store) UpdateMSchedule(ctx context.Context, mSchedule *models.Schedule) (err error) {
+	mSchedule.UpdatedAt = time.Now().UTC()
+	if _, err = mSchedule.Update(ctx, s.db, boil.Blacklist(
+		models.ScheduleColumns.CreatedAt,
+		models.ScheduleColumns.DeletedAt,
+	)); err != nil {
+		err = errors.Wrap(err, "Error update schedule")
+		logger.Errorf(ctx, "UpdateMSchedule: %v", err)
+	}
+
+	return err
+}
+
+func (s *Store) DeleteMSchedule(ctx context.Context, scheduleID int32) (status bool, err error) {
+	mSchedule, err := models.FindSchedule(ctx, s.db, scheduleID)
+	if err != nil {
+		return false, errors.Wrap(err, "Error delete schedule, error get schedule")
+	}
+
+	if _, err = mSchedule.Delete(ctx, s.db); err != nil {
+		return false, errors.Wrap(err, "Error delete schedule")
+	}
+
+	return true, err
+}
+
+// GetMSchedulesToRun получение включенных расписаний, время запуска которых уже наступило
+func (s *Store) GetMSchedulesToRun(ctx context.Context, now time.Time) (mSchedules []*models.Schedule, err error) {
+	mSchedules, err = models.Schedules(
+		models.ScheduleWhere.Enabled.EQ(true),
+		models.ScheduleWhere.NextRunAt.IsNotNull(),
+		models.ScheduleWhere.NextRunAt.LTE(null.TimeFrom(now)),
+		qm.OrderBy("next_run_at ASC"),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error select schedules to run")
+	}
+
+	return mSchedules, err
+}
+
+// ClaimMSchedule Захват расписания на запуск: переносит next_run_at на следующее время.
+// Обновление проходит только если next_run_at не изменился с момента выборки,
+// поэтому при нескольких подах сценарий запустит только один из них.
+func (s *Store) ClaimMSchedule(ctx context.Context,
+	mSchedule *models.Schedule, nextRunAt null.Time, enabled bool, now time.Time) (claimed bool, err error) {
+	rowsAff, err := models.Schedules(
+		models.ScheduleWhere.ScheduleID.EQ(mSchedule.ScheduleID),
+		models.ScheduleWhere.NextRunAt.EQ(mSchedule.NextRunAt),
+	).UpdateAll(ctx, s.db, models.M{
+		models.ScheduleColumns.NextRunAt: nextRunAt,
+		models.ScheduleColumns.LastRunAt: null.TimeFrom(now),
+		models.ScheduleColumns.Enabled:   enabled,
+		models.ScheduleColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "Error claim schedule '%v'", mSchedule.ScheduleID)
+	}
+
+	if rowsAff == 1 {
+		mSchedule.NextRunAt = nextRunAt
+		mSchedule.LastRunAt = null.TimeFrom(now)
+		mSchedule.Enabled = enabled
+	}
+
+	return rowsAff == 1, err
+}
+
+// UpdateMScheduleLastRun сохранение результата последнего запуска по расписанию
+func (s *Store) UpdateMScheduleLastRun(ctx context.Context,
+	scheduleID int32, runID int32, message string) (err error) {
+	_, err = models.Schedules(
+		models.ScheduleWhere.ScheduleID.EQ(scheduleID),
+	).UpdateAll(ctx, s.db, models.M{
+		models.ScheduleColumns.LastRunID:   runID,
+		models.ScheduleColumns.LastMessage: message,
+		models.ScheduleColumns.UpdatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "Error update last run of schedule '%v'", scheduleID)
+	}
+
+	return err
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/null/v8"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestClaimMSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	scheduledAt := null.TimeFrom(now.Add(-time.Second))
+	nextRunAt := null.TimeFrom(now.Add(24 * time.Hour))
+
+	tests := []struct {
+		name        string
+		rowsAff     int64
+		wantClaimed bool
+	}{
+		{name: "claimed", rowsAff: 1, wantClaimed: true},
+		// next_run_at уже перенесен другим подом
+		{name: "claimed by another host", rowsAff: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec(`UPDATE "schedules" SET .* WHERE \("schedules"\."schedule_id" = \$\d+\) `+
+				`AND \("schedules"\."next_run_at" = \$\d+\)`).
+				WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 7, scheduledAt).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAff))
+
+			mSchedule := &models.Schedule{ScheduleID: 7, Cron: "0 3 * * *", NextRunAt: scheduledAt, Enabled: true}
+			s := NewStore(sqlx.NewDb(db, "postgres"))
+
+			claimed, err := s.ClaimMSchedule(context.Background(), mSchedule, nextRunAt, true, now)
+			if err != nil {
+				t.Fatalf("ClaimMSchedule() error = %v", err)
+			}
+
+			if claimed != tt.wantClaimed {
+				t.Errorf("ClaimMSchedule() = %v, want %v", claimed, tt.wantClaimed)
+			}
+
+			// расписание в памяти меняется только при захвате
+			wantNextRunAt := scheduledAt
+			if tt.wantClaimed {
+				wantNextRunAt = nextRunAt
+			}
+
+			if !mSchedule.NextRunAt.Time.Equal(wantNextRunAt.Time) {
+				t.Errorf("ClaimMSchedule() next_run_at = %v, want %v", mSchedule.NextRunAt.Time, wantNextRunAt.Time)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
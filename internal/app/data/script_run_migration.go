@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/pkg/errors"
+)
+
+// ClaimMScriptRunMigration захват переноса ScriptRun`а, запущенного с pid, на другой агент.
+// Захват проходит один раз на pid, false - ScriptRun уже переносит(перенесла) другая реплика
+func (s *Store) ClaimMScriptRunMigration(ctx context.Context, mMigration *models.ScriptRunMigration) (bool, error) {
+	result, err := s.db.ExecContext(ctx, fmt.Sprintf(
+		"insert into %v (%v, %v, %v, %v, %v, %v) values ($1, $2, $3, $4, $5, $6) on conflict (%v, %v, %v) do nothing",
+		models.TableNames.ScriptRunMigrations,
+		models.ScriptRunMigrationColumns.RunID, models.ScriptRunMigrationColumns.RunScriptID,
+		models.ScriptRunMigrationColumns.Pid, models.ScriptRunMigrationColumns.AgentHost,
+		models.ScriptRunMigrationColumns.Reason, models.ScriptRunMigrationColumns.Owner,
+		models.ScriptRunMigrationColumns.RunID, models.ScriptRunMigrationColumns.RunScriptID,
+		models.ScriptRunMigrationColumns.Pid),
+		mMigration.RunID, mMigration.RunScriptID, mMigration.Pid,
+		mMigration.AgentHost, mMigration.Reason, mMigration.Owner,
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "Error claim migration of ScriptRun '%v/%v'",
+			mMigration.RunID, mMigration.RunScriptID)
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "Error claim migration of ScriptRun '%v/%v'",
+			mMigration.RunID, mMigration.RunScriptID)
+	}
+
+	return rowsAff == 1, nil
+}
+
+// SetMScriptRunMigrationAgentStopped скрипт остановлен на агенте, с которого был перенесен
+func (s *Store) SetMScriptRunMigrationAgentStopped(ctx context.Context, mMigration *models.ScriptRunMigration) error {
+	_, err := models.ScriptRunMigrations(
+		models.ScriptRunMigrationWhere.RunID.EQ(mMigration.RunID),
+		models.ScriptRunMigrationWhere.RunScriptID.EQ(mMigration.RunScriptID),
+		models.ScriptRunMigrationWhere.Pid.EQ(mMigration.Pid),
+	).UpdateAll(ctx, s.db, models.M{
+		models.ScriptRunMigrationColumns.AgentStopped: true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Error update migration of ScriptRun '%v/%v'", mMigration.RunID, mMigration.RunScriptID)
+	}
+
+	return nil
+}
+
+// DeleteMScriptRunMigration отмена захвата переноса: скрипт остался на прежнем агенте, перенос можно повторить
+func (s *Store) DeleteMScriptRunMigration(ctx context.Context, mMigration *models.ScriptRunMigration) error {
+	_, err := models.ScriptRunMigrations(
+		models.ScriptRunMigrationWhere.RunID.EQ(mMigration.RunID),
+		models.ScriptRunMigrationWhere.RunScriptID.EQ(mMigration.RunScriptID),
+		models.ScriptRunMigrationWhere.Pid.EQ(mMigration.Pid),
+	).DeleteAll(ctx, s.db)
+	if err != nil {
+		return errors.Wrapf(err, "Error delete migration of ScriptRun '%v/%v'",
+			mMigration.RunID, mMigration.RunScriptID)
+	}
+
+	return nil
+}
+
+// GetMScriptRunMigrationsAgentNotStopped переносы после since, скрипт которых не удалось остановить на прежнем агенте
+func (s *Store) GetMScriptRunMigrationsAgentNotStopped(ctx context.Context, since time.Time) (
+	[]*models.ScriptRunMigration, error) {
+	mMigrations, err := models.ScriptRunMigrations(
+		models.ScriptRunMigrationWhere.AgentStopped.EQ(false),
+		models.ScriptRunMigrationWhere.CreatedAt.GT(since),
+	).All(ctx, s.db)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error select not stopped script run migrations")
+	}
+
+	return mMigrations, nil
+}
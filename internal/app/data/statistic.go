@@ -166,3 +166,22 @@ func (s *Store) CountURLsInRunNew(ctx context.Context, runID int32, scriptRunID
 
 	return count, err
 }
+
+// GetMStatisticsByRuns получение статистики, привязанной к указанным Run`ам
+func (s *Store) GetMStatisticsByRuns(ctx context.Context, runIDs []int32) (models.StatisticSlice, error) {
+	ids := make(types.Int64Array, 0, len(runIDs))
+	for _, runID := range runIDs {
+		ids = append(ids, int64(runID))
+	}
+
+	statisticSlice, err := models.Statistics(
+		qm.Where(fmt.Sprintf("%s && ?::BIGINT[]", models.StatisticColumns.RunIds), ids),
+		qm.OrderBy(models.StatisticColumns.StatisticDumpID),
+	).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrapf(err, "Error get MStatistics by runs %v", runIDs)
+		logger.Warn(ctx, err)
+	}
+
+	return statisticSlice, err
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+)
+
+func (s *Store) GetMStopConditions(ctx context.Context, scenarioID int32) (
+	mStopConditions models.StopConditionSlice, err error) {
+	mStopConditions, err = models.StopConditions(
+		models.StopConditionWhere.ScenarioID.EQ(scenarioID),
+		qm.OrderBy(models.StopConditionColumns.StopConditionID),
+	).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrapf(err, "Error fetch stop conditions of scenario '%v'", scenarioID)
+		logger.Errorf(ctx, err.Error())
+	}
+
+	return mStopConditions, err
+}
+
+// GetEnabledMStopConditions получение включенных условий остановки для списка сценариев
+func (s *Store) GetEnabledMStopConditions(ctx context.Context, scenarioIDs []int32) (
+	mStopConditions models.StopConditionSlice, err error) {
+	if len(scenarioIDs) == 0 {
+		return mStopConditions, nil
+	}
+
+	mStopConditions, err = models.StopConditions(
+		models.StopConditionWhere.ScenarioID.IN(scenarioIDs),
+		models.StopConditionWhere.Enabled.EQ(true),
+	).All(ctx, s.db)
+	if err != nil {
+		err = errors.Wrap(err, "Error fetch enabled stop conditions")
+	}
+
+	return mStopConditions, err
+}
+
+// ReplaceMStopConditions полная замена условий остановки сценария в одной транзакции
+func (s *Store) ReplaceMStopConditions(ctx context.Context,
+	scenarioID int32, mStopConditions []*models.StopCondition) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "Error begin transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			if er := tx.Rollback(); er != nil {
+				logger.Errorf(ctx, "ReplaceMStopConditions rollback error: %v", er)
+			}
+		}
+	}()
+
+	if _, err = models.StopConditions(
+		models.StopConditionWhere.ScenarioID.EQ(scenarioID),
+	).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrapf(err, "Error delete stop conditions of scenario '%v'", scenarioID)
+	}
+
+	for _, mStopCondition := range mStopConditions {
+		mStopCondition.ScenarioID = scenarioID
+		if err = mStopCondition.Insert(ctx, tx, boil.Blacklist(
+			models.StopConditionColumns.StopConditionID,
+			models.StopConditionColumns.CreatedAt,
+			models.StopConditionColumns.UpdatedAt,
+			models.StopConditionColumns.DeletedAt,
+		)); err != nil {
+			return errors.Wrapf(err, "Error insert stop condition of scenario '%v'", scenarioID)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "Error commit stop conditions")
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
 )
 
 func (s *Store) GetAllScenarios(ctx context.Context, projectID int32, limit int32, pageNumber int32) (
@@ -108,6 +109,14 @@ func (s *Store) UpdateScenario(ctx context.Context, pbScenario *pb.Scenario) (er
 	mScenario.Title = pbScenario.Title
 	mScenario.Descrip = null.StringFrom(pbScenario.Descrip)
 
+	mUpdatedScenario, message := conv.PBToModelScenario(ctx, pbScenario)
+	if message != "" {
+		return errors.New(message)
+	}
+	mScenario.LoadProfile = mUpdatedScenario.LoadProfile
+	mScenario.FailoverEnabled = mUpdatedScenario.FailoverEnabled
+	mScenario.MaxFailovers = mUpdatedScenario.MaxFailovers
+
 	err = s.db.UpdateMScenario(ctx, mScenario)
 	if err != nil {
 		logger.Warnf(ctx, "UpdateScenario -> UpdateMScenario fail: '%v'", err.Error())
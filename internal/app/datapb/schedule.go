@@ -0,0 +1,53 @@
+package datapb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	v1 "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+func (s *Store) GetAllSchedules(ctx context.Context, scenarioID int32, limit int32, pageNumber int32) (
+	schedules []*v1.Schedule, pages int64, message string) {
+	offset, limit := data.OffsetCalculation(limit, pageNumber)
+	logger.Infof(ctx, "Query params(schedules): (scenarioID:'%v'; limit:'%v'; offset:'%v')", scenarioID, limit, offset)
+	mSchedules, totalPages, err := s.db.GetAllMSchedules(ctx, scenarioID, limit, offset)
+	if err != nil {
+		message = fmt.Sprintf("Error getting all mSchedules: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return schedules, totalPages, message
+	}
+
+	schedules = make([]*v1.Schedule, 0, len(mSchedules))
+
+	for _, mSchedule := range mSchedules {
+		pbSchedule, mes := conv.ModelToPBSchedule(ctx, mSchedule)
+		message = fmt.Sprint(message, mes)
+
+		if mes == "" {
+			schedules = append(schedules, pbSchedule)
+		} else {
+			logger.Errorf(ctx, "scheduleModelToPB: '%v' '%v'", pbSchedule, mes)
+		}
+	}
+
+	logger.Infof(ctx, "len(ReturnSchedules): '%v'; Total Pages(Schedules): '%v'", len(schedules), totalPages)
+
+	return schedules, totalPages, message
+}
+
+func (s *Store) GetSchedule(ctx context.Context, scheduleID int32) (schedule *v1.Schedule, message string) {
+	mSchedule, err := s.db.GetMSchedule(ctx, scheduleID)
+	if err != nil {
+		message = fmt.Sprintf("Error get schedule'%v': '%v'", scheduleID, err.Error())
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	return conv.ModelToPBSchedule(ctx, mSchedule)
+}
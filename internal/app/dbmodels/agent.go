@@ -25,77 +25,142 @@ import (
 
 // Agent is an object representing the database table.
 type Agent struct {
-	AgentID      int32             `boil:"agent_id" json:"agent_id" toml:"agent_id" yaml:"agent_id"`
-	HostName     string            `boil:"host_name" json:"host_name" toml:"host_name" yaml:"host_name"`
-	Port         string            `boil:"port" json:"port" toml:"port" yaml:"port"`
-	Enabled      bool              `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
-	CreatedAt    time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	DeletedAt    null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	Tags         types.StringArray `boil:"tags" json:"tags" toml:"tags" yaml:"tags"`
-	CPUUsed      int32             `boil:"cpu_used" json:"cpu_used" toml:"cpu_used" yaml:"cpu_used"`
-	MemUsed      int32             `boil:"mem_used" json:"mem_used" toml:"mem_used" yaml:"mem_used"`
-	PortsUsed    int32             `boil:"ports_used" json:"ports_used" toml:"ports_used" yaml:"ports_used"`
-	TotalLoading int16             `boil:"total_loading" json:"total_loading" toml:"total_loading" yaml:"total_loading"`
+	AgentID         int32             `boil:"agent_id" json:"agent_id" toml:"agent_id" yaml:"agent_id"`
+	HostName        string            `boil:"host_name" json:"host_name" toml:"host_name" yaml:"host_name"`
+	Port            string            `boil:"port" json:"port" toml:"port" yaml:"port"`
+	Enabled         bool              `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt       time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time         `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	DeletedAt       null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Tags            types.StringArray `boil:"tags" json:"tags" toml:"tags" yaml:"tags"`
+	CPUUsed         int32             `boil:"cpu_used" json:"cpu_used" toml:"cpu_used" yaml:"cpu_used"`
+	MemUsed         int32             `boil:"mem_used" json:"mem_used" toml:"mem_used" yaml:"mem_used"`
+	PortsUsed       int32             `boil:"ports_used" json:"ports_used" toml:"ports_used" yaml:"ports_used"`
+	TotalLoading    int16             `boil:"total_loading" json:"total_loading" toml:"total_loading" yaml:"total_loading"`
+	MaxScripts      int32             `boil:"max_scripts" json:"max_scripts" toml:"max_scripts" yaml:"max_scripts"`
+	LastSeenAt      null.Time         `boil:"last_seen_at" json:"last_seen_at,omitempty" toml:"last_seen_at" yaml:"last_seen_at,omitempty"`
+	FailureCount    int32             `boil:"failure_count" json:"failure_count" toml:"failure_count" yaml:"failure_count"`
+	Quarantined     bool              `boil:"quarantined" json:"quarantined" toml:"quarantined" yaml:"quarantined"`
+	LastError       string            `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	Version         string            `boil:"version" json:"version" toml:"version" yaml:"version"`
+	SelfRegistered  bool              `boil:"self_registered" json:"self_registered" toml:"self_registered" yaml:"self_registered"`
+	LastHeartbeatAt null.Time         `boil:"last_heartbeat_at" json:"last_heartbeat_at,omitempty" toml:"last_heartbeat_at" yaml:"last_heartbeat_at,omitempty"`
+	Draining        bool              `boil:"draining" json:"draining" toml:"draining" yaml:"draining"`
+	DrainMigrate    bool              `boil:"drain_migrate" json:"drain_migrate" toml:"drain_migrate" yaml:"drain_migrate"`
+	DrainStartedAt  null.Time         `boil:"drain_started_at" json:"drain_started_at,omitempty" toml:"drain_started_at" yaml:"drain_started_at,omitempty"`
+	TokenEncrypted  string            `boil:"token_encrypted" json:"token_encrypted" toml:"token_encrypted" yaml:"token_encrypted"`
+	TokenRotatedAt  null.Time         `boil:"token_rotated_at" json:"token_rotated_at,omitempty" toml:"token_rotated_at" yaml:"token_rotated_at,omitempty"`
 
 	R *agentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L agentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AgentColumns = struct {
-	AgentID      string
-	HostName     string
-	Port         string
-	Enabled      string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedAt    string
-	Tags         string
-	CPUUsed      string
-	MemUsed      string
-	PortsUsed    string
-	TotalLoading string
+	AgentID         string
+	HostName        string
+	Port            string
+	Enabled         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	Tags            string
+	CPUUsed         string
+	MemUsed         string
+	PortsUsed       string
+	TotalLoading    string
+	MaxScripts      string
+	LastSeenAt      string
+	FailureCount    string
+	Quarantined     string
+	LastError       string
+	Version         string
+	SelfRegistered  string
+	LastHeartbeatAt string
+	Draining        string
+	DrainMigrate    string
+	DrainStartedAt  string
+	TokenEncrypted  string
+	TokenRotatedAt  string
 }{
-	AgentID:      "agent_id",
-	HostName:     "host_name",
-	Port:         "port",
-	Enabled:      "enabled",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	DeletedAt:    "deleted_at",
-	Tags:         "tags",
-	CPUUsed:      "cpu_used",
-	MemUsed:      "mem_used",
-	PortsUsed:    "ports_used",
-	TotalLoading: "total_loading",
+	AgentID:         "agent_id",
+	HostName:        "host_name",
+	Port:            "port",
+	Enabled:         "enabled",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	Tags:            "tags",
+	CPUUsed:         "cpu_used",
+	MemUsed:         "mem_used",
+	PortsUsed:       "ports_used",
+	TotalLoading:    "total_loading",
+	MaxScripts:      "max_scripts",
+	LastSeenAt:      "last_seen_at",
+	FailureCount:    "failure_count",
+	Quarantined:     "quarantined",
+	LastError:       "last_error",
+	Version:         "version",
+	SelfRegistered:  "self_registered",
+	LastHeartbeatAt: "last_heartbeat_at",
+	Draining:        "draining",
+	DrainMigrate:    "drain_migrate",
+	DrainStartedAt:  "drain_started_at",
+	TokenEncrypted:  "token_encrypted",
+	TokenRotatedAt:  "token_rotated_at",
 }
 
 var AgentTableColumns = struct {
-	AgentID      string
-	HostName     string
-	Port         string
-	Enabled      string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedAt    string
-	Tags         string
-	CPUUsed      string
-	MemUsed      string
-	PortsUsed    string
-	TotalLoading string
+	AgentID         string
+	HostName        string
+	Port            string
+	Enabled         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	Tags            string
+	CPUUsed         string
+	MemUsed         string
+	PortsUsed       string
+	TotalLoading    string
+	MaxScripts      string
+	LastSeenAt      string
+	FailureCount    string
+	Quarantined     string
+	LastError       string
+	Version         string
+	SelfRegistered  string
+	LastHeartbeatAt string
+	Draining        string
+	DrainMigrate    string
+	DrainStartedAt  string
+	TokenEncrypted  string
+	TokenRotatedAt  string
 }{
-	AgentID:      "agent.agent_id",
-	HostName:     "agent.host_name",
-	Port:         "agent.port",
-	Enabled:      "agent.enabled",
-	CreatedAt:    "agent.created_at",
-	UpdatedAt:    "agent.updated_at",
-	DeletedAt:    "agent.deleted_at",
-	Tags:         "agent.tags",
-	CPUUsed:      "agent.cpu_used",
-	MemUsed:      "agent.mem_used",
-	PortsUsed:    "agent.ports_used",
-	TotalLoading: "agent.total_loading",
+	AgentID:         "agent.agent_id",
+	HostName:        "agent.host_name",
+	Port:            "agent.port",
+	Enabled:         "agent.enabled",
+	CreatedAt:       "agent.created_at",
+	UpdatedAt:       "agent.updated_at",
+	DeletedAt:       "agent.deleted_at",
+	Tags:            "agent.tags",
+	CPUUsed:         "agent.cpu_used",
+	MemUsed:         "agent.mem_used",
+	PortsUsed:       "agent.ports_used",
+	TotalLoading:    "agent.total_loading",
+	MaxScripts:      "agent.max_scripts",
+	LastSeenAt:      "agent.last_seen_at",
+	FailureCount:    "agent.failure_count",
+	Quarantined:     "agent.quarantined",
+	LastError:       "agent.last_error",
+	Version:         "agent.version",
+	SelfRegistered:  "agent.self_registered",
+	LastHeartbeatAt: "agent.last_heartbeat_at",
+	Draining:        "agent.draining",
+	DrainMigrate:    "agent.drain_migrate",
+	DrainStartedAt:  "agent.drain_started_at",
+	TokenEncrypted:  "agent.token_encrypted",
+	TokenRotatedAt:  "agent.token_rotated_at",
 }
 
 // Generated where
@@ -253,31 +318,57 @@ func (w whereHelperint16) NIN(slice []int16) qm.QueryMod {
 }
 
 var AgentWhere = struct {
-	AgentID      whereHelperint32
-	HostName     whereHelperstring
-	Port         whereHelperstring
-	Enabled      whereHelperbool
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpernull_Time
-	DeletedAt    whereHelpernull_Time
-	Tags         whereHelpertypes_StringArray
-	CPUUsed      whereHelperint32
-	MemUsed      whereHelperint32
-	PortsUsed    whereHelperint32
-	TotalLoading whereHelperint16
+	AgentID         whereHelperint32
+	HostName        whereHelperstring
+	Port            whereHelperstring
+	Enabled         whereHelperbool
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+	DeletedAt       whereHelpernull_Time
+	Tags            whereHelpertypes_StringArray
+	CPUUsed         whereHelperint32
+	MemUsed         whereHelperint32
+	PortsUsed       whereHelperint32
+	TotalLoading    whereHelperint16
+	MaxScripts      whereHelperint32
+	LastSeenAt      whereHelpernull_Time
+	FailureCount    whereHelperint32
+	Quarantined     whereHelperbool
+	LastError       whereHelperstring
+	Version         whereHelperstring
+	SelfRegistered  whereHelperbool
+	LastHeartbeatAt whereHelpernull_Time
+	Draining        whereHelperbool
+	DrainMigrate    whereHelperbool
+	DrainStartedAt  whereHelpernull_Time
+	TokenEncrypted  whereHelperstring
+	TokenRotatedAt  whereHelpernull_Time
 }{
-	AgentID:      whereHelperint32{field: "\"agent\".\"agent_id\""},
-	HostName:     whereHelperstring{field: "\"agent\".\"host_name\""},
-	Port:         whereHelperstring{field: "\"agent\".\"port\""},
-	Enabled:      whereHelperbool{field: "\"agent\".\"enabled\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"agent\".\"created_at\""},
-	UpdatedAt:    whereHelpernull_Time{field: "\"agent\".\"updated_at\""},
-	DeletedAt:    whereHelpernull_Time{field: "\"agent\".\"deleted_at\""},
-	Tags:         whereHelpertypes_StringArray{field: "\"agent\".\"tags\""},
-	CPUUsed:      whereHelperint32{field: "\"agent\".\"cpu_used\""},
-	MemUsed:      whereHelperint32{field: "\"agent\".\"mem_used\""},
-	PortsUsed:    whereHelperint32{field: "\"agent\".\"ports_used\""},
-	TotalLoading: whereHelperint16{field: "\"agent\".\"total_loading\""},
+	AgentID:         whereHelperint32{field: "\"agent\".\"agent_id\""},
+	HostName:        whereHelperstring{field: "\"agent\".\"host_name\""},
+	Port:            whereHelperstring{field: "\"agent\".\"port\""},
+	Enabled:         whereHelperbool{field: "\"agent\".\"enabled\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"agent\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"agent\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"agent\".\"deleted_at\""},
+	Tags:            whereHelpertypes_StringArray{field: "\"agent\".\"tags\""},
+	CPUUsed:         whereHelperint32{field: "\"agent\".\"cpu_used\""},
+	MemUsed:         whereHelperint32{field: "\"agent\".\"mem_used\""},
+	PortsUsed:       whereHelperint32{field: "\"agent\".\"ports_used\""},
+	TotalLoading:    whereHelperint16{field: "\"agent\".\"total_loading\""},
+	MaxScripts:      whereHelperint32{field: "\"agent\".\"max_scripts\""},
+	LastSeenAt:      whereHelpernull_Time{field: "\"agent\".\"last_seen_at\""},
+	FailureCount:    whereHelperint32{field: "\"agent\".\"failure_count\""},
+	Quarantined:     whereHelperbool{field: "\"agent\".\"quarantined\""},
+	LastError:       whereHelperstring{field: "\"agent\".\"last_error\""},
+	Version:         whereHelperstring{field: "\"agent\".\"version\""},
+	SelfRegistered:  whereHelperbool{field: "\"agent\".\"self_registered\""},
+	LastHeartbeatAt: whereHelpernull_Time{field: "\"agent\".\"last_heartbeat_at\""},
+	Draining:        whereHelperbool{field: "\"agent\".\"draining\""},
+	DrainMigrate:    whereHelperbool{field: "\"agent\".\"drain_migrate\""},
+	DrainStartedAt:  whereHelpernull_Time{field: "\"agent\".\"drain_started_at\""},
+	TokenEncrypted:  whereHelperstring{field: "\"agent\".\"token_encrypted\""},
+	TokenRotatedAt:  whereHelpernull_Time{field: "\"agent\".\"token_rotated_at\""},
 }
 
 // AgentRels is where relationship names are stored.
@@ -297,9 +388,9 @@ func (*agentR) NewStruct() *agentR {
 type agentL struct{}
 
 var (
-	agentAllColumns            = []string{"agent_id", "host_name", "port", "enabled", "created_at", "updated_at", "deleted_at", "tags", "cpu_used", "mem_used", "ports_used", "total_loading"}
+	agentAllColumns            = []string{"agent_id", "host_name", "port", "enabled", "created_at", "updated_at", "deleted_at", "tags", "cpu_used", "mem_used", "ports_used", "total_loading", "max_scripts", "last_seen_at", "failure_count", "quarantined", "last_error", "version", "self_registered", "last_heartbeat_at", "draining", "drain_migrate", "drain_started_at", "token_encrypted", "token_rotated_at"}
 	agentColumnsWithoutDefault = []string{}
-	agentColumnsWithDefault    = []string{"agent_id", "host_name", "port", "enabled", "created_at", "updated_at", "deleted_at", "tags", "cpu_used", "mem_used", "ports_used", "total_loading"}
+	agentColumnsWithDefault    = []string{"agent_id", "host_name", "port", "enabled", "created_at", "updated_at", "deleted_at", "tags", "cpu_used", "mem_used", "ports_used", "total_loading", "max_scripts", "last_seen_at", "failure_count", "quarantined", "last_error", "version", "self_registered", "last_heartbeat_at", "draining", "drain_migrate", "drain_started_at", "token_encrypted", "token_rotated_at"}
 	agentPrimaryKeyColumns     = []string{"agent_id"}
 	agentGeneratedColumns      = []string{}
 )
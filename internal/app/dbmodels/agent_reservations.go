@@ -0,0 +1,1289 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AgentReservation is an object representing the database table.
+type AgentReservation struct {
+	AgentReservationID int32      `boil:"agent_reservation_id" json:"agent_reservation_id" toml:"agent_reservation_id" yaml:"agent_reservation_id"`
+	Tag                string     `boil:"tag" json:"tag" toml:"tag" yaml:"tag"`
+	AgentsCount        int32      `boil:"agents_count" json:"agents_count" toml:"agents_count" yaml:"agents_count"`
+	UserName           string     `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	ScenarioID         null.Int32 `boil:"scenario_id" json:"scenario_id,omitempty" toml:"scenario_id" yaml:"scenario_id,omitempty"`
+	Comment            string     `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
+	StartsAt           time.Time  `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt             time.Time  `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	CreatedAt          time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time  `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *agentReservationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L agentReservationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AgentReservationColumns = struct {
+	AgentReservationID string
+	Tag                string
+	AgentsCount        string
+	UserName           string
+	ScenarioID         string
+	Comment            string
+	StartsAt           string
+	EndsAt             string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	AgentReservationID: "agent_reservation_id",
+	Tag:                "tag",
+	AgentsCount:        "agents_count",
+	UserName:           "user_name",
+	ScenarioID:         "scenario_id",
+	Comment:            "comment",
+	StartsAt:           "starts_at",
+	EndsAt:             "ends_at",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
+}
+
+var AgentReservationTableColumns = struct {
+	AgentReservationID string
+	Tag                string
+	AgentsCount        string
+	UserName           string
+	ScenarioID         string
+	Comment            string
+	StartsAt           string
+	EndsAt             string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	AgentReservationID: "agent_reservations.agent_reservation_id",
+	Tag:                "agent_reservations.tag",
+	AgentsCount:        "agent_reservations.agents_count",
+	UserName:           "agent_reservations.user_name",
+	ScenarioID:         "agent_reservations.scenario_id",
+	Comment:            "agent_reservations.comment",
+	StartsAt:           "agent_reservations.starts_at",
+	EndsAt:             "agent_reservations.ends_at",
+	CreatedAt:          "agent_reservations.created_at",
+	UpdatedAt:          "agent_reservations.updated_at",
+	DeletedAt:          "agent_reservations.deleted_at",
+}
+
+// Generated where
+
+type whereHelpernull_Int32 struct{ field string }
+
+func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int32) NEQ(x null.Int32) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int32) LT(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int32) LTE(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int32) GT(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int32) GTE(x null.Int32) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int32) IN(slice []int32) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var AgentReservationWhere = struct {
+	AgentReservationID whereHelperint32
+	Tag                whereHelperstring
+	AgentsCount        whereHelperint32
+	UserName           whereHelperstring
+	ScenarioID         whereHelpernull_Int32
+	Comment            whereHelperstring
+	StartsAt           whereHelpertime_Time
+	EndsAt             whereHelpertime_Time
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+}{
+	AgentReservationID: whereHelperint32{field: "\"agent_reservations\".\"agent_reservation_id\""},
+	Tag:                whereHelperstring{field: "\"agent_reservations\".\"tag\""},
+	AgentsCount:        whereHelperint32{field: "\"agent_reservations\".\"agents_count\""},
+	UserName:           whereHelperstring{field: "\"agent_reservations\".\"user_name\""},
+	ScenarioID:         whereHelpernull_Int32{field: "\"agent_reservations\".\"scenario_id\""},
+	Comment:            whereHelperstring{field: "\"agent_reservations\".\"comment\""},
+	StartsAt:           whereHelpertime_Time{field: "\"agent_reservations\".\"starts_at\""},
+	EndsAt:             whereHelpertime_Time{field: "\"agent_reservations\".\"ends_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"agent_reservations\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"agent_reservations\".\"updated_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"agent_reservations\".\"deleted_at\""},
+}
+
+// AgentReservationRels is where relationship names are stored.
+var AgentReservationRels = struct {
+	Scenario string
+}{
+	Scenario: "Scenario",
+}
+
+// agentReservationR is where relationships are stored.
+type agentReservationR struct {
+	Scenario *Scenario `boil:"Scenario" json:"Scenario" toml:"Scenario" yaml:"Scenario"`
+}
+
+// NewStruct creates a new relationship struct
+func (*agentReservationR) NewStruct() *agentReservationR {
+	return &agentReservationR{}
+}
+
+func (o *AgentReservation) GetScenario() *Scenario {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenario()
+}
+
+func (r *agentReservationR) GetScenario() *Scenario {
+	if r == nil {
+		return nil
+	}
+
+	return r.Scenario
+}
+
+// agentReservationL is where Load methods for each relationship are stored.
+type agentReservationL struct{}
+
+var (
+	agentReservationAllColumns            = []string{"agent_reservation_id", "tag", "agents_count", "user_name", "scenario_id", "comment", "starts_at", "ends_at", "created_at", "updated_at", "deleted_at"}
+	agentReservationColumnsWithoutDefault = []string{"starts_at", "ends_at"}
+	agentReservationColumnsWithDefault    = []string{"agent_reservation_id", "tag", "agents_count", "user_name", "scenario_id", "comment", "created_at", "updated_at", "deleted_at"}
+	agentReservationPrimaryKeyColumns     = []string{"agent_reservation_id"}
+	agentReservationGeneratedColumns      = []string{}
+)
+
+type (
+	// AgentReservationSlice is an alias for a slice of pointers to AgentReservation.
+	// This should almost always be used instead of []AgentReservation.
+	AgentReservationSlice []*AgentReservation
+	// AgentReservationHook is the signature for custom AgentReservation hook methods
+	AgentReservationHook func(context.Context, boil.ContextExecutor, *AgentReservation) error
+
+	agentReservationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	agentReservationType                 = reflect.TypeOf(&AgentReservation{})
+	agentReservationMapping              = queries.MakeStructMapping(agentReservationType)
+	agentReservationPrimaryKeyMapping, _ = queries.BindMapping(agentReservationType, agentReservationMapping, agentReservationPrimaryKeyColumns)
+	agentReservationInsertCacheMut       sync.RWMutex
+	agentReservationInsertCache          = make(map[string]insertCache)
+	agentReservationUpdateCacheMut       sync.RWMutex
+	agentReservationUpdateCache          = make(map[string]updateCache)
+	agentReservationUpsertCacheMut       sync.RWMutex
+	agentReservationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var agentReservationAfterSelectMu sync.Mutex
+var agentReservationAfterSelectHooks []AgentReservationHook
+
+var agentReservationBeforeInsertMu sync.Mutex
+var agentReservationBeforeInsertHooks []AgentReservationHook
+var agentReservationAfterInsertMu sync.Mutex
+var agentReservationAfterInsertHooks []AgentReservationHook
+
+var agentReservationBeforeUpdateMu sync.Mutex
+var agentReservationBeforeUpdateHooks []AgentReservationHook
+var agentReservationAfterUpdateMu sync.Mutex
+var agentReservationAfterUpdateHooks []AgentReservationHook
+
+var agentReservationBeforeDeleteMu sync.Mutex
+var agentReservationBeforeDeleteHooks []AgentReservationHook
+var agentReservationAfterDeleteMu sync.Mutex
+var agentReservationAfterDeleteHooks []AgentReservationHook
+
+var agentReservationBeforeUpsertMu sync.Mutex
+var agentReservationBeforeUpsertHooks []AgentReservationHook
+var agentReservationAfterUpsertMu sync.Mutex
+var agentReservationAfterUpsertHooks []AgentReservationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AgentReservation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AgentReservation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AgentReservation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AgentReservation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AgentReservation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AgentReservation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AgentReservation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AgentReservation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AgentReservation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range agentReservationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAgentReservationHook registers your hook function for all future operations.
+func AddAgentReservationHook(hookPoint boil.HookPoint, agentReservationHook AgentReservationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		agentReservationAfterSelectMu.Lock()
+		agentReservationAfterSelectHooks = append(agentReservationAfterSelectHooks, agentReservationHook)
+		agentReservationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		agentReservationBeforeInsertMu.Lock()
+		agentReservationBeforeInsertHooks = append(agentReservationBeforeInsertHooks, agentReservationHook)
+		agentReservationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		agentReservationAfterInsertMu.Lock()
+		agentReservationAfterInsertHooks = append(agentReservationAfterInsertHooks, agentReservationHook)
+		agentReservationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		agentReservationBeforeUpdateMu.Lock()
+		agentReservationBeforeUpdateHooks = append(agentReservationBeforeUpdateHooks, agentReservationHook)
+		agentReservationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		agentReservationAfterUpdateMu.Lock()
+		agentReservationAfterUpdateHooks = append(agentReservationAfterUpdateHooks, agentReservationHook)
+		agentReservationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		agentReservationBeforeDeleteMu.Lock()
+		agentReservationBeforeDeleteHooks = append(agentReservationBeforeDeleteHooks, agentReservationHook)
+		agentReservationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		agentReservationAfterDeleteMu.Lock()
+		agentReservationAfterDeleteHooks = append(agentReservationAfterDeleteHooks, agentReservationHook)
+		agentReservationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		agentReservationBeforeUpsertMu.Lock()
+		agentReservationBeforeUpsertHooks = append(agentReservationBeforeUpsertHooks, agentReservationHook)
+		agentReservationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		agentReservationAfterUpsertMu.Lock()
+		agentReservationAfterUpsertHooks = append(agentReservationAfterUpsertHooks, agentReservationHook)
+		agentReservationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single agentReservation record from the query.
+func (q agentReservationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AgentReservation, error) {
+	o := &AgentReservation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for agent_reservations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AgentReservation records from the query.
+func (q agentReservationQuery) All(ctx context.Context, exec boil.ContextExecutor) (AgentReservationSlice, error) {
+	var o []*AgentReservation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AgentReservation slice")
+	}
+
+	if len(agentReservationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AgentReservation records in the query.
+func (q agentReservationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count agent_reservations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q agentReservationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if agent_reservations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Scenario pointed to by the foreign key.
+func (o *AgentReservation) Scenario(mods ...qm.QueryMod) scenarioQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"scenario_id\" = ?", o.ScenarioID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Scenarios(queryMods...)
+}
+
+// LoadScenario allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (agentReservationL) LoadScenario(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAgentReservation interface{}, mods queries.Applicator) error {
+	var slice []*AgentReservation
+	var object *AgentReservation
+
+	if singular {
+		var ok bool
+		object, ok = maybeAgentReservation.(*AgentReservation)
+		if !ok {
+			object = new(AgentReservation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAgentReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAgentReservation))
+			}
+		}
+	} else {
+		s, ok := maybeAgentReservation.(*[]*AgentReservation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAgentReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAgentReservation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &agentReservationR{}
+		}
+		if !queries.IsNil(object.ScenarioID) {
+			args[object.ScenarioID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &agentReservationR{}
+			}
+
+			if !queries.IsNil(obj.ScenarioID) {
+				args[obj.ScenarioID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scenarios`),
+		qm.WhereIn(`scenarios.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Scenario")
+	}
+
+	var resultSlice []*Scenario
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Scenario")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for scenarios")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenarios")
+	}
+
+	if len(scenarioAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Scenario = foreign
+		if foreign.R == nil {
+			foreign.R = &scenarioR{}
+		}
+		foreign.R.AgentReservations = append(foreign.R.AgentReservations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ScenarioID, foreign.ScenarioID) {
+				local.R.Scenario = foreign
+				if foreign.R == nil {
+					foreign.R = &scenarioR{}
+				}
+				foreign.R.AgentReservations = append(foreign.R.AgentReservations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetScenario of the agentReservation to the related item.
+// Sets o.R.Scenario to related.
+// Adds o to related.R.AgentReservations.
+func (o *AgentReservation) SetScenario(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Scenario) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"agent_reservations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+		strmangle.WhereClause("\"", "\"", 2, agentReservationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ScenarioID, o.AgentReservationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ScenarioID, related.ScenarioID)
+	if o.R == nil {
+		o.R = &agentReservationR{
+			Scenario: related,
+		}
+	} else {
+		o.R.Scenario = related
+	}
+
+	if related.R == nil {
+		related.R = &scenarioR{
+			AgentReservations: AgentReservationSlice{o},
+		}
+	} else {
+		related.R.AgentReservations = append(related.R.AgentReservations, o)
+	}
+
+	return nil
+}
+
+// RemoveScenario relationship.
+// Sets o.R.Scenario to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AgentReservation) RemoveScenario(ctx context.Context, exec boil.ContextExecutor, related *Scenario) error {
+	var err error
+
+	queries.SetScanner(&o.ScenarioID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("scenario_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Scenario = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AgentReservations {
+		if queries.Equal(o.ScenarioID, ri.ScenarioID) {
+			continue
+		}
+
+		ln := len(related.R.AgentReservations)
+		if ln > 1 && i < ln-1 {
+			related.R.AgentReservations[i] = related.R.AgentReservations[ln-1]
+		}
+		related.R.AgentReservations = related.R.AgentReservations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AgentReservations retrieves all the records using an executor.
+func AgentReservations(mods ...qm.QueryMod) agentReservationQuery {
+	mods = append(mods, qm.From("\"agent_reservations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"agent_reservations\".*"})
+	}
+
+	return agentReservationQuery{q}
+}
+
+// FindAgentReservation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAgentReservation(ctx context.Context, exec boil.ContextExecutor, agentReservationID int32, selectCols ...string) (*AgentReservation, error) {
+	agentReservationObj := &AgentReservation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"agent_reservations\" where \"agent_reservation_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, agentReservationID)
+
+	err := q.Bind(ctx, exec, agentReservationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from agent_reservations")
+	}
+
+	if err = agentReservationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return agentReservationObj, err
+	}
+
+	return agentReservationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AgentReservation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no agent_reservations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(agentReservationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	agentReservationInsertCacheMut.RLock()
+	cache, cached := agentReservationInsertCache[key]
+	agentReservationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			agentReservationAllColumns,
+			agentReservationColumnsWithDefault,
+			agentReservationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(agentReservationType, agentReservationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(agentReservationType, agentReservationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"agent_reservations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"agent_reservations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into agent_reservations")
+	}
+
+	if !cached {
+		agentReservationInsertCacheMut.Lock()
+		agentReservationInsertCache[key] = cache
+		agentReservationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the AgentReservation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AgentReservation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	agentReservationUpdateCacheMut.RLock()
+	cache, cached := agentReservationUpdateCache[key]
+	agentReservationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			agentReservationAllColumns,
+			agentReservationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update agent_reservations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"agent_reservations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, agentReservationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(agentReservationType, agentReservationMapping, append(wl, agentReservationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update agent_reservations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for agent_reservations")
+	}
+
+	if !cached {
+		agentReservationUpdateCacheMut.Lock()
+		agentReservationUpdateCache[key] = cache
+		agentReservationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q agentReservationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for agent_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for agent_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AgentReservationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), agentReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"agent_reservations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, agentReservationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in agentReservation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all agentReservation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AgentReservation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no agent_reservations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(agentReservationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	agentReservationUpsertCacheMut.RLock()
+	cache, cached := agentReservationUpsertCache[key]
+	agentReservationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			agentReservationAllColumns,
+			agentReservationColumnsWithDefault,
+			agentReservationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			agentReservationAllColumns,
+			agentReservationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert agent_reservations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(agentReservationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(agentReservationPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert agent_reservations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(agentReservationPrimaryKeyColumns))
+			copy(conflict, agentReservationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"agent_reservations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(agentReservationType, agentReservationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(agentReservationType, agentReservationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert agent_reservations")
+	}
+
+	if !cached {
+		agentReservationUpsertCacheMut.Lock()
+		agentReservationUpsertCache[key] = cache
+		agentReservationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single AgentReservation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AgentReservation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AgentReservation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), agentReservationPrimaryKeyMapping)
+	sql := "DELETE FROM \"agent_reservations\" WHERE \"agent_reservation_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from agent_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for agent_reservations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q agentReservationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no agentReservationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from agent_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for agent_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AgentReservationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(agentReservationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), agentReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"agent_reservations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, agentReservationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from agentReservation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for agent_reservations")
+	}
+
+	if len(agentReservationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AgentReservation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAgentReservation(ctx, exec, o.AgentReservationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AgentReservationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AgentReservationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), agentReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"agent_reservations\".* FROM \"agent_reservations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, agentReservationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AgentReservationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AgentReservationExists checks if the AgentReservation row exists.
+func AgentReservationExists(ctx context.Context, exec boil.ContextExecutor, agentReservationID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"agent_reservations\" where \"agent_reservation_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, agentReservationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, agentReservationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if agent_reservations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AgentReservation row exists.
+func (o *AgentReservation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AgentReservationExists(ctx, exec, o.AgentReservationID)
+}
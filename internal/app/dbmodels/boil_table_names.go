@@ -4,31 +4,47 @@
 package models
 
 var TableNames = struct {
-	Agent         string
-	Command       string
-	GroupLogs     string
-	GroupsPage    string
-	Projects      string
-	RunReport     string
-	Runs          string
-	Scenarios     string
-	Scripts       string
-	SimpleScripts string
-	Statistic     string
-	StatisticDump string
-	Traces        string
+	Agent               string
+	AgentReservations   string
+	CapacitySearches    string
+	Command             string
+	GroupLogs           string
+	GroupsPage          string
+	Projects            string
+	RunPauses           string
+	RunReport           string
+	RunVerdicts         string
+	Runs                string
+	ScenarioBaselines   string
+	Scenarios           string
+	Schedules           string
+	ScriptRunMigrations string
+	Scripts             string
+	SimpleScripts       string
+	Statistic           string
+	StatisticDump       string
+	StopConditions      string
+	Traces              string
 }{
-	Agent:         "agent",
-	Command:       "command",
-	GroupLogs:     "group_logs",
-	GroupsPage:    "groups_page",
-	Projects:      "projects",
-	RunReport:     "run_report",
-	Runs:          "runs",
-	Scenarios:     "scenarios",
-	Scripts:       "scripts",
-	SimpleScripts: "simple_scripts",
-	Statistic:     "statistic",
-	StatisticDump: "statistic_dump",
-	Traces:        "traces",
+	Agent:               "agent",
+	AgentReservations:   "agent_reservations",
+	CapacitySearches:    "capacity_searches",
+	Command:             "command",
+	GroupLogs:           "group_logs",
+	GroupsPage:          "groups_page",
+	Projects:            "projects",
+	RunPauses:           "run_pauses",
+	RunReport:           "run_report",
+	RunVerdicts:         "run_verdicts",
+	Runs:                "runs",
+	ScenarioBaselines:   "scenario_baselines",
+	Scenarios:           "scenarios",
+	Schedules:           "schedules",
+	ScriptRunMigrations: "script_run_migrations",
+	Scripts:             "scripts",
+	SimpleScripts:       "simple_scripts",
+	Statistic:           "statistic",
+	StatisticDump:       "statistic_dump",
+	StopConditions:      "stop_conditions",
+	Traces:              "traces",
 }
@@ -51,6 +51,23 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for CapacitySearchStatus
+const (
+	CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED  string = "STATUS_SEARCHING_UNSPECIFIED"
+	CapacitySearchStatusSTATUS_LIMIT_REACHED          string = "STATUS_LIMIT_REACHED"
+	CapacitySearchStatusSTATUS_MAX_PERCENTAGE_REACHED string = "STATUS_MAX_PERCENTAGE_REACHED"
+	CapacitySearchStatusSTATUS_INTERRUPTED            string = "STATUS_INTERRUPTED"
+)
+
+func AllCapacitySearchStatus() []string {
+	return []string{
+		CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED,
+		CapacitySearchStatusSTATUS_LIMIT_REACHED,
+		CapacitySearchStatusSTATUS_MAX_PERCENTAGE_REACHED,
+		CapacitySearchStatusSTATUS_INTERRUPTED,
+	}
+}
+
 // Enum values for Cmdtype
 const (
 	CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED string = "TYPE_RUN_SCENARIO_UNSPECIFIED"
@@ -61,6 +78,8 @@ const (
 	CmdtypeTYPE_ADJUSTMENT               string = "TYPE_ADJUSTMENT"
 	CmdtypeTYPE_INCREASE                 string = "TYPE_INCREASE"
 	CmdtypeTYPE_RUN_SIMPLE_SCRIPT        string = "TYPE_RUN_SIMPLE_SCRIPT"
+	CmdtypeTYPE_PAUSE_SCENARIO           string = "TYPE_PAUSE_SCENARIO"
+	CmdtypeTYPE_RESUME_SCENARIO          string = "TYPE_RESUME_SCENARIO"
 )
 
 func AllCmdtype() []string {
@@ -73,6 +92,8 @@ func AllCmdtype() []string {
 		CmdtypeTYPE_ADJUSTMENT,
 		CmdtypeTYPE_INCREASE,
 		CmdtypeTYPE_RUN_SIMPLE_SCRIPT,
+		CmdtypeTYPE_PAUSE_SCENARIO,
+		CmdtypeTYPE_RESUME_SCENARIO,
 	}
 }
 
@@ -125,6 +146,21 @@ func AllRunReportStatus() []string {
 	}
 }
 
+// Enum values for RunVerdict
+const (
+	RunVerdictVERDICT_UNSPECIFIED string = "VERDICT_UNSPECIFIED"
+	RunVerdictVERDICT_PASS        string = "VERDICT_PASS"
+	RunVerdictVERDICT_FAIL        string = "VERDICT_FAIL"
+)
+
+func AllRunVerdict() []string {
+	return []string{
+		RunVerdictVERDICT_UNSPECIFIED,
+		RunVerdictVERDICT_PASS,
+		RunVerdictVERDICT_FAIL,
+	}
+}
+
 // Enum values for Estatus
 const (
 	EstatusSTATUS_STOPPED_UNSPECIFIED string = "STATUS_STOPPED_UNSPECIFIED"
@@ -132,6 +168,7 @@ const (
 	EstatusSTATUS_RUNNING             string = "STATUS_RUNNING"
 	EstatusSTATUS_SCHEDULED           string = "STATUS_SCHEDULED"
 	EstatusSTATUS_STOPPING            string = "STATUS_STOPPING"
+	EstatusSTATUS_PAUSED              string = "STATUS_PAUSED"
 )
 
 func AllEstatus() []string {
@@ -141,6 +178,7 @@ func AllEstatus() []string {
 		EstatusSTATUS_RUNNING,
 		EstatusSTATUS_SCHEDULED,
 		EstatusSTATUS_STOPPING,
+		EstatusSTATUS_PAUSED,
 	}
 }
 
@@ -179,3 +217,39 @@ func AllEhttpMethod() []string {
 		EhttpMethodDel,
 	}
 }
+
+// Enum values for StopMetric
+const (
+	StopMetricMETRIC_RT_95_P_UNSPECIFIED string = "METRIC_RT_95_P_UNSPECIFIED"
+	StopMetricMETRIC_RT_90_P             string = "METRIC_RT_90_P"
+	StopMetricMETRIC_RT_99_P             string = "METRIC_RT_99_P"
+	StopMetricMETRIC_RT_MAX              string = "METRIC_RT_MAX"
+	StopMetricMETRIC_RPS                 string = "METRIC_RPS"
+	StopMetricMETRIC_FAILED              string = "METRIC_FAILED"
+	StopMetricMETRIC_FAILED_RATE         string = "METRIC_FAILED_RATE"
+)
+
+func AllStopMetric() []string {
+	return []string{
+		StopMetricMETRIC_RT_95_P_UNSPECIFIED,
+		StopMetricMETRIC_RT_90_P,
+		StopMetricMETRIC_RT_99_P,
+		StopMetricMETRIC_RT_MAX,
+		StopMetricMETRIC_RPS,
+		StopMetricMETRIC_FAILED,
+		StopMetricMETRIC_FAILED_RATE,
+	}
+}
+
+// Enum values for StopOperator
+const (
+	StopOperatorOPERATOR_GREATER_UNSPECIFIED string = "OPERATOR_GREATER_UNSPECIFIED"
+	StopOperatorOPERATOR_LESS                string = "OPERATOR_LESS"
+)
+
+func AllStopOperator() []string {
+	return []string{
+		StopOperatorOPERATOR_GREATER_UNSPECIFIED,
+		StopOperatorOPERATOR_LESS,
+	}
+}
@@ -0,0 +1,1299 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// CapacitySearch is an object representing the database table.
+type CapacitySearch struct {
+	CapacitySearchID  int32     `boil:"capacity_search_id" json:"capacity_search_id" toml:"capacity_search_id" yaml:"capacity_search_id"`
+	RunID             int32     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	ScenarioID        int32     `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
+	StartPercentage   int32     `boil:"start_percentage" json:"start_percentage" toml:"start_percentage" yaml:"start_percentage"`
+	StepPercentage    int32     `boil:"step_percentage" json:"step_percentage" toml:"step_percentage" yaml:"step_percentage"`
+	MaxPercentage     int32     `boil:"max_percentage" json:"max_percentage" toml:"max_percentage" yaml:"max_percentage"`
+	StepDurationSec   int32     `boil:"step_duration_sec" json:"step_duration_sec" toml:"step_duration_sec" yaml:"step_duration_sec"`
+	MaxRT95P          int32     `boil:"max_rt_95_p" json:"max_rt_95_p" toml:"max_rt_95_p" yaml:"max_rt_95_p"`
+	MaxFailedRate     float64   `boil:"max_failed_rate" json:"max_failed_rate" toml:"max_failed_rate" yaml:"max_failed_rate"`
+	Status            string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CurrentPercentage int32     `boil:"current_percentage" json:"current_percentage" toml:"current_percentage" yaml:"current_percentage"`
+	PassedPercentage  int32     `boil:"passed_percentage" json:"passed_percentage" toml:"passed_percentage" yaml:"passed_percentage"`
+	PassedRPS         int32     `boil:"passed_rps" json:"passed_rps" toml:"passed_rps" yaml:"passed_rps"`
+	StepStartedAt     time.Time `boil:"step_started_at" json:"step_started_at" toml:"step_started_at" yaml:"step_started_at"`
+	Info              string    `boil:"info" json:"info" toml:"info" yaml:"info"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt         null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	BreachSustainSec  int32     `boil:"breach_sustain_sec" json:"breach_sustain_sec" toml:"breach_sustain_sec" yaml:"breach_sustain_sec"`
+
+	R *capacitySearchR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L capacitySearchL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CapacitySearchColumns = struct {
+	CapacitySearchID  string
+	RunID             string
+	ScenarioID        string
+	StartPercentage   string
+	StepPercentage    string
+	MaxPercentage     string
+	StepDurationSec   string
+	MaxRT95P          string
+	MaxFailedRate     string
+	Status            string
+	CurrentPercentage string
+	PassedPercentage  string
+	PassedRPS         string
+	StepStartedAt     string
+	Info              string
+	CreatedAt         string
+	UpdatedAt         string
+	DeletedAt         string
+	BreachSustainSec  string
+}{
+	CapacitySearchID:  "capacity_search_id",
+	RunID:             "run_id",
+	ScenarioID:        "scenario_id",
+	StartPercentage:   "start_percentage",
+	StepPercentage:    "step_percentage",
+	MaxPercentage:     "max_percentage",
+	StepDurationSec:   "step_duration_sec",
+	MaxRT95P:          "max_rt_95_p",
+	MaxFailedRate:     "max_failed_rate",
+	Status:            "status",
+	CurrentPercentage: "current_percentage",
+	PassedPercentage:  "passed_percentage",
+	PassedRPS:         "passed_rps",
+	StepStartedAt:     "step_started_at",
+	Info:              "info",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	DeletedAt:         "deleted_at",
+	BreachSustainSec:  "breach_sustain_sec",
+}
+
+var CapacitySearchTableColumns = struct {
+	CapacitySearchID  string
+	RunID             string
+	ScenarioID        string
+	StartPercentage   string
+	StepPercentage    string
+	MaxPercentage     string
+	StepDurationSec   string
+	MaxRT95P          string
+	MaxFailedRate     string
+	Status            string
+	CurrentPercentage string
+	PassedPercentage  string
+	PassedRPS         string
+	StepStartedAt     string
+	Info              string
+	CreatedAt         string
+	UpdatedAt         string
+	DeletedAt         string
+	BreachSustainSec  string
+}{
+	CapacitySearchID:  "capacity_searches.capacity_search_id",
+	RunID:             "capacity_searches.run_id",
+	ScenarioID:        "capacity_searches.scenario_id",
+	StartPercentage:   "capacity_searches.start_percentage",
+	StepPercentage:    "capacity_searches.step_percentage",
+	MaxPercentage:     "capacity_searches.max_percentage",
+	StepDurationSec:   "capacity_searches.step_duration_sec",
+	MaxRT95P:          "capacity_searches.max_rt_95_p",
+	MaxFailedRate:     "capacity_searches.max_failed_rate",
+	Status:            "capacity_searches.status",
+	CurrentPercentage: "capacity_searches.current_percentage",
+	PassedPercentage:  "capacity_searches.passed_percentage",
+	PassedRPS:         "capacity_searches.passed_rps",
+	StepStartedAt:     "capacity_searches.step_started_at",
+	Info:              "capacity_searches.info",
+	CreatedAt:         "capacity_searches.created_at",
+	UpdatedAt:         "capacity_searches.updated_at",
+	DeletedAt:         "capacity_searches.deleted_at",
+	BreachSustainSec:  "capacity_searches.breach_sustain_sec",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var CapacitySearchWhere = struct {
+	CapacitySearchID  whereHelperint32
+	RunID             whereHelperint32
+	ScenarioID        whereHelperint32
+	StartPercentage   whereHelperint32
+	StepPercentage    whereHelperint32
+	MaxPercentage     whereHelperint32
+	StepDurationSec   whereHelperint32
+	MaxRT95P          whereHelperint32
+	MaxFailedRate     whereHelperfloat64
+	Status            whereHelperstring
+	CurrentPercentage whereHelperint32
+	PassedPercentage  whereHelperint32
+	PassedRPS         whereHelperint32
+	StepStartedAt     whereHelpertime_Time
+	Info              whereHelperstring
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	DeletedAt         whereHelpernull_Time
+	BreachSustainSec  whereHelperint32
+}{
+	CapacitySearchID:  whereHelperint32{field: "\"capacity_searches\".\"capacity_search_id\""},
+	RunID:             whereHelperint32{field: "\"capacity_searches\".\"run_id\""},
+	ScenarioID:        whereHelperint32{field: "\"capacity_searches\".\"scenario_id\""},
+	StartPercentage:   whereHelperint32{field: "\"capacity_searches\".\"start_percentage\""},
+	StepPercentage:    whereHelperint32{field: "\"capacity_searches\".\"step_percentage\""},
+	MaxPercentage:     whereHelperint32{field: "\"capacity_searches\".\"max_percentage\""},
+	StepDurationSec:   whereHelperint32{field: "\"capacity_searches\".\"step_duration_sec\""},
+	MaxRT95P:          whereHelperint32{field: "\"capacity_searches\".\"max_rt_95_p\""},
+	MaxFailedRate:     whereHelperfloat64{field: "\"capacity_searches\".\"max_failed_rate\""},
+	Status:            whereHelperstring{field: "\"capacity_searches\".\"status\""},
+	CurrentPercentage: whereHelperint32{field: "\"capacity_searches\".\"current_percentage\""},
+	PassedPercentage:  whereHelperint32{field: "\"capacity_searches\".\"passed_percentage\""},
+	PassedRPS:         whereHelperint32{field: "\"capacity_searches\".\"passed_rps\""},
+	StepStartedAt:     whereHelpertime_Time{field: "\"capacity_searches\".\"step_started_at\""},
+	Info:              whereHelperstring{field: "\"capacity_searches\".\"info\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"capacity_searches\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"capacity_searches\".\"updated_at\""},
+	DeletedAt:         whereHelpernull_Time{field: "\"capacity_searches\".\"deleted_at\""},
+	BreachSustainSec:  whereHelperint32{field: "\"capacity_searches\".\"breach_sustain_sec\""},
+}
+
+// CapacitySearchRels is where relationship names are stored.
+var CapacitySearchRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// capacitySearchR is where relationships are stored.
+type capacitySearchR struct {
+	Run *Run `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*capacitySearchR) NewStruct() *capacitySearchR {
+	return &capacitySearchR{}
+}
+
+func (o *CapacitySearch) GetRun() *Run {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRun()
+}
+
+func (r *capacitySearchR) GetRun() *Run {
+	if r == nil {
+		return nil
+	}
+
+	return r.Run
+}
+
+// capacitySearchL is where Load methods for each relationship are stored.
+type capacitySearchL struct{}
+
+var (
+	capacitySearchAllColumns            = []string{"capacity_search_id", "run_id", "scenario_id", "start_percentage", "step_percentage", "max_percentage", "step_duration_sec", "max_rt_95_p", "max_failed_rate", "status", "current_percentage", "passed_percentage", "passed_rps", "step_started_at", "info", "created_at", "updated_at", "deleted_at", "breach_sustain_sec"}
+	capacitySearchColumnsWithoutDefault = []string{"run_id", "scenario_id"}
+	capacitySearchColumnsWithDefault    = []string{"capacity_search_id", "start_percentage", "step_percentage", "max_percentage", "step_duration_sec", "max_rt_95_p", "max_failed_rate", "status", "current_percentage", "passed_percentage", "passed_rps", "step_started_at", "info", "created_at", "updated_at", "deleted_at", "breach_sustain_sec"}
+	capacitySearchPrimaryKeyColumns     = []string{"capacity_search_id"}
+	capacitySearchGeneratedColumns      = []string{}
+)
+
+type (
+	// CapacitySearchSlice is an alias for a slice of pointers to CapacitySearch.
+	// This should almost always be used instead of []CapacitySearch.
+	CapacitySearchSlice []*CapacitySearch
+	// CapacitySearchHook is the signature for custom CapacitySearch hook methods
+	CapacitySearchHook func(context.Context, boil.ContextExecutor, *CapacitySearch) error
+
+	capacitySearchQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	capacitySearchType                 = reflect.TypeOf(&CapacitySearch{})
+	capacitySearchMapping              = queries.MakeStructMapping(capacitySearchType)
+	capacitySearchPrimaryKeyMapping, _ = queries.BindMapping(capacitySearchType, capacitySearchMapping, capacitySearchPrimaryKeyColumns)
+	capacitySearchInsertCacheMut       sync.RWMutex
+	capacitySearchInsertCache          = make(map[string]insertCache)
+	capacitySearchUpdateCacheMut       sync.RWMutex
+	capacitySearchUpdateCache          = make(map[string]updateCache)
+	capacitySearchUpsertCacheMut       sync.RWMutex
+	capacitySearchUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var capacitySearchAfterSelectMu sync.Mutex
+var capacitySearchAfterSelectHooks []CapacitySearchHook
+
+var capacitySearchBeforeInsertMu sync.Mutex
+var capacitySearchBeforeInsertHooks []CapacitySearchHook
+var capacitySearchAfterInsertMu sync.Mutex
+var capacitySearchAfterInsertHooks []CapacitySearchHook
+
+var capacitySearchBeforeUpdateMu sync.Mutex
+var capacitySearchBeforeUpdateHooks []CapacitySearchHook
+var capacitySearchAfterUpdateMu sync.Mutex
+var capacitySearchAfterUpdateHooks []CapacitySearchHook
+
+var capacitySearchBeforeDeleteMu sync.Mutex
+var capacitySearchBeforeDeleteHooks []CapacitySearchHook
+var capacitySearchAfterDeleteMu sync.Mutex
+var capacitySearchAfterDeleteHooks []CapacitySearchHook
+
+var capacitySearchBeforeUpsertMu sync.Mutex
+var capacitySearchBeforeUpsertHooks []CapacitySearchHook
+var capacitySearchAfterUpsertMu sync.Mutex
+var capacitySearchAfterUpsertHooks []CapacitySearchHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CapacitySearch) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CapacitySearch) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CapacitySearch) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CapacitySearch) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CapacitySearch) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CapacitySearch) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CapacitySearch) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CapacitySearch) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CapacitySearch) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range capacitySearchAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCapacitySearchHook registers your hook function for all future operations.
+func AddCapacitySearchHook(hookPoint boil.HookPoint, capacitySearchHook CapacitySearchHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		capacitySearchAfterSelectMu.Lock()
+		capacitySearchAfterSelectHooks = append(capacitySearchAfterSelectHooks, capacitySearchHook)
+		capacitySearchAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		capacitySearchBeforeInsertMu.Lock()
+		capacitySearchBeforeInsertHooks = append(capacitySearchBeforeInsertHooks, capacitySearchHook)
+		capacitySearchBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		capacitySearchAfterInsertMu.Lock()
+		capacitySearchAfterInsertHooks = append(capacitySearchAfterInsertHooks, capacitySearchHook)
+		capacitySearchAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		capacitySearchBeforeUpdateMu.Lock()
+		capacitySearchBeforeUpdateHooks = append(capacitySearchBeforeUpdateHooks, capacitySearchHook)
+		capacitySearchBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		capacitySearchAfterUpdateMu.Lock()
+		capacitySearchAfterUpdateHooks = append(capacitySearchAfterUpdateHooks, capacitySearchHook)
+		capacitySearchAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		capacitySearchBeforeDeleteMu.Lock()
+		capacitySearchBeforeDeleteHooks = append(capacitySearchBeforeDeleteHooks, capacitySearchHook)
+		capacitySearchBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		capacitySearchAfterDeleteMu.Lock()
+		capacitySearchAfterDeleteHooks = append(capacitySearchAfterDeleteHooks, capacitySearchHook)
+		capacitySearchAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		capacitySearchBeforeUpsertMu.Lock()
+		capacitySearchBeforeUpsertHooks = append(capacitySearchBeforeUpsertHooks, capacitySearchHook)
+		capacitySearchBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		capacitySearchAfterUpsertMu.Lock()
+		capacitySearchAfterUpsertHooks = append(capacitySearchAfterUpsertHooks, capacitySearchHook)
+		capacitySearchAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single capacitySearch record from the query.
+func (q capacitySearchQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CapacitySearch, error) {
+	o := &CapacitySearch{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for capacity_searches")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CapacitySearch records from the query.
+func (q capacitySearchQuery) All(ctx context.Context, exec boil.ContextExecutor) (CapacitySearchSlice, error) {
+	var o []*CapacitySearch
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to CapacitySearch slice")
+	}
+
+	if len(capacitySearchAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CapacitySearch records in the query.
+func (q capacitySearchQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count capacity_searches rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q capacitySearchQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if capacity_searches exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *CapacitySearch) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Runs(queryMods...)
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (capacitySearchL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCapacitySearch interface{}, mods queries.Applicator) error {
+	var slice []*CapacitySearch
+	var object *CapacitySearch
+
+	if singular {
+		var ok bool
+		object, ok = maybeCapacitySearch.(*CapacitySearch)
+		if !ok {
+			object = new(CapacitySearch)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCapacitySearch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCapacitySearch))
+			}
+		}
+	} else {
+		s, ok := maybeCapacitySearch.(*[]*CapacitySearch)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCapacitySearch)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCapacitySearch))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &capacitySearchR{}
+		}
+		args[object.RunID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &capacitySearchR{}
+			}
+
+			args[obj.RunID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.CapacitySearches = append(foreign.R.CapacitySearches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.CapacitySearches = append(foreign.R.CapacitySearches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the capacitySearch to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.CapacitySearches.
+func (o *CapacitySearch) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"capacity_searches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, capacitySearchPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.CapacitySearchID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &capacitySearchR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			CapacitySearches: CapacitySearchSlice{o},
+		}
+	} else {
+		related.R.CapacitySearches = append(related.R.CapacitySearches, o)
+	}
+
+	return nil
+}
+
+// CapacitySearches retrieves all the records using an executor.
+func CapacitySearches(mods ...qm.QueryMod) capacitySearchQuery {
+	mods = append(mods, qm.From("\"capacity_searches\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"capacity_searches\".*"})
+	}
+
+	return capacitySearchQuery{q}
+}
+
+// FindCapacitySearch retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCapacitySearch(ctx context.Context, exec boil.ContextExecutor, capacitySearchID int32, selectCols ...string) (*CapacitySearch, error) {
+	capacitySearchObj := &CapacitySearch{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"capacity_searches\" where \"capacity_search_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, capacitySearchID)
+
+	err := q.Bind(ctx, exec, capacitySearchObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from capacity_searches")
+	}
+
+	if err = capacitySearchObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return capacitySearchObj, err
+	}
+
+	return capacitySearchObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CapacitySearch) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no capacity_searches provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(capacitySearchColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	capacitySearchInsertCacheMut.RLock()
+	cache, cached := capacitySearchInsertCache[key]
+	capacitySearchInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			capacitySearchAllColumns,
+			capacitySearchColumnsWithDefault,
+			capacitySearchColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(capacitySearchType, capacitySearchMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(capacitySearchType, capacitySearchMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"capacity_searches\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"capacity_searches\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into capacity_searches")
+	}
+
+	if !cached {
+		capacitySearchInsertCacheMut.Lock()
+		capacitySearchInsertCache[key] = cache
+		capacitySearchInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CapacitySearch.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CapacitySearch) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	capacitySearchUpdateCacheMut.RLock()
+	cache, cached := capacitySearchUpdateCache[key]
+	capacitySearchUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			capacitySearchAllColumns,
+			capacitySearchPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update capacity_searches, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"capacity_searches\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, capacitySearchPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(capacitySearchType, capacitySearchMapping, append(wl, capacitySearchPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update capacity_searches row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for capacity_searches")
+	}
+
+	if !cached {
+		capacitySearchUpdateCacheMut.Lock()
+		capacitySearchUpdateCache[key] = cache
+		capacitySearchUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q capacitySearchQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for capacity_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for capacity_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CapacitySearchSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), capacitySearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"capacity_searches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, capacitySearchPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in capacitySearch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all capacitySearch")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CapacitySearch) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no capacity_searches provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(capacitySearchColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	capacitySearchUpsertCacheMut.RLock()
+	cache, cached := capacitySearchUpsertCache[key]
+	capacitySearchUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			capacitySearchAllColumns,
+			capacitySearchColumnsWithDefault,
+			capacitySearchColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			capacitySearchAllColumns,
+			capacitySearchPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert capacity_searches, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(capacitySearchAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(capacitySearchPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert capacity_searches, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(capacitySearchPrimaryKeyColumns))
+			copy(conflict, capacitySearchPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"capacity_searches\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(capacitySearchType, capacitySearchMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(capacitySearchType, capacitySearchMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert capacity_searches")
+	}
+
+	if !cached {
+		capacitySearchUpsertCacheMut.Lock()
+		capacitySearchUpsertCache[key] = cache
+		capacitySearchUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CapacitySearch record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CapacitySearch) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no CapacitySearch provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), capacitySearchPrimaryKeyMapping)
+	sql := "DELETE FROM \"capacity_searches\" WHERE \"capacity_search_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from capacity_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for capacity_searches")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q capacitySearchQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no capacitySearchQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from capacity_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for capacity_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CapacitySearchSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(capacitySearchBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), capacitySearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"capacity_searches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, capacitySearchPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from capacitySearch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for capacity_searches")
+	}
+
+	if len(capacitySearchAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CapacitySearch) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCapacitySearch(ctx, exec, o.CapacitySearchID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CapacitySearchSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CapacitySearchSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), capacitySearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"capacity_searches\".* FROM \"capacity_searches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, capacitySearchPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CapacitySearchSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CapacitySearchExists checks if the CapacitySearch row exists.
+func CapacitySearchExists(ctx context.Context, exec boil.ContextExecutor, capacitySearchID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"capacity_searches\" where \"capacity_search_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, capacitySearchID)
+	}
+	row := exec.QueryRowContext(ctx, sql, capacitySearchID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if capacity_searches exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CapacitySearch row exists.
+func (o *CapacitySearch) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CapacitySearchExists(ctx, exec, o.CapacitySearchID)
+}
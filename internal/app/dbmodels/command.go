@@ -38,6 +38,12 @@ type Command struct {
 	ScriptIds          types.Int64Array `boil:"script_ids" json:"script_ids" toml:"script_ids" yaml:"script_ids"`
 	PercentageOfTarget null.Int32       `boil:"percentage_of_target" json:"percentage_of_target,omitempty" toml:"percentage_of_target" yaml:"percentage_of_target,omitempty"`
 	IncreaseRPS        int32            `boil:"increase_rps" json:"increase_rps" toml:"increase_rps" yaml:"increase_rps"`
+	LeaseOwner         string           `boil:"lease_owner" json:"lease_owner" toml:"lease_owner" yaml:"lease_owner"`
+	LeaseExpiresAt     null.Time        `boil:"lease_expires_at" json:"lease_expires_at,omitempty" toml:"lease_expires_at" yaml:"lease_expires_at,omitempty"`
+	Attempts           int32            `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	UserName           string           `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	StartedAt          null.Time        `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt         null.Time        `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
 
 	R *commandR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L commandL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -57,6 +63,12 @@ var CommandColumns = struct {
 	ScriptIds          string
 	PercentageOfTarget string
 	IncreaseRPS        string
+	LeaseOwner         string
+	LeaseExpiresAt     string
+	Attempts           string
+	UserName           string
+	StartedAt          string
+	FinishedAt         string
 }{
 	CommandID:          "command_id",
 	Type:               "type",
@@ -71,6 +83,12 @@ var CommandColumns = struct {
 	ScriptIds:          "script_ids",
 	PercentageOfTarget: "percentage_of_target",
 	IncreaseRPS:        "increase_rps",
+	LeaseOwner:         "lease_owner",
+	LeaseExpiresAt:     "lease_expires_at",
+	Attempts:           "attempts",
+	UserName:           "user_name",
+	StartedAt:          "started_at",
+	FinishedAt:         "finished_at",
 }
 
 var CommandTableColumns = struct {
@@ -87,6 +105,12 @@ var CommandTableColumns = struct {
 	ScriptIds          string
 	PercentageOfTarget string
 	IncreaseRPS        string
+	LeaseOwner         string
+	LeaseExpiresAt     string
+	Attempts           string
+	UserName           string
+	StartedAt          string
+	FinishedAt         string
 }{
 	CommandID:          "command.command_id",
 	Type:               "command.type",
@@ -101,6 +125,12 @@ var CommandTableColumns = struct {
 	ScriptIds:          "command.script_ids",
 	PercentageOfTarget: "command.percentage_of_target",
 	IncreaseRPS:        "command.increase_rps",
+	LeaseOwner:         "command.lease_owner",
+	LeaseExpiresAt:     "command.lease_expires_at",
+	Attempts:           "command.attempts",
+	UserName:           "command.user_name",
+	StartedAt:          "command.started_at",
+	FinishedAt:         "command.finished_at",
 }
 
 // Generated where
@@ -126,44 +156,6 @@ func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpernull_Int32 struct{ field string }
-
-func (w whereHelpernull_Int32) EQ(x null.Int32) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int32) NEQ(x null.Int32) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int32) LT(x null.Int32) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int32) LTE(x null.Int32) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int32) GT(x null.Int32) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int32) GTE(x null.Int32) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int32) IN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int32) NIN(slice []int32) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var CommandWhere = struct {
 	CommandID          whereHelperint32
 	Type               whereHelperstring
@@ -178,6 +170,12 @@ var CommandWhere = struct {
 	ScriptIds          whereHelpertypes_Int64Array
 	PercentageOfTarget whereHelpernull_Int32
 	IncreaseRPS        whereHelperint32
+	LeaseOwner         whereHelperstring
+	LeaseExpiresAt     whereHelpernull_Time
+	Attempts           whereHelperint32
+	UserName           whereHelperstring
+	StartedAt          whereHelpernull_Time
+	FinishedAt         whereHelpernull_Time
 }{
 	CommandID:          whereHelperint32{field: "\"command\".\"command_id\""},
 	Type:               whereHelperstring{field: "\"command\".\"type\""},
@@ -192,6 +190,12 @@ var CommandWhere = struct {
 	ScriptIds:          whereHelpertypes_Int64Array{field: "\"command\".\"script_ids\""},
 	PercentageOfTarget: whereHelpernull_Int32{field: "\"command\".\"percentage_of_target\""},
 	IncreaseRPS:        whereHelperint32{field: "\"command\".\"increase_rps\""},
+	LeaseOwner:         whereHelperstring{field: "\"command\".\"lease_owner\""},
+	LeaseExpiresAt:     whereHelpernull_Time{field: "\"command\".\"lease_expires_at\""},
+	Attempts:           whereHelperint32{field: "\"command\".\"attempts\""},
+	UserName:           whereHelperstring{field: "\"command\".\"user_name\""},
+	StartedAt:          whereHelpernull_Time{field: "\"command\".\"started_at\""},
+	FinishedAt:         whereHelpernull_Time{field: "\"command\".\"finished_at\""},
 }
 
 // CommandRels is where relationship names are stored.
@@ -231,9 +235,9 @@ func (r *commandR) GetRun() *Run {
 type commandL struct{}
 
 var (
-	commandAllColumns            = []string{"command_id", "type", "scope", "run_id", "status", "error_description", "hostname", "created_at", "updated_at", "deleted_at", "script_ids", "percentage_of_target", "increase_rps"}
+	commandAllColumns            = []string{"command_id", "type", "scope", "run_id", "status", "error_description", "hostname", "created_at", "updated_at", "deleted_at", "script_ids", "percentage_of_target", "increase_rps", "lease_owner", "lease_expires_at", "attempts", "user_name", "started_at", "finished_at"}
 	commandColumnsWithoutDefault = []string{"run_id", "error_description", "hostname"}
-	commandColumnsWithDefault    = []string{"command_id", "type", "scope", "status", "created_at", "updated_at", "deleted_at", "script_ids", "percentage_of_target", "increase_rps"}
+	commandColumnsWithDefault    = []string{"command_id", "type", "scope", "status", "created_at", "updated_at", "deleted_at", "script_ids", "percentage_of_target", "increase_rps", "lease_owner", "lease_expires_at", "attempts", "user_name", "started_at", "finished_at"}
 	commandPrimaryKeyColumns     = []string{"command_id"}
 	commandGeneratedColumns      = []string{}
 )
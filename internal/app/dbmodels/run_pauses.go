@@ -0,0 +1,1207 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RunPause is an object representing the database table.
+type RunPause struct {
+	RunPauseID         int32     `boil:"run_pause_id" json:"run_pause_id" toml:"run_pause_id" yaml:"run_pause_id"`
+	RunID              int32     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	PercentageOfTarget int32     `boil:"percentage_of_target" json:"percentage_of_target" toml:"percentage_of_target" yaml:"percentage_of_target"`
+	ScriptRuns         string    `boil:"script_runs" json:"script_runs" toml:"script_runs" yaml:"script_runs"`
+	UserName           string    `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	PausedAt           time.Time `boil:"paused_at" json:"paused_at" toml:"paused_at" yaml:"paused_at"`
+	ResumedAt          null.Time `boil:"resumed_at" json:"resumed_at,omitempty" toml:"resumed_at" yaml:"resumed_at,omitempty"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *runPauseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L runPauseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RunPauseColumns = struct {
+	RunPauseID         string
+	RunID              string
+	PercentageOfTarget string
+	ScriptRuns         string
+	UserName           string
+	PausedAt           string
+	ResumedAt          string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	RunPauseID:         "run_pause_id",
+	RunID:              "run_id",
+	PercentageOfTarget: "percentage_of_target",
+	ScriptRuns:         "script_runs",
+	UserName:           "user_name",
+	PausedAt:           "paused_at",
+	ResumedAt:          "resumed_at",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
+}
+
+var RunPauseTableColumns = struct {
+	RunPauseID         string
+	RunID              string
+	PercentageOfTarget string
+	ScriptRuns         string
+	UserName           string
+	PausedAt           string
+	ResumedAt          string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	RunPauseID:         "run_pauses.run_pause_id",
+	RunID:              "run_pauses.run_id",
+	PercentageOfTarget: "run_pauses.percentage_of_target",
+	ScriptRuns:         "run_pauses.script_runs",
+	UserName:           "run_pauses.user_name",
+	PausedAt:           "run_pauses.paused_at",
+	ResumedAt:          "run_pauses.resumed_at",
+	CreatedAt:          "run_pauses.created_at",
+	UpdatedAt:          "run_pauses.updated_at",
+	DeletedAt:          "run_pauses.deleted_at",
+}
+
+// Generated where
+
+var RunPauseWhere = struct {
+	RunPauseID         whereHelperint32
+	RunID              whereHelperint32
+	PercentageOfTarget whereHelperint32
+	ScriptRuns         whereHelperstring
+	UserName           whereHelperstring
+	PausedAt           whereHelpertime_Time
+	ResumedAt          whereHelpernull_Time
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+}{
+	RunPauseID:         whereHelperint32{field: "\"run_pauses\".\"run_pause_id\""},
+	RunID:              whereHelperint32{field: "\"run_pauses\".\"run_id\""},
+	PercentageOfTarget: whereHelperint32{field: "\"run_pauses\".\"percentage_of_target\""},
+	ScriptRuns:         whereHelperstring{field: "\"run_pauses\".\"script_runs\""},
+	UserName:           whereHelperstring{field: "\"run_pauses\".\"user_name\""},
+	PausedAt:           whereHelpertime_Time{field: "\"run_pauses\".\"paused_at\""},
+	ResumedAt:          whereHelpernull_Time{field: "\"run_pauses\".\"resumed_at\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"run_pauses\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"run_pauses\".\"updated_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"run_pauses\".\"deleted_at\""},
+}
+
+// RunPauseRels is where relationship names are stored.
+var RunPauseRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// runPauseR is where relationships are stored.
+type runPauseR struct {
+	Run *Run `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*runPauseR) NewStruct() *runPauseR {
+	return &runPauseR{}
+}
+
+func (o *RunPause) GetRun() *Run {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRun()
+}
+
+func (r *runPauseR) GetRun() *Run {
+	if r == nil {
+		return nil
+	}
+
+	return r.Run
+}
+
+// runPauseL is where Load methods for each relationship are stored.
+type runPauseL struct{}
+
+var (
+	runPauseAllColumns            = []string{"run_pause_id", "run_id", "percentage_of_target", "script_runs", "user_name", "paused_at", "resumed_at", "created_at", "updated_at", "deleted_at"}
+	runPauseColumnsWithoutDefault = []string{"run_id"}
+	runPauseColumnsWithDefault    = []string{"run_pause_id", "percentage_of_target", "script_runs", "user_name", "paused_at", "resumed_at", "created_at", "updated_at", "deleted_at"}
+	runPausePrimaryKeyColumns     = []string{"run_pause_id"}
+	runPauseGeneratedColumns      = []string{}
+)
+
+type (
+	// RunPauseSlice is an alias for a slice of pointers to RunPause.
+	// This should almost always be used instead of []RunPause.
+	RunPauseSlice []*RunPause
+	// RunPauseHook is the signature for custom RunPause hook methods
+	RunPauseHook func(context.Context, boil.ContextExecutor, *RunPause) error
+
+	runPauseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	runPauseType                 = reflect.TypeOf(&RunPause{})
+	runPauseMapping              = queries.MakeStructMapping(runPauseType)
+	runPausePrimaryKeyMapping, _ = queries.BindMapping(runPauseType, runPauseMapping, runPausePrimaryKeyColumns)
+	runPauseInsertCacheMut       sync.RWMutex
+	runPauseInsertCache          = make(map[string]insertCache)
+	runPauseUpdateCacheMut       sync.RWMutex
+	runPauseUpdateCache          = make(map[string]updateCache)
+	runPauseUpsertCacheMut       sync.RWMutex
+	runPauseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var runPauseAfterSelectMu sync.Mutex
+var runPauseAfterSelectHooks []RunPauseHook
+
+var runPauseBeforeInsertMu sync.Mutex
+var runPauseBeforeInsertHooks []RunPauseHook
+var runPauseAfterInsertMu sync.Mutex
+var runPauseAfterInsertHooks []RunPauseHook
+
+var runPauseBeforeUpdateMu sync.Mutex
+var runPauseBeforeUpdateHooks []RunPauseHook
+var runPauseAfterUpdateMu sync.Mutex
+var runPauseAfterUpdateHooks []RunPauseHook
+
+var runPauseBeforeDeleteMu sync.Mutex
+var runPauseBeforeDeleteHooks []RunPauseHook
+var runPauseAfterDeleteMu sync.Mutex
+var runPauseAfterDeleteHooks []RunPauseHook
+
+var runPauseBeforeUpsertMu sync.Mutex
+var runPauseBeforeUpsertHooks []RunPauseHook
+var runPauseAfterUpsertMu sync.Mutex
+var runPauseAfterUpsertHooks []RunPauseHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RunPause) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RunPause) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RunPause) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RunPause) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RunPause) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RunPause) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RunPause) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RunPause) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RunPause) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runPauseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRunPauseHook registers your hook function for all future operations.
+func AddRunPauseHook(hookPoint boil.HookPoint, runPauseHook RunPauseHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		runPauseAfterSelectMu.Lock()
+		runPauseAfterSelectHooks = append(runPauseAfterSelectHooks, runPauseHook)
+		runPauseAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		runPauseBeforeInsertMu.Lock()
+		runPauseBeforeInsertHooks = append(runPauseBeforeInsertHooks, runPauseHook)
+		runPauseBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		runPauseAfterInsertMu.Lock()
+		runPauseAfterInsertHooks = append(runPauseAfterInsertHooks, runPauseHook)
+		runPauseAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		runPauseBeforeUpdateMu.Lock()
+		runPauseBeforeUpdateHooks = append(runPauseBeforeUpdateHooks, runPauseHook)
+		runPauseBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		runPauseAfterUpdateMu.Lock()
+		runPauseAfterUpdateHooks = append(runPauseAfterUpdateHooks, runPauseHook)
+		runPauseAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		runPauseBeforeDeleteMu.Lock()
+		runPauseBeforeDeleteHooks = append(runPauseBeforeDeleteHooks, runPauseHook)
+		runPauseBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		runPauseAfterDeleteMu.Lock()
+		runPauseAfterDeleteHooks = append(runPauseAfterDeleteHooks, runPauseHook)
+		runPauseAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		runPauseBeforeUpsertMu.Lock()
+		runPauseBeforeUpsertHooks = append(runPauseBeforeUpsertHooks, runPauseHook)
+		runPauseBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		runPauseAfterUpsertMu.Lock()
+		runPauseAfterUpsertHooks = append(runPauseAfterUpsertHooks, runPauseHook)
+		runPauseAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single runPause record from the query.
+func (q runPauseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RunPause, error) {
+	o := &RunPause{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for run_pauses")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RunPause records from the query.
+func (q runPauseQuery) All(ctx context.Context, exec boil.ContextExecutor) (RunPauseSlice, error) {
+	var o []*RunPause
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RunPause slice")
+	}
+
+	if len(runPauseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RunPause records in the query.
+func (q runPauseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count run_pauses rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q runPauseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if run_pauses exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *RunPause) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Runs(queryMods...)
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (runPauseL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRunPause interface{}, mods queries.Applicator) error {
+	var slice []*RunPause
+	var object *RunPause
+
+	if singular {
+		var ok bool
+		object, ok = maybeRunPause.(*RunPause)
+		if !ok {
+			object = new(RunPause)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRunPause)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRunPause))
+			}
+		}
+	} else {
+		s, ok := maybeRunPause.(*[]*RunPause)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRunPause)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRunPause))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runPauseR{}
+		}
+		args[object.RunID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runPauseR{}
+			}
+
+			args[obj.RunID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.RunPauses = append(foreign.R.RunPauses, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.RunPauses = append(foreign.R.RunPauses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the runPause to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.RunPauses.
+func (o *RunPause) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"run_pauses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, runPausePrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.RunPauseID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &runPauseR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			RunPauses: RunPauseSlice{o},
+		}
+	} else {
+		related.R.RunPauses = append(related.R.RunPauses, o)
+	}
+
+	return nil
+}
+
+// RunPauses retrieves all the records using an executor.
+func RunPauses(mods ...qm.QueryMod) runPauseQuery {
+	mods = append(mods, qm.From("\"run_pauses\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"run_pauses\".*"})
+	}
+
+	return runPauseQuery{q}
+}
+
+// FindRunPause retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRunPause(ctx context.Context, exec boil.ContextExecutor, runPauseID int32, selectCols ...string) (*RunPause, error) {
+	runPauseObj := &RunPause{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"run_pauses\" where \"run_pause_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, runPauseID)
+
+	err := q.Bind(ctx, exec, runPauseObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from run_pauses")
+	}
+
+	if err = runPauseObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return runPauseObj, err
+	}
+
+	return runPauseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RunPause) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no run_pauses provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(runPauseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	runPauseInsertCacheMut.RLock()
+	cache, cached := runPauseInsertCache[key]
+	runPauseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			runPauseAllColumns,
+			runPauseColumnsWithDefault,
+			runPauseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(runPauseType, runPauseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(runPauseType, runPauseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"run_pauses\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"run_pauses\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into run_pauses")
+	}
+
+	if !cached {
+		runPauseInsertCacheMut.Lock()
+		runPauseInsertCache[key] = cache
+		runPauseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RunPause.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RunPause) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	runPauseUpdateCacheMut.RLock()
+	cache, cached := runPauseUpdateCache[key]
+	runPauseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			runPauseAllColumns,
+			runPausePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update run_pauses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"run_pauses\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, runPausePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(runPauseType, runPauseMapping, append(wl, runPausePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update run_pauses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for run_pauses")
+	}
+
+	if !cached {
+		runPauseUpdateCacheMut.Lock()
+		runPauseUpdateCache[key] = cache
+		runPauseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q runPauseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for run_pauses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for run_pauses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RunPauseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runPausePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"run_pauses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, runPausePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in runPause slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all runPause")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RunPause) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no run_pauses provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(runPauseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	runPauseUpsertCacheMut.RLock()
+	cache, cached := runPauseUpsertCache[key]
+	runPauseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			runPauseAllColumns,
+			runPauseColumnsWithDefault,
+			runPauseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			runPauseAllColumns,
+			runPausePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert run_pauses, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(runPauseAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(runPausePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert run_pauses, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(runPausePrimaryKeyColumns))
+			copy(conflict, runPausePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"run_pauses\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(runPauseType, runPauseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(runPauseType, runPauseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert run_pauses")
+	}
+
+	if !cached {
+		runPauseUpsertCacheMut.Lock()
+		runPauseUpsertCache[key] = cache
+		runPauseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RunPause record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RunPause) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RunPause provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), runPausePrimaryKeyMapping)
+	sql := "DELETE FROM \"run_pauses\" WHERE \"run_pause_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from run_pauses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for run_pauses")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q runPauseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no runPauseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from run_pauses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for run_pauses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RunPauseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(runPauseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runPausePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"run_pauses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, runPausePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from runPause slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for run_pauses")
+	}
+
+	if len(runPauseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RunPause) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRunPause(ctx, exec, o.RunPauseID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RunPauseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RunPauseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runPausePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"run_pauses\".* FROM \"run_pauses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, runPausePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RunPauseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RunPauseExists checks if the RunPause row exists.
+func RunPauseExists(ctx context.Context, exec boil.ContextExecutor, runPauseID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"run_pauses\" where \"run_pause_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runPauseID)
+	}
+	row := exec.QueryRowContext(ctx, sql, runPauseID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if run_pauses exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RunPause row exists.
+func (o *RunPause) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RunPauseExists(ctx, exec, o.RunPauseID)
+}
@@ -0,0 +1,1193 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RunVerdict is an object representing the database table.
+type RunVerdict struct {
+	RunVerdictID  int32     `boil:"run_verdict_id" json:"run_verdict_id" toml:"run_verdict_id" yaml:"run_verdict_id"`
+	RunID         int32     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	BaselineRunID int32     `boil:"baseline_run_id" json:"baseline_run_id" toml:"baseline_run_id" yaml:"baseline_run_id"`
+	Verdict       string    `boil:"verdict" json:"verdict" toml:"verdict" yaml:"verdict"`
+	Info          string    `boil:"info" json:"info" toml:"info" yaml:"info"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt     null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *runVerdictR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L runVerdictL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RunVerdictColumns = struct {
+	RunVerdictID  string
+	RunID         string
+	BaselineRunID string
+	Verdict       string
+	Info          string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedAt     string
+}{
+	RunVerdictID:  "run_verdict_id",
+	RunID:         "run_id",
+	BaselineRunID: "baseline_run_id",
+	Verdict:       "verdict",
+	Info:          "info",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	DeletedAt:     "deleted_at",
+}
+
+var RunVerdictTableColumns = struct {
+	RunVerdictID  string
+	RunID         string
+	BaselineRunID string
+	Verdict       string
+	Info          string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedAt     string
+}{
+	RunVerdictID:  "run_verdicts.run_verdict_id",
+	RunID:         "run_verdicts.run_id",
+	BaselineRunID: "run_verdicts.baseline_run_id",
+	Verdict:       "run_verdicts.verdict",
+	Info:          "run_verdicts.info",
+	CreatedAt:     "run_verdicts.created_at",
+	UpdatedAt:     "run_verdicts.updated_at",
+	DeletedAt:     "run_verdicts.deleted_at",
+}
+
+// Generated where
+
+var RunVerdictWhere = struct {
+	RunVerdictID  whereHelperint32
+	RunID         whereHelperint32
+	BaselineRunID whereHelperint32
+	Verdict       whereHelperstring
+	Info          whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	DeletedAt     whereHelpernull_Time
+}{
+	RunVerdictID:  whereHelperint32{field: "\"run_verdicts\".\"run_verdict_id\""},
+	RunID:         whereHelperint32{field: "\"run_verdicts\".\"run_id\""},
+	BaselineRunID: whereHelperint32{field: "\"run_verdicts\".\"baseline_run_id\""},
+	Verdict:       whereHelperstring{field: "\"run_verdicts\".\"verdict\""},
+	Info:          whereHelperstring{field: "\"run_verdicts\".\"info\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"run_verdicts\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"run_verdicts\".\"updated_at\""},
+	DeletedAt:     whereHelpernull_Time{field: "\"run_verdicts\".\"deleted_at\""},
+}
+
+// RunVerdictRels is where relationship names are stored.
+var RunVerdictRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// runVerdictR is where relationships are stored.
+type runVerdictR struct {
+	Run *Run `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*runVerdictR) NewStruct() *runVerdictR {
+	return &runVerdictR{}
+}
+
+func (o *RunVerdict) GetRun() *Run {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRun()
+}
+
+func (r *runVerdictR) GetRun() *Run {
+	if r == nil {
+		return nil
+	}
+
+	return r.Run
+}
+
+// runVerdictL is where Load methods for each relationship are stored.
+type runVerdictL struct{}
+
+var (
+	runVerdictAllColumns            = []string{"run_verdict_id", "run_id", "baseline_run_id", "verdict", "info", "created_at", "updated_at", "deleted_at"}
+	runVerdictColumnsWithoutDefault = []string{"run_id", "baseline_run_id"}
+	runVerdictColumnsWithDefault    = []string{"run_verdict_id", "verdict", "info", "created_at", "updated_at", "deleted_at"}
+	runVerdictPrimaryKeyColumns     = []string{"run_verdict_id"}
+	runVerdictGeneratedColumns      = []string{}
+)
+
+type (
+	// RunVerdictSlice is an alias for a slice of pointers to RunVerdict.
+	// This should almost always be used instead of []RunVerdict.
+	RunVerdictSlice []*RunVerdict
+	// RunVerdictHook is the signature for custom RunVerdict hook methods
+	RunVerdictHook func(context.Context, boil.ContextExecutor, *RunVerdict) error
+
+	runVerdictQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	runVerdictType                 = reflect.TypeOf(&RunVerdict{})
+	runVerdictMapping              = queries.MakeStructMapping(runVerdictType)
+	runVerdictPrimaryKeyMapping, _ = queries.BindMapping(runVerdictType, runVerdictMapping, runVerdictPrimaryKeyColumns)
+	runVerdictInsertCacheMut       sync.RWMutex
+	runVerdictInsertCache          = make(map[string]insertCache)
+	runVerdictUpdateCacheMut       sync.RWMutex
+	runVerdictUpdateCache          = make(map[string]updateCache)
+	runVerdictUpsertCacheMut       sync.RWMutex
+	runVerdictUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var runVerdictAfterSelectMu sync.Mutex
+var runVerdictAfterSelectHooks []RunVerdictHook
+
+var runVerdictBeforeInsertMu sync.Mutex
+var runVerdictBeforeInsertHooks []RunVerdictHook
+var runVerdictAfterInsertMu sync.Mutex
+var runVerdictAfterInsertHooks []RunVerdictHook
+
+var runVerdictBeforeUpdateMu sync.Mutex
+var runVerdictBeforeUpdateHooks []RunVerdictHook
+var runVerdictAfterUpdateMu sync.Mutex
+var runVerdictAfterUpdateHooks []RunVerdictHook
+
+var runVerdictBeforeDeleteMu sync.Mutex
+var runVerdictBeforeDeleteHooks []RunVerdictHook
+var runVerdictAfterDeleteMu sync.Mutex
+var runVerdictAfterDeleteHooks []RunVerdictHook
+
+var runVerdictBeforeUpsertMu sync.Mutex
+var runVerdictBeforeUpsertHooks []RunVerdictHook
+var runVerdictAfterUpsertMu sync.Mutex
+var runVerdictAfterUpsertHooks []RunVerdictHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RunVerdict) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RunVerdict) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RunVerdict) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RunVerdict) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RunVerdict) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RunVerdict) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RunVerdict) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RunVerdict) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RunVerdict) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range runVerdictAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRunVerdictHook registers your hook function for all future operations.
+func AddRunVerdictHook(hookPoint boil.HookPoint, runVerdictHook RunVerdictHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		runVerdictAfterSelectMu.Lock()
+		runVerdictAfterSelectHooks = append(runVerdictAfterSelectHooks, runVerdictHook)
+		runVerdictAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		runVerdictBeforeInsertMu.Lock()
+		runVerdictBeforeInsertHooks = append(runVerdictBeforeInsertHooks, runVerdictHook)
+		runVerdictBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		runVerdictAfterInsertMu.Lock()
+		runVerdictAfterInsertHooks = append(runVerdictAfterInsertHooks, runVerdictHook)
+		runVerdictAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		runVerdictBeforeUpdateMu.Lock()
+		runVerdictBeforeUpdateHooks = append(runVerdictBeforeUpdateHooks, runVerdictHook)
+		runVerdictBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		runVerdictAfterUpdateMu.Lock()
+		runVerdictAfterUpdateHooks = append(runVerdictAfterUpdateHooks, runVerdictHook)
+		runVerdictAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		runVerdictBeforeDeleteMu.Lock()
+		runVerdictBeforeDeleteHooks = append(runVerdictBeforeDeleteHooks, runVerdictHook)
+		runVerdictBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		runVerdictAfterDeleteMu.Lock()
+		runVerdictAfterDeleteHooks = append(runVerdictAfterDeleteHooks, runVerdictHook)
+		runVerdictAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		runVerdictBeforeUpsertMu.Lock()
+		runVerdictBeforeUpsertHooks = append(runVerdictBeforeUpsertHooks, runVerdictHook)
+		runVerdictBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		runVerdictAfterUpsertMu.Lock()
+		runVerdictAfterUpsertHooks = append(runVerdictAfterUpsertHooks, runVerdictHook)
+		runVerdictAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single runVerdict record from the query.
+func (q runVerdictQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RunVerdict, error) {
+	o := &RunVerdict{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for run_verdicts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RunVerdict records from the query.
+func (q runVerdictQuery) All(ctx context.Context, exec boil.ContextExecutor) (RunVerdictSlice, error) {
+	var o []*RunVerdict
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RunVerdict slice")
+	}
+
+	if len(runVerdictAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RunVerdict records in the query.
+func (q runVerdictQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count run_verdicts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q runVerdictQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if run_verdicts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *RunVerdict) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Runs(queryMods...)
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (runVerdictL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRunVerdict interface{}, mods queries.Applicator) error {
+	var slice []*RunVerdict
+	var object *RunVerdict
+
+	if singular {
+		var ok bool
+		object, ok = maybeRunVerdict.(*RunVerdict)
+		if !ok {
+			object = new(RunVerdict)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRunVerdict)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRunVerdict))
+			}
+		}
+	} else {
+		s, ok := maybeRunVerdict.(*[]*RunVerdict)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRunVerdict)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRunVerdict))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runVerdictR{}
+		}
+		args[object.RunID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runVerdictR{}
+			}
+
+			args[obj.RunID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.RunVerdicts = append(foreign.R.RunVerdicts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.RunVerdicts = append(foreign.R.RunVerdicts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the runVerdict to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.RunVerdicts.
+func (o *RunVerdict) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"run_verdicts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, runVerdictPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.RunVerdictID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &runVerdictR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			RunVerdicts: RunVerdictSlice{o},
+		}
+	} else {
+		related.R.RunVerdicts = append(related.R.RunVerdicts, o)
+	}
+
+	return nil
+}
+
+// RunVerdicts retrieves all the records using an executor.
+func RunVerdicts(mods ...qm.QueryMod) runVerdictQuery {
+	mods = append(mods, qm.From("\"run_verdicts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"run_verdicts\".*"})
+	}
+
+	return runVerdictQuery{q}
+}
+
+// FindRunVerdict retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRunVerdict(ctx context.Context, exec boil.ContextExecutor, runVerdictID int32, selectCols ...string) (*RunVerdict, error) {
+	runVerdictObj := &RunVerdict{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"run_verdicts\" where \"run_verdict_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, runVerdictID)
+
+	err := q.Bind(ctx, exec, runVerdictObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from run_verdicts")
+	}
+
+	if err = runVerdictObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return runVerdictObj, err
+	}
+
+	return runVerdictObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RunVerdict) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no run_verdicts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(runVerdictColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	runVerdictInsertCacheMut.RLock()
+	cache, cached := runVerdictInsertCache[key]
+	runVerdictInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			runVerdictAllColumns,
+			runVerdictColumnsWithDefault,
+			runVerdictColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(runVerdictType, runVerdictMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(runVerdictType, runVerdictMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"run_verdicts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"run_verdicts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into run_verdicts")
+	}
+
+	if !cached {
+		runVerdictInsertCacheMut.Lock()
+		runVerdictInsertCache[key] = cache
+		runVerdictInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RunVerdict.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RunVerdict) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	runVerdictUpdateCacheMut.RLock()
+	cache, cached := runVerdictUpdateCache[key]
+	runVerdictUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			runVerdictAllColumns,
+			runVerdictPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update run_verdicts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"run_verdicts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, runVerdictPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(runVerdictType, runVerdictMapping, append(wl, runVerdictPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update run_verdicts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for run_verdicts")
+	}
+
+	if !cached {
+		runVerdictUpdateCacheMut.Lock()
+		runVerdictUpdateCache[key] = cache
+		runVerdictUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q runVerdictQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for run_verdicts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for run_verdicts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RunVerdictSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runVerdictPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"run_verdicts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, runVerdictPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in runVerdict slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all runVerdict")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RunVerdict) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no run_verdicts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(runVerdictColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	runVerdictUpsertCacheMut.RLock()
+	cache, cached := runVerdictUpsertCache[key]
+	runVerdictUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			runVerdictAllColumns,
+			runVerdictColumnsWithDefault,
+			runVerdictColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			runVerdictAllColumns,
+			runVerdictPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert run_verdicts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(runVerdictAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(runVerdictPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert run_verdicts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(runVerdictPrimaryKeyColumns))
+			copy(conflict, runVerdictPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"run_verdicts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(runVerdictType, runVerdictMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(runVerdictType, runVerdictMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert run_verdicts")
+	}
+
+	if !cached {
+		runVerdictUpsertCacheMut.Lock()
+		runVerdictUpsertCache[key] = cache
+		runVerdictUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RunVerdict record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RunVerdict) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RunVerdict provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), runVerdictPrimaryKeyMapping)
+	sql := "DELETE FROM \"run_verdicts\" WHERE \"run_verdict_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from run_verdicts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for run_verdicts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q runVerdictQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no runVerdictQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from run_verdicts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for run_verdicts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RunVerdictSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(runVerdictBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runVerdictPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"run_verdicts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, runVerdictPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from runVerdict slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for run_verdicts")
+	}
+
+	if len(runVerdictAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RunVerdict) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRunVerdict(ctx, exec, o.RunVerdictID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RunVerdictSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RunVerdictSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), runVerdictPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"run_verdicts\".* FROM \"run_verdicts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, runVerdictPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RunVerdictSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RunVerdictExists checks if the RunVerdict row exists.
+func RunVerdictExists(ctx context.Context, exec boil.ContextExecutor, runVerdictID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"run_verdicts\" where \"run_verdict_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runVerdictID)
+	}
+	row := exec.QueryRowContext(ctx, sql, runVerdictID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if run_verdicts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RunVerdict row exists.
+func (o *RunVerdict) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RunVerdictExists(ctx, exec, o.RunVerdictID)
+}
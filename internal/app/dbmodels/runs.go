@@ -143,17 +143,32 @@ var RunWhere = struct {
 
 // RunRels is where relationship names are stored.
 var RunRels = struct {
-	Commands   string
-	RunReports string
+	CapacitySearches    string
+	Commands            string
+	RunPauses           string
+	RunReports          string
+	RunVerdicts         string
+	ScenarioBaselines   string
+	ScriptRunMigrations string
 }{
-	Commands:   "Commands",
-	RunReports: "RunReports",
+	CapacitySearches:    "CapacitySearches",
+	Commands:            "Commands",
+	RunPauses:           "RunPauses",
+	RunReports:          "RunReports",
+	RunVerdicts:         "RunVerdicts",
+	ScenarioBaselines:   "ScenarioBaselines",
+	ScriptRunMigrations: "ScriptRunMigrations",
 }
 
 // runR is where relationships are stored.
 type runR struct {
-	Commands   CommandSlice   `boil:"Commands" json:"Commands" toml:"Commands" yaml:"Commands"`
-	RunReports RunReportSlice `boil:"RunReports" json:"RunReports" toml:"RunReports" yaml:"RunReports"`
+	CapacitySearches    CapacitySearchSlice     `boil:"CapacitySearches" json:"CapacitySearches" toml:"CapacitySearches" yaml:"CapacitySearches"`
+	Commands            CommandSlice            `boil:"Commands" json:"Commands" toml:"Commands" yaml:"Commands"`
+	RunPauses           RunPauseSlice           `boil:"RunPauses" json:"RunPauses" toml:"RunPauses" yaml:"RunPauses"`
+	RunReports          RunReportSlice          `boil:"RunReports" json:"RunReports" toml:"RunReports" yaml:"RunReports"`
+	RunVerdicts         RunVerdictSlice         `boil:"RunVerdicts" json:"RunVerdicts" toml:"RunVerdicts" yaml:"RunVerdicts"`
+	ScenarioBaselines   ScenarioBaselineSlice   `boil:"ScenarioBaselines" json:"ScenarioBaselines" toml:"ScenarioBaselines" yaml:"ScenarioBaselines"`
+	ScriptRunMigrations ScriptRunMigrationSlice `boil:"ScriptRunMigrations" json:"ScriptRunMigrations" toml:"ScriptRunMigrations" yaml:"ScriptRunMigrations"`
 }
 
 // NewStruct creates a new relationship struct
@@ -161,6 +176,22 @@ func (*runR) NewStruct() *runR {
 	return &runR{}
 }
 
+func (o *Run) GetCapacitySearches() CapacitySearchSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCapacitySearches()
+}
+
+func (r *runR) GetCapacitySearches() CapacitySearchSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CapacitySearches
+}
+
 func (o *Run) GetCommands() CommandSlice {
 	if o == nil {
 		return nil
@@ -177,6 +208,22 @@ func (r *runR) GetCommands() CommandSlice {
 	return r.Commands
 }
 
+func (o *Run) GetRunPauses() RunPauseSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRunPauses()
+}
+
+func (r *runR) GetRunPauses() RunPauseSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RunPauses
+}
+
 func (o *Run) GetRunReports() RunReportSlice {
 	if o == nil {
 		return nil
@@ -193,6 +240,54 @@ func (r *runR) GetRunReports() RunReportSlice {
 	return r.RunReports
 }
 
+func (o *Run) GetRunVerdicts() RunVerdictSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRunVerdicts()
+}
+
+func (r *runR) GetRunVerdicts() RunVerdictSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RunVerdicts
+}
+
+func (o *Run) GetScenarioBaselines() ScenarioBaselineSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenarioBaselines()
+}
+
+func (r *runR) GetScenarioBaselines() ScenarioBaselineSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScenarioBaselines
+}
+
+func (o *Run) GetScriptRunMigrations() ScriptRunMigrationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScriptRunMigrations()
+}
+
+func (r *runR) GetScriptRunMigrations() ScriptRunMigrationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScriptRunMigrations
+}
+
 // runL is where Load methods for each relationship are stored.
 type runL struct{}
 
@@ -509,6 +604,20 @@ func (q runQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// CapacitySearches retrieves all the capacity_search's CapacitySearches with an executor.
+func (o *Run) CapacitySearches(mods ...qm.QueryMod) capacitySearchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"capacity_searches\".\"run_id\"=?", o.RunID),
+	)
+
+	return CapacitySearches(queryMods...)
+}
+
 // Commands retrieves all the command's Commands with an executor.
 func (o *Run) Commands(mods ...qm.QueryMod) commandQuery {
 	var queryMods []qm.QueryMod
@@ -523,6 +632,20 @@ func (o *Run) Commands(mods ...qm.QueryMod) commandQuery {
 	return Commands(queryMods...)
 }
 
+// RunPauses retrieves all the run_pause's RunPauses with an executor.
+func (o *Run) RunPauses(mods ...qm.QueryMod) runPauseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"run_pauses\".\"run_id\"=?", o.RunID),
+	)
+
+	return RunPauses(queryMods...)
+}
+
 // RunReports retrieves all the run_report's RunReports with an executor.
 func (o *Run) RunReports(mods ...qm.QueryMod) runReportQuery {
 	var queryMods []qm.QueryMod
@@ -537,6 +660,161 @@ func (o *Run) RunReports(mods ...qm.QueryMod) runReportQuery {
 	return RunReports(queryMods...)
 }
 
+// RunVerdicts retrieves all the run_verdict's RunVerdicts with an executor.
+func (o *Run) RunVerdicts(mods ...qm.QueryMod) runVerdictQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"run_verdicts\".\"run_id\"=?", o.RunID),
+	)
+
+	return RunVerdicts(queryMods...)
+}
+
+// ScenarioBaselines retrieves all the scenario_baseline's ScenarioBaselines with an executor.
+func (o *Run) ScenarioBaselines(mods ...qm.QueryMod) scenarioBaselineQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"scenario_baselines\".\"run_id\"=?", o.RunID),
+	)
+
+	return ScenarioBaselines(queryMods...)
+}
+
+// ScriptRunMigrations retrieves all the script_run_migration's ScriptRunMigrations with an executor.
+func (o *Run) ScriptRunMigrations(mods ...qm.QueryMod) scriptRunMigrationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"script_run_migrations\".\"run_id\"=?", o.RunID),
+	)
+
+	return ScriptRunMigrations(queryMods...)
+}
+
+// LoadCapacitySearches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadCapacitySearches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		var ok bool
+		object, ok = maybeRun.(*Run)
+		if !ok {
+			object = new(Run)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRun))
+			}
+		}
+	} else {
+		s, ok := maybeRun.(*[]*Run)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRun))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args[object.RunID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+			args[obj.RunID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`capacity_searches`),
+		qm.WhereIn(`capacity_searches.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load capacity_searches")
+	}
+
+	var resultSlice []*CapacitySearch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice capacity_searches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on capacity_searches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for capacity_searches")
+	}
+
+	if len(capacitySearchAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CapacitySearches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &capacitySearchR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.CapacitySearches = append(local.R.CapacitySearches, foreign)
+				if foreign.R == nil {
+					foreign.R = &capacitySearchR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadCommands allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (runL) LoadCommands(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
@@ -650,9 +928,9 @@ func (runL) LoadCommands(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadRunReports allows an eager lookup of values, cached into the
+// LoadRunPauses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+func (runL) LoadRunPauses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
 	var slice []*Run
 	var object *Run
 
@@ -705,8 +983,8 @@ func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`run_report`),
-		qm.WhereIn(`run_report.run_id in ?`, argsSlice...),
+		qm.From(`run_pauses`),
+		qm.WhereIn(`run_pauses.run_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -714,22 +992,22 @@ func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load run_report")
+		return errors.Wrap(err, "failed to eager load run_pauses")
 	}
 
-	var resultSlice []*RunReport
+	var resultSlice []*RunPause
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice run_report")
+		return errors.Wrap(err, "failed to bind eager loaded slice run_pauses")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on run_report")
+		return errors.Wrap(err, "failed to close results in eager load on run_pauses")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for run_report")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for run_pauses")
 	}
 
-	if len(runReportAfterSelectHooks) != 0 {
+	if len(runPauseAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -737,10 +1015,10 @@ func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.RunReports = resultSlice
+		object.R.RunPauses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &runReportR{}
+				foreign.R = &runPauseR{}
 			}
 			foreign.R.Run = object
 		}
@@ -750,9 +1028,9 @@ func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.RunID == foreign.RunID {
-				local.R.RunReports = append(local.R.RunReports, foreign)
+				local.R.RunPauses = append(local.R.RunPauses, foreign)
 				if foreign.R == nil {
-					foreign.R = &runReportR{}
+					foreign.R = &runPauseR{}
 				}
 				foreign.R.Run = local
 				break
@@ -763,22 +1041,527 @@ func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// AddCommands adds the given related objects to the existing relationships
-// of the run, optionally inserting them as new records.
-// Appends related to o.R.Commands.
-// Sets related.R.Run appropriately.
-func (o *Run) AddCommands(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Command) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.RunID = o.RunID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+// LoadRunReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadRunReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		var ok bool
+		object, ok = maybeRun.(*Run)
+		if !ok {
+			object = new(Run)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRun))
 			}
+		}
+	} else {
+		s, ok := maybeRun.(*[]*Run)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"command\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRun))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args[object.RunID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+			args[obj.RunID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`run_report`),
+		qm.WhereIn(`run_report.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load run_report")
+	}
+
+	var resultSlice []*RunReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice run_report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on run_report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for run_report")
+	}
+
+	if len(runReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RunReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &runReportR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.RunReports = append(local.R.RunReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &runReportR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRunVerdicts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadRunVerdicts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		var ok bool
+		object, ok = maybeRun.(*Run)
+		if !ok {
+			object = new(Run)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRun))
+			}
+		}
+	} else {
+		s, ok := maybeRun.(*[]*Run)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRun))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args[object.RunID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+			args[obj.RunID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`run_verdicts`),
+		qm.WhereIn(`run_verdicts.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load run_verdicts")
+	}
+
+	var resultSlice []*RunVerdict
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice run_verdicts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on run_verdicts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for run_verdicts")
+	}
+
+	if len(runVerdictAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RunVerdicts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &runVerdictR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.RunVerdicts = append(local.R.RunVerdicts, foreign)
+				if foreign.R == nil {
+					foreign.R = &runVerdictR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScenarioBaselines allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadScenarioBaselines(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		var ok bool
+		object, ok = maybeRun.(*Run)
+		if !ok {
+			object = new(Run)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRun))
+			}
+		}
+	} else {
+		s, ok := maybeRun.(*[]*Run)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRun))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args[object.RunID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+			args[obj.RunID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scenario_baselines`),
+		qm.WhereIn(`scenario_baselines.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scenario_baselines")
+	}
+
+	var resultSlice []*ScenarioBaseline
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scenario_baselines")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scenario_baselines")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenario_baselines")
+	}
+
+	if len(scenarioBaselineAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScenarioBaselines = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scenarioBaselineR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.ScenarioBaselines = append(local.R.ScenarioBaselines, foreign)
+				if foreign.R == nil {
+					foreign.R = &scenarioBaselineR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScriptRunMigrations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadScriptRunMigrations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		var ok bool
+		object, ok = maybeRun.(*Run)
+		if !ok {
+			object = new(Run)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRun))
+			}
+		}
+	} else {
+		s, ok := maybeRun.(*[]*Run)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRun)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRun))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args[object.RunID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+			args[obj.RunID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`script_run_migrations`),
+		qm.WhereIn(`script_run_migrations.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load script_run_migrations")
+	}
+
+	var resultSlice []*ScriptRunMigration
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice script_run_migrations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on script_run_migrations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for script_run_migrations")
+	}
+
+	if len(scriptRunMigrationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScriptRunMigrations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scriptRunMigrationR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.ScriptRunMigrations = append(local.R.ScriptRunMigrations, foreign)
+				if foreign.R == nil {
+					foreign.R = &scriptRunMigrationR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddCapacitySearches adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.CapacitySearches.
+// Sets related.R.Run appropriately.
+func (o *Run) AddCapacitySearches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CapacitySearch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"capacity_searches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, capacitySearchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.CapacitySearchID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			CapacitySearches: related,
+		}
+	} else {
+		o.R.CapacitySearches = append(o.R.CapacitySearches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &capacitySearchR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
+// AddCommands adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.Commands.
+// Sets related.R.Run appropriately.
+func (o *Run) AddCommands(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Command) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"command\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
 				strmangle.WhereClause("\"", "\"", 2, commandPrimaryKeyColumns),
 			)
 			values := []interface{}{o.RunID, rel.CommandID}
@@ -816,6 +1599,59 @@ func (o *Run) AddCommands(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddRunPauses adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.RunPauses.
+// Sets related.R.Run appropriately.
+func (o *Run) AddRunPauses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RunPause) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"run_pauses\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, runPausePrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.RunPauseID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			RunPauses: related,
+		}
+	} else {
+		o.R.RunPauses = append(o.R.RunPauses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &runPauseR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
 // AddRunReports adds the given related objects to the existing relationships
 // of the run, optionally inserting them as new records.
 // Appends related to o.R.RunReports.
@@ -869,6 +1705,165 @@ func (o *Run) AddRunReports(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddRunVerdicts adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.RunVerdicts.
+// Sets related.R.Run appropriately.
+func (o *Run) AddRunVerdicts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RunVerdict) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"run_verdicts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, runVerdictPrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.RunVerdictID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			RunVerdicts: related,
+		}
+	} else {
+		o.R.RunVerdicts = append(o.R.RunVerdicts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &runVerdictR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
+// AddScenarioBaselines adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.ScenarioBaselines.
+// Sets related.R.Run appropriately.
+func (o *Run) AddScenarioBaselines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ScenarioBaseline) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"scenario_baselines\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scenarioBaselinePrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.ScenarioBaselineID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			ScenarioBaselines: related,
+		}
+	} else {
+		o.R.ScenarioBaselines = append(o.R.ScenarioBaselines, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scenarioBaselineR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
+// AddScriptRunMigrations adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.ScriptRunMigrations.
+// Sets related.R.Run appropriately.
+func (o *Run) AddScriptRunMigrations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ScriptRunMigration) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"script_run_migrations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scriptRunMigrationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.ScriptRunMigrationID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			ScriptRunMigrations: related,
+		}
+	} else {
+		o.R.ScriptRunMigrations = append(o.R.ScriptRunMigrations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scriptRunMigrationR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
 // Runs retrieves all the records using an executor.
 func Runs(mods ...qm.QueryMod) runQuery {
 	mods = append(mods, qm.From("\"runs\""))
@@ -0,0 +1,1383 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ScenarioBaseline is an object representing the database table.
+type ScenarioBaseline struct {
+	ScenarioBaselineID int32     `boil:"scenario_baseline_id" json:"scenario_baseline_id" toml:"scenario_baseline_id" yaml:"scenario_baseline_id"`
+	ScenarioID         int32     `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
+	RunID              int32     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	Tolerances         string    `boil:"tolerances" json:"tolerances" toml:"tolerances" yaml:"tolerances"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *scenarioBaselineR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scenarioBaselineL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScenarioBaselineColumns = struct {
+	ScenarioBaselineID string
+	ScenarioID         string
+	RunID              string
+	Tolerances         string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	ScenarioBaselineID: "scenario_baseline_id",
+	ScenarioID:         "scenario_id",
+	RunID:              "run_id",
+	Tolerances:         "tolerances",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
+}
+
+var ScenarioBaselineTableColumns = struct {
+	ScenarioBaselineID string
+	ScenarioID         string
+	RunID              string
+	Tolerances         string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	ScenarioBaselineID: "scenario_baselines.scenario_baseline_id",
+	ScenarioID:         "scenario_baselines.scenario_id",
+	RunID:              "scenario_baselines.run_id",
+	Tolerances:         "scenario_baselines.tolerances",
+	CreatedAt:          "scenario_baselines.created_at",
+	UpdatedAt:          "scenario_baselines.updated_at",
+	DeletedAt:          "scenario_baselines.deleted_at",
+}
+
+// Generated where
+
+var ScenarioBaselineWhere = struct {
+	ScenarioBaselineID whereHelperint32
+	ScenarioID         whereHelperint32
+	RunID              whereHelperint32
+	Tolerances         whereHelperstring
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+}{
+	ScenarioBaselineID: whereHelperint32{field: "\"scenario_baselines\".\"scenario_baseline_id\""},
+	ScenarioID:         whereHelperint32{field: "\"scenario_baselines\".\"scenario_id\""},
+	RunID:              whereHelperint32{field: "\"scenario_baselines\".\"run_id\""},
+	Tolerances:         whereHelperstring{field: "\"scenario_baselines\".\"tolerances\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"scenario_baselines\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"scenario_baselines\".\"updated_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"scenario_baselines\".\"deleted_at\""},
+}
+
+// ScenarioBaselineRels is where relationship names are stored.
+var ScenarioBaselineRels = struct {
+	Scenario string
+	Run      string
+}{
+	Scenario: "Scenario",
+	Run:      "Run",
+}
+
+// scenarioBaselineR is where relationships are stored.
+type scenarioBaselineR struct {
+	Scenario *Scenario `boil:"Scenario" json:"Scenario" toml:"Scenario" yaml:"Scenario"`
+	Run      *Run      `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*scenarioBaselineR) NewStruct() *scenarioBaselineR {
+	return &scenarioBaselineR{}
+}
+
+func (o *ScenarioBaseline) GetScenario() *Scenario {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenario()
+}
+
+func (r *scenarioBaselineR) GetScenario() *Scenario {
+	if r == nil {
+		return nil
+	}
+
+	return r.Scenario
+}
+
+func (o *ScenarioBaseline) GetRun() *Run {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRun()
+}
+
+func (r *scenarioBaselineR) GetRun() *Run {
+	if r == nil {
+		return nil
+	}
+
+	return r.Run
+}
+
+// scenarioBaselineL is where Load methods for each relationship are stored.
+type scenarioBaselineL struct{}
+
+var (
+	scenarioBaselineAllColumns            = []string{"scenario_baseline_id", "scenario_id", "run_id", "tolerances", "created_at", "updated_at", "deleted_at"}
+	scenarioBaselineColumnsWithoutDefault = []string{"scenario_id", "run_id"}
+	scenarioBaselineColumnsWithDefault    = []string{"scenario_baseline_id", "tolerances", "created_at", "updated_at", "deleted_at"}
+	scenarioBaselinePrimaryKeyColumns     = []string{"scenario_baseline_id"}
+	scenarioBaselineGeneratedColumns      = []string{}
+)
+
+type (
+	// ScenarioBaselineSlice is an alias for a slice of pointers to ScenarioBaseline.
+	// This should almost always be used instead of []ScenarioBaseline.
+	ScenarioBaselineSlice []*ScenarioBaseline
+	// ScenarioBaselineHook is the signature for custom ScenarioBaseline hook methods
+	ScenarioBaselineHook func(context.Context, boil.ContextExecutor, *ScenarioBaseline) error
+
+	scenarioBaselineQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scenarioBaselineType                 = reflect.TypeOf(&ScenarioBaseline{})
+	scenarioBaselineMapping              = queries.MakeStructMapping(scenarioBaselineType)
+	scenarioBaselinePrimaryKeyMapping, _ = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, scenarioBaselinePrimaryKeyColumns)
+	scenarioBaselineInsertCacheMut       sync.RWMutex
+	scenarioBaselineInsertCache          = make(map[string]insertCache)
+	scenarioBaselineUpdateCacheMut       sync.RWMutex
+	scenarioBaselineUpdateCache          = make(map[string]updateCache)
+	scenarioBaselineUpsertCacheMut       sync.RWMutex
+	scenarioBaselineUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scenarioBaselineAfterSelectMu sync.Mutex
+var scenarioBaselineAfterSelectHooks []ScenarioBaselineHook
+
+var scenarioBaselineBeforeInsertMu sync.Mutex
+var scenarioBaselineBeforeInsertHooks []ScenarioBaselineHook
+var scenarioBaselineAfterInsertMu sync.Mutex
+var scenarioBaselineAfterInsertHooks []ScenarioBaselineHook
+
+var scenarioBaselineBeforeUpdateMu sync.Mutex
+var scenarioBaselineBeforeUpdateHooks []ScenarioBaselineHook
+var scenarioBaselineAfterUpdateMu sync.Mutex
+var scenarioBaselineAfterUpdateHooks []ScenarioBaselineHook
+
+var scenarioBaselineBeforeDeleteMu sync.Mutex
+var scenarioBaselineBeforeDeleteHooks []ScenarioBaselineHook
+var scenarioBaselineAfterDeleteMu sync.Mutex
+var scenarioBaselineAfterDeleteHooks []ScenarioBaselineHook
+
+var scenarioBaselineBeforeUpsertMu sync.Mutex
+var scenarioBaselineBeforeUpsertHooks []ScenarioBaselineHook
+var scenarioBaselineAfterUpsertMu sync.Mutex
+var scenarioBaselineAfterUpsertHooks []ScenarioBaselineHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ScenarioBaseline) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ScenarioBaseline) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ScenarioBaseline) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ScenarioBaseline) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ScenarioBaseline) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ScenarioBaseline) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ScenarioBaseline) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ScenarioBaseline) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ScenarioBaseline) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scenarioBaselineAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScenarioBaselineHook registers your hook function for all future operations.
+func AddScenarioBaselineHook(hookPoint boil.HookPoint, scenarioBaselineHook ScenarioBaselineHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		scenarioBaselineAfterSelectMu.Lock()
+		scenarioBaselineAfterSelectHooks = append(scenarioBaselineAfterSelectHooks, scenarioBaselineHook)
+		scenarioBaselineAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		scenarioBaselineBeforeInsertMu.Lock()
+		scenarioBaselineBeforeInsertHooks = append(scenarioBaselineBeforeInsertHooks, scenarioBaselineHook)
+		scenarioBaselineBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		scenarioBaselineAfterInsertMu.Lock()
+		scenarioBaselineAfterInsertHooks = append(scenarioBaselineAfterInsertHooks, scenarioBaselineHook)
+		scenarioBaselineAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		scenarioBaselineBeforeUpdateMu.Lock()
+		scenarioBaselineBeforeUpdateHooks = append(scenarioBaselineBeforeUpdateHooks, scenarioBaselineHook)
+		scenarioBaselineBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		scenarioBaselineAfterUpdateMu.Lock()
+		scenarioBaselineAfterUpdateHooks = append(scenarioBaselineAfterUpdateHooks, scenarioBaselineHook)
+		scenarioBaselineAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		scenarioBaselineBeforeDeleteMu.Lock()
+		scenarioBaselineBeforeDeleteHooks = append(scenarioBaselineBeforeDeleteHooks, scenarioBaselineHook)
+		scenarioBaselineBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		scenarioBaselineAfterDeleteMu.Lock()
+		scenarioBaselineAfterDeleteHooks = append(scenarioBaselineAfterDeleteHooks, scenarioBaselineHook)
+		scenarioBaselineAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		scenarioBaselineBeforeUpsertMu.Lock()
+		scenarioBaselineBeforeUpsertHooks = append(scenarioBaselineBeforeUpsertHooks, scenarioBaselineHook)
+		scenarioBaselineBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		scenarioBaselineAfterUpsertMu.Lock()
+		scenarioBaselineAfterUpsertHooks = append(scenarioBaselineAfterUpsertHooks, scenarioBaselineHook)
+		scenarioBaselineAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single scenarioBaseline record from the query.
+func (q scenarioBaselineQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ScenarioBaseline, error) {
+	o := &ScenarioBaseline{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for scenario_baselines")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ScenarioBaseline records from the query.
+func (q scenarioBaselineQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScenarioBaselineSlice, error) {
+	var o []*ScenarioBaseline
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ScenarioBaseline slice")
+	}
+
+	if len(scenarioBaselineAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ScenarioBaseline records in the query.
+func (q scenarioBaselineQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count scenario_baselines rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scenarioBaselineQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if scenario_baselines exists")
+	}
+
+	return count > 0, nil
+}
+
+// Scenario pointed to by the foreign key.
+func (o *ScenarioBaseline) Scenario(mods ...qm.QueryMod) scenarioQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"scenario_id\" = ?", o.ScenarioID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Scenarios(queryMods...)
+}
+
+// Run pointed to by the foreign key.
+func (o *ScenarioBaseline) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Runs(queryMods...)
+}
+
+// LoadScenario allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scenarioBaselineL) LoadScenario(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenarioBaseline interface{}, mods queries.Applicator) error {
+	var slice []*ScenarioBaseline
+	var object *ScenarioBaseline
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenarioBaseline.(*ScenarioBaseline)
+		if !ok {
+			object = new(ScenarioBaseline)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenarioBaseline)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenarioBaseline))
+			}
+		}
+	} else {
+		s, ok := maybeScenarioBaseline.(*[]*ScenarioBaseline)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenarioBaseline)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenarioBaseline))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioBaselineR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioBaselineR{}
+			}
+
+			args[obj.ScenarioID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scenarios`),
+		qm.WhereIn(`scenarios.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Scenario")
+	}
+
+	var resultSlice []*Scenario
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Scenario")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for scenarios")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenarios")
+	}
+
+	if len(scenarioAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Scenario = foreign
+		if foreign.R == nil {
+			foreign.R = &scenarioR{}
+		}
+		foreign.R.ScenarioBaselines = append(foreign.R.ScenarioBaselines, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.Scenario = foreign
+				if foreign.R == nil {
+					foreign.R = &scenarioR{}
+				}
+				foreign.R.ScenarioBaselines = append(foreign.R.ScenarioBaselines, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scenarioBaselineL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenarioBaseline interface{}, mods queries.Applicator) error {
+	var slice []*ScenarioBaseline
+	var object *ScenarioBaseline
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenarioBaseline.(*ScenarioBaseline)
+		if !ok {
+			object = new(ScenarioBaseline)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenarioBaseline)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenarioBaseline))
+			}
+		}
+	} else {
+		s, ok := maybeScenarioBaseline.(*[]*ScenarioBaseline)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenarioBaseline)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenarioBaseline))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioBaselineR{}
+		}
+		args[object.RunID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioBaselineR{}
+			}
+
+			args[obj.RunID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.ScenarioBaselines = append(foreign.R.ScenarioBaselines, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.ScenarioBaselines = append(foreign.R.ScenarioBaselines, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetScenario of the scenarioBaseline to the related item.
+// Sets o.R.Scenario to related.
+// Adds o to related.R.ScenarioBaselines.
+func (o *ScenarioBaseline) SetScenario(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Scenario) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"scenario_baselines\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+		strmangle.WhereClause("\"", "\"", 2, scenarioBaselinePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ScenarioID, o.ScenarioBaselineID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScenarioID = related.ScenarioID
+	if o.R == nil {
+		o.R = &scenarioBaselineR{
+			Scenario: related,
+		}
+	} else {
+		o.R.Scenario = related
+	}
+
+	if related.R == nil {
+		related.R = &scenarioR{
+			ScenarioBaselines: ScenarioBaselineSlice{o},
+		}
+	} else {
+		related.R.ScenarioBaselines = append(related.R.ScenarioBaselines, o)
+	}
+
+	return nil
+}
+
+// SetRun of the scenarioBaseline to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.ScenarioBaselines.
+func (o *ScenarioBaseline) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"scenario_baselines\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, scenarioBaselinePrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.ScenarioBaselineID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &scenarioBaselineR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			ScenarioBaselines: ScenarioBaselineSlice{o},
+		}
+	} else {
+		related.R.ScenarioBaselines = append(related.R.ScenarioBaselines, o)
+	}
+
+	return nil
+}
+
+// ScenarioBaselines retrieves all the records using an executor.
+func ScenarioBaselines(mods ...qm.QueryMod) scenarioBaselineQuery {
+	mods = append(mods, qm.From("\"scenario_baselines\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"scenario_baselines\".*"})
+	}
+
+	return scenarioBaselineQuery{q}
+}
+
+// FindScenarioBaseline retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindScenarioBaseline(ctx context.Context, exec boil.ContextExecutor, scenarioBaselineID int32, selectCols ...string) (*ScenarioBaseline, error) {
+	scenarioBaselineObj := &ScenarioBaseline{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"scenario_baselines\" where \"scenario_baseline_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, scenarioBaselineID)
+
+	err := q.Bind(ctx, exec, scenarioBaselineObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from scenario_baselines")
+	}
+
+	if err = scenarioBaselineObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return scenarioBaselineObj, err
+	}
+
+	return scenarioBaselineObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ScenarioBaseline) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no scenario_baselines provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scenarioBaselineColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scenarioBaselineInsertCacheMut.RLock()
+	cache, cached := scenarioBaselineInsertCache[key]
+	scenarioBaselineInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scenarioBaselineAllColumns,
+			scenarioBaselineColumnsWithDefault,
+			scenarioBaselineColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"scenario_baselines\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"scenario_baselines\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into scenario_baselines")
+	}
+
+	if !cached {
+		scenarioBaselineInsertCacheMut.Lock()
+		scenarioBaselineInsertCache[key] = cache
+		scenarioBaselineInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ScenarioBaseline.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ScenarioBaseline) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scenarioBaselineUpdateCacheMut.RLock()
+	cache, cached := scenarioBaselineUpdateCache[key]
+	scenarioBaselineUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scenarioBaselineAllColumns,
+			scenarioBaselinePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update scenario_baselines, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"scenario_baselines\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, scenarioBaselinePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, append(wl, scenarioBaselinePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update scenario_baselines row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for scenario_baselines")
+	}
+
+	if !cached {
+		scenarioBaselineUpdateCacheMut.Lock()
+		scenarioBaselineUpdateCache[key] = cache
+		scenarioBaselineUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scenarioBaselineQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for scenario_baselines")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for scenario_baselines")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScenarioBaselineSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scenarioBaselinePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"scenario_baselines\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, scenarioBaselinePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in scenarioBaseline slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all scenarioBaseline")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ScenarioBaseline) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no scenario_baselines provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scenarioBaselineColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	scenarioBaselineUpsertCacheMut.RLock()
+	cache, cached := scenarioBaselineUpsertCache[key]
+	scenarioBaselineUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			scenarioBaselineAllColumns,
+			scenarioBaselineColumnsWithDefault,
+			scenarioBaselineColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			scenarioBaselineAllColumns,
+			scenarioBaselinePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert scenario_baselines, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(scenarioBaselineAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(scenarioBaselinePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert scenario_baselines, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(scenarioBaselinePrimaryKeyColumns))
+			copy(conflict, scenarioBaselinePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"scenario_baselines\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(scenarioBaselineType, scenarioBaselineMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert scenario_baselines")
+	}
+
+	if !cached {
+		scenarioBaselineUpsertCacheMut.Lock()
+		scenarioBaselineUpsertCache[key] = cache
+		scenarioBaselineUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ScenarioBaseline record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ScenarioBaseline) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ScenarioBaseline provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), scenarioBaselinePrimaryKeyMapping)
+	sql := "DELETE FROM \"scenario_baselines\" WHERE \"scenario_baseline_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from scenario_baselines")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for scenario_baselines")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scenarioBaselineQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no scenarioBaselineQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from scenario_baselines")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for scenario_baselines")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScenarioBaselineSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scenarioBaselineBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scenarioBaselinePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"scenario_baselines\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, scenarioBaselinePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from scenarioBaseline slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for scenario_baselines")
+	}
+
+	if len(scenarioBaselineAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ScenarioBaseline) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindScenarioBaseline(ctx, exec, o.ScenarioBaselineID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScenarioBaselineSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScenarioBaselineSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scenarioBaselinePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"scenario_baselines\".* FROM \"scenario_baselines\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, scenarioBaselinePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ScenarioBaselineSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScenarioBaselineExists checks if the ScenarioBaseline row exists.
+func ScenarioBaselineExists(ctx context.Context, exec boil.ContextExecutor, scenarioBaselineID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"scenario_baselines\" where \"scenario_baseline_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, scenarioBaselineID)
+	}
+	row := exec.QueryRowContext(ctx, sql, scenarioBaselineID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if scenario_baselines exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ScenarioBaseline row exists.
+func (o *ScenarioBaseline) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ScenarioBaselineExists(ctx, exec, o.ScenarioBaselineID)
+}
@@ -24,97 +24,153 @@ import (
 
 // Scenario is an object representing the database table.
 type Scenario struct {
-	ScenarioID int32       `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
-	ProjectID  int32       `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
-	Title      string      `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Descrip    null.String `boil:"descrip" json:"descrip,omitempty" toml:"descrip" yaml:"descrip,omitempty"`
-	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt  null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	Selectors  string      `boil:"selectors" json:"selectors" toml:"selectors" yaml:"selectors"`
+	ScenarioID      int32       `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
+	ProjectID       int32       `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
+	Title           string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Descrip         null.String `boil:"descrip" json:"descrip,omitempty" toml:"descrip" yaml:"descrip,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Selectors       string      `boil:"selectors" json:"selectors" toml:"selectors" yaml:"selectors"`
+	LoadProfile     string      `boil:"load_profile" json:"load_profile" toml:"load_profile" yaml:"load_profile"`
+	FailoverEnabled bool        `boil:"failover_enabled" json:"failover_enabled" toml:"failover_enabled" yaml:"failover_enabled"`
+	MaxFailovers    int         `boil:"max_failovers" json:"max_failovers" toml:"max_failovers" yaml:"max_failovers"`
 
 	R *scenarioR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L scenarioL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ScenarioColumns = struct {
-	ScenarioID string
-	ProjectID  string
-	Title      string
-	Descrip    string
-	CreatedAt  string
-	UpdatedAt  string
-	DeletedAt  string
-	Selectors  string
+	ScenarioID      string
+	ProjectID       string
+	Title           string
+	Descrip         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	Selectors       string
+	LoadProfile     string
+	FailoverEnabled string
+	MaxFailovers    string
 }{
-	ScenarioID: "scenario_id",
-	ProjectID:  "project_id",
-	Title:      "title",
-	Descrip:    "descrip",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
-	DeletedAt:  "deleted_at",
-	Selectors:  "selectors",
+	ScenarioID:      "scenario_id",
+	ProjectID:       "project_id",
+	Title:           "title",
+	Descrip:         "descrip",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	Selectors:       "selectors",
+	LoadProfile:     "load_profile",
+	FailoverEnabled: "failover_enabled",
+	MaxFailovers:    "max_failovers",
 }
 
 var ScenarioTableColumns = struct {
-	ScenarioID string
-	ProjectID  string
-	Title      string
-	Descrip    string
-	CreatedAt  string
-	UpdatedAt  string
-	DeletedAt  string
-	Selectors  string
+	ScenarioID      string
+	ProjectID       string
+	Title           string
+	Descrip         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	Selectors       string
+	LoadProfile     string
+	FailoverEnabled string
+	MaxFailovers    string
 }{
-	ScenarioID: "scenarios.scenario_id",
-	ProjectID:  "scenarios.project_id",
-	Title:      "scenarios.title",
-	Descrip:    "scenarios.descrip",
-	CreatedAt:  "scenarios.created_at",
-	UpdatedAt:  "scenarios.updated_at",
-	DeletedAt:  "scenarios.deleted_at",
-	Selectors:  "scenarios.selectors",
+	ScenarioID:      "scenarios.scenario_id",
+	ProjectID:       "scenarios.project_id",
+	Title:           "scenarios.title",
+	Descrip:         "scenarios.descrip",
+	CreatedAt:       "scenarios.created_at",
+	UpdatedAt:       "scenarios.updated_at",
+	DeletedAt:       "scenarios.deleted_at",
+	Selectors:       "scenarios.selectors",
+	LoadProfile:     "scenarios.load_profile",
+	FailoverEnabled: "scenarios.failover_enabled",
+	MaxFailovers:    "scenarios.max_failovers",
 }
 
 // Generated where
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var ScenarioWhere = struct {
-	ScenarioID whereHelperint32
-	ProjectID  whereHelperint32
-	Title      whereHelperstring
-	Descrip    whereHelpernull_String
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpertime_Time
-	DeletedAt  whereHelpernull_Time
-	Selectors  whereHelperstring
+	ScenarioID      whereHelperint32
+	ProjectID       whereHelperint32
+	Title           whereHelperstring
+	Descrip         whereHelpernull_String
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	DeletedAt       whereHelpernull_Time
+	Selectors       whereHelperstring
+	LoadProfile     whereHelperstring
+	FailoverEnabled whereHelperbool
+	MaxFailovers    whereHelperint
 }{
-	ScenarioID: whereHelperint32{field: "\"scenarios\".\"scenario_id\""},
-	ProjectID:  whereHelperint32{field: "\"scenarios\".\"project_id\""},
-	Title:      whereHelperstring{field: "\"scenarios\".\"title\""},
-	Descrip:    whereHelpernull_String{field: "\"scenarios\".\"descrip\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"scenarios\".\"created_at\""},
-	UpdatedAt:  whereHelpertime_Time{field: "\"scenarios\".\"updated_at\""},
-	DeletedAt:  whereHelpernull_Time{field: "\"scenarios\".\"deleted_at\""},
-	Selectors:  whereHelperstring{field: "\"scenarios\".\"selectors\""},
+	ScenarioID:      whereHelperint32{field: "\"scenarios\".\"scenario_id\""},
+	ProjectID:       whereHelperint32{field: "\"scenarios\".\"project_id\""},
+	Title:           whereHelperstring{field: "\"scenarios\".\"title\""},
+	Descrip:         whereHelpernull_String{field: "\"scenarios\".\"descrip\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"scenarios\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"scenarios\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"scenarios\".\"deleted_at\""},
+	Selectors:       whereHelperstring{field: "\"scenarios\".\"selectors\""},
+	LoadProfile:     whereHelperstring{field: "\"scenarios\".\"load_profile\""},
+	FailoverEnabled: whereHelperbool{field: "\"scenarios\".\"failover_enabled\""},
+	MaxFailovers:    whereHelperint{field: "\"scenarios\".\"max_failovers\""},
 }
 
 // ScenarioRels is where relationship names are stored.
 var ScenarioRels = struct {
-	Project       string
-	Scripts       string
-	SimpleScripts string
+	Project           string
+	AgentReservations string
+	ScenarioBaselines string
+	Schedules         string
+	Scripts           string
+	SimpleScripts     string
+	StopConditions    string
 }{
-	Project:       "Project",
-	Scripts:       "Scripts",
-	SimpleScripts: "SimpleScripts",
+	Project:           "Project",
+	AgentReservations: "AgentReservations",
+	ScenarioBaselines: "ScenarioBaselines",
+	Schedules:         "Schedules",
+	Scripts:           "Scripts",
+	SimpleScripts:     "SimpleScripts",
+	StopConditions:    "StopConditions",
 }
 
 // scenarioR is where relationships are stored.
 type scenarioR struct {
-	Project       *Project          `boil:"Project" json:"Project" toml:"Project" yaml:"Project"`
-	Scripts       ScriptSlice       `boil:"Scripts" json:"Scripts" toml:"Scripts" yaml:"Scripts"`
-	SimpleScripts SimpleScriptSlice `boil:"SimpleScripts" json:"SimpleScripts" toml:"SimpleScripts" yaml:"SimpleScripts"`
+	Project           *Project              `boil:"Project" json:"Project" toml:"Project" yaml:"Project"`
+	AgentReservations AgentReservationSlice `boil:"AgentReservations" json:"AgentReservations" toml:"AgentReservations" yaml:"AgentReservations"`
+	ScenarioBaselines ScenarioBaselineSlice `boil:"ScenarioBaselines" json:"ScenarioBaselines" toml:"ScenarioBaselines" yaml:"ScenarioBaselines"`
+	Schedules         ScheduleSlice         `boil:"Schedules" json:"Schedules" toml:"Schedules" yaml:"Schedules"`
+	Scripts           ScriptSlice           `boil:"Scripts" json:"Scripts" toml:"Scripts" yaml:"Scripts"`
+	SimpleScripts     SimpleScriptSlice     `boil:"SimpleScripts" json:"SimpleScripts" toml:"SimpleScripts" yaml:"SimpleScripts"`
+	StopConditions    StopConditionSlice    `boil:"StopConditions" json:"StopConditions" toml:"StopConditions" yaml:"StopConditions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -138,6 +194,54 @@ func (r *scenarioR) GetProject() *Project {
 	return r.Project
 }
 
+func (o *Scenario) GetAgentReservations() AgentReservationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAgentReservations()
+}
+
+func (r *scenarioR) GetAgentReservations() AgentReservationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AgentReservations
+}
+
+func (o *Scenario) GetScenarioBaselines() ScenarioBaselineSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenarioBaselines()
+}
+
+func (r *scenarioR) GetScenarioBaselines() ScenarioBaselineSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScenarioBaselines
+}
+
+func (o *Scenario) GetSchedules() ScheduleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedules()
+}
+
+func (r *scenarioR) GetSchedules() ScheduleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedules
+}
+
 func (o *Scenario) GetScripts() ScriptSlice {
 	if o == nil {
 		return nil
@@ -170,13 +274,29 @@ func (r *scenarioR) GetSimpleScripts() SimpleScriptSlice {
 	return r.SimpleScripts
 }
 
+func (o *Scenario) GetStopConditions() StopConditionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStopConditions()
+}
+
+func (r *scenarioR) GetStopConditions() StopConditionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.StopConditions
+}
+
 // scenarioL is where Load methods for each relationship are stored.
 type scenarioL struct{}
 
 var (
-	scenarioAllColumns            = []string{"scenario_id", "project_id", "title", "descrip", "created_at", "updated_at", "deleted_at", "selectors"}
+	scenarioAllColumns            = []string{"scenario_id", "project_id", "title", "descrip", "created_at", "updated_at", "deleted_at", "selectors", "load_profile", "failover_enabled", "max_failovers"}
 	scenarioColumnsWithoutDefault = []string{"project_id", "title"}
-	scenarioColumnsWithDefault    = []string{"scenario_id", "descrip", "created_at", "updated_at", "deleted_at", "selectors"}
+	scenarioColumnsWithDefault    = []string{"scenario_id", "descrip", "created_at", "updated_at", "deleted_at", "selectors", "load_profile", "failover_enabled", "max_failovers"}
 	scenarioPrimaryKeyColumns     = []string{"scenario_id"}
 	scenarioGeneratedColumns      = []string{}
 )
@@ -497,6 +617,48 @@ func (o *Scenario) Project(mods ...qm.QueryMod) projectQuery {
 	return Projects(queryMods...)
 }
 
+// AgentReservations retrieves all the agent_reservation's AgentReservations with an executor.
+func (o *Scenario) AgentReservations(mods ...qm.QueryMod) agentReservationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"agent_reservations\".\"scenario_id\"=?", o.ScenarioID),
+	)
+
+	return AgentReservations(queryMods...)
+}
+
+// ScenarioBaselines retrieves all the scenario_baseline's ScenarioBaselines with an executor.
+func (o *Scenario) ScenarioBaselines(mods ...qm.QueryMod) scenarioBaselineQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"scenario_baselines\".\"scenario_id\"=?", o.ScenarioID),
+	)
+
+	return ScenarioBaselines(queryMods...)
+}
+
+// Schedules retrieves all the schedule's Schedules with an executor.
+func (o *Scenario) Schedules(mods ...qm.QueryMod) scheduleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"schedules\".\"scenario_id\"=?", o.ScenarioID),
+	)
+
+	return Schedules(queryMods...)
+}
+
 // Scripts retrieves all the script's Scripts with an executor.
 func (o *Scenario) Scripts(mods ...qm.QueryMod) scriptQuery {
 	var queryMods []qm.QueryMod
@@ -525,6 +687,20 @@ func (o *Scenario) SimpleScripts(mods ...qm.QueryMod) simpleScriptQuery {
 	return SimpleScripts(queryMods...)
 }
 
+// StopConditions retrieves all the stop_condition's StopConditions with an executor.
+func (o *Scenario) StopConditions(mods ...qm.QueryMod) stopConditionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stop_conditions\".\"scenario_id\"=?", o.ScenarioID),
+	)
+
+	return StopConditions(queryMods...)
+}
+
 // LoadProject allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (scenarioL) LoadProject(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
@@ -645,9 +821,9 @@ func (scenarioL) LoadProject(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadScripts allows an eager lookup of values, cached into the
+// LoadAgentReservations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+func (scenarioL) LoadAgentReservations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
 	var slice []*Scenario
 	var object *Scenario
 
@@ -700,8 +876,8 @@ func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`scripts`),
-		qm.WhereIn(`scripts.scenario_id in ?`, argsSlice...),
+		qm.From(`agent_reservations`),
+		qm.WhereIn(`agent_reservations.scenario_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -709,22 +885,22 @@ func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load scripts")
+		return errors.Wrap(err, "failed to eager load agent_reservations")
 	}
 
-	var resultSlice []*Script
+	var resultSlice []*AgentReservation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice scripts")
+		return errors.Wrap(err, "failed to bind eager loaded slice agent_reservations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on scripts")
+		return errors.Wrap(err, "failed to close results in eager load on agent_reservations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scripts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for agent_reservations")
 	}
 
-	if len(scriptAfterSelectHooks) != 0 {
+	if len(agentReservationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -732,10 +908,10 @@ func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.Scripts = resultSlice
+		object.R.AgentReservations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &scriptR{}
+				foreign.R = &agentReservationR{}
 			}
 			foreign.R.Scenario = object
 		}
@@ -744,10 +920,10 @@ func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singul
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ScenarioID == foreign.ScenarioID {
-				local.R.Scripts = append(local.R.Scripts, foreign)
+			if queries.Equal(local.ScenarioID, foreign.ScenarioID) {
+				local.R.AgentReservations = append(local.R.AgentReservations, foreign)
 				if foreign.R == nil {
-					foreign.R = &scriptR{}
+					foreign.R = &agentReservationR{}
 				}
 				foreign.R.Scenario = local
 				break
@@ -758,9 +934,9 @@ func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadSimpleScripts allows an eager lookup of values, cached into the
+// LoadScenarioBaselines allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+func (scenarioL) LoadScenarioBaselines(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
 	var slice []*Scenario
 	var object *Scenario
 
@@ -813,8 +989,8 @@ func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`simple_scripts`),
-		qm.WhereIn(`simple_scripts.scenario_id in ?`, argsSlice...),
+		qm.From(`scenario_baselines`),
+		qm.WhereIn(`scenario_baselines.scenario_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -822,22 +998,22 @@ func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load simple_scripts")
+		return errors.Wrap(err, "failed to eager load scenario_baselines")
 	}
 
-	var resultSlice []*SimpleScript
+	var resultSlice []*ScenarioBaseline
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice simple_scripts")
+		return errors.Wrap(err, "failed to bind eager loaded slice scenario_baselines")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on simple_scripts")
+		return errors.Wrap(err, "failed to close results in eager load on scenario_baselines")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for simple_scripts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenario_baselines")
 	}
 
-	if len(simpleScriptAfterSelectHooks) != 0 {
+	if len(scenarioBaselineAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -845,10 +1021,10 @@ func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.SimpleScripts = resultSlice
+		object.R.ScenarioBaselines = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &simpleScriptR{}
+				foreign.R = &scenarioBaselineR{}
 			}
 			foreign.R.Scenario = object
 		}
@@ -858,9 +1034,9 @@ func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor,
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ScenarioID == foreign.ScenarioID {
-				local.R.SimpleScripts = append(local.R.SimpleScripts, foreign)
+				local.R.ScenarioBaselines = append(local.R.ScenarioBaselines, foreign)
 				if foreign.R == nil {
-					foreign.R = &simpleScriptR{}
+					foreign.R = &scenarioBaselineR{}
 				}
 				foreign.R.Scenario = local
 				break
@@ -871,90 +1047,775 @@ func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// SetProject of the scenario to the related item.
-// Sets o.R.Project to related.
-// Adds o to related.R.Scenarios.
-func (o *Scenario) SetProject(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Project) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (scenarioL) LoadSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+	var slice []*Scenario
+	var object *Scenario
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenario.(*Scenario)
+		if !ok {
+			object = new(Scenario)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenario))
+			}
+		}
+	} else {
+		s, ok := maybeScenario.(*[]*Scenario)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenario))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"scenarios\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"project_id"}),
-		strmangle.WhereClause("\"", "\"", 2, scenarioPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ScenarioID}
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioR{}
+			}
+			args[obj.ScenarioID] = struct{}{}
+		}
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	if len(args) == 0 {
+		return nil
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	o.ProjectID = related.ID
-	if o.R == nil {
-		o.R = &scenarioR{
-			Project: related,
-		}
-	} else {
-		o.R.Project = related
+	query := NewQuery(
+		qm.From(`schedules`),
+		qm.WhereIn(`schedules.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	if related.R == nil {
-		related.R = &projectR{
-			Scenarios: ScenarioSlice{o},
-		}
-	} else {
-		related.R.Scenarios = append(related.R.Scenarios, o)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load schedules")
 	}
 
-	return nil
-}
+	var resultSlice []*Schedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice schedules")
+	}
 
-// AddScripts adds the given related objects to the existing relationships
-// of the scenario, optionally inserting them as new records.
-// Appends related to o.R.Scripts.
-// Sets related.R.Scenario appropriately.
-func (o *Scenario) AddScripts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Script) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ScenarioID = o.ScenarioID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"scripts\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
-				strmangle.WhereClause("\"", "\"", 2, scriptPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ScenarioID, rel.ScriptID}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for schedules")
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	}
+	if singular {
+		object.R.Schedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scheduleR{}
 			}
-
-			rel.ScenarioID = o.ScenarioID
+			foreign.R.Scenario = object
 		}
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &scenarioR{
-			Scripts: related,
-		}
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.Schedules = append(local.R.Schedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &scheduleR{}
+				}
+				foreign.R.Scenario = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScripts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (scenarioL) LoadScripts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+	var slice []*Scenario
+	var object *Scenario
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenario.(*Scenario)
+		if !ok {
+			object = new(Scenario)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenario))
+			}
+		}
+	} else {
+		s, ok := maybeScenario.(*[]*Scenario)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenario))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioR{}
+			}
+			args[obj.ScenarioID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scripts`),
+		qm.WhereIn(`scripts.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load scripts")
+	}
+
+	var resultSlice []*Script
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice scripts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on scripts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scripts")
+	}
+
+	if len(scriptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Scripts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &scriptR{}
+			}
+			foreign.R.Scenario = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.Scripts = append(local.R.Scripts, foreign)
+				if foreign.R == nil {
+					foreign.R = &scriptR{}
+				}
+				foreign.R.Scenario = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSimpleScripts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (scenarioL) LoadSimpleScripts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+	var slice []*Scenario
+	var object *Scenario
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenario.(*Scenario)
+		if !ok {
+			object = new(Scenario)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenario))
+			}
+		}
+	} else {
+		s, ok := maybeScenario.(*[]*Scenario)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenario))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioR{}
+			}
+			args[obj.ScenarioID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`simple_scripts`),
+		qm.WhereIn(`simple_scripts.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load simple_scripts")
+	}
+
+	var resultSlice []*SimpleScript
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice simple_scripts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on simple_scripts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for simple_scripts")
+	}
+
+	if len(simpleScriptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SimpleScripts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &simpleScriptR{}
+			}
+			foreign.R.Scenario = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.SimpleScripts = append(local.R.SimpleScripts, foreign)
+				if foreign.R == nil {
+					foreign.R = &simpleScriptR{}
+				}
+				foreign.R.Scenario = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStopConditions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (scenarioL) LoadStopConditions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScenario interface{}, mods queries.Applicator) error {
+	var slice []*Scenario
+	var object *Scenario
+
+	if singular {
+		var ok bool
+		object, ok = maybeScenario.(*Scenario)
+		if !ok {
+			object = new(Scenario)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScenario))
+			}
+		}
+	} else {
+		s, ok := maybeScenario.(*[]*Scenario)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScenario)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScenario))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scenarioR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scenarioR{}
+			}
+			args[obj.ScenarioID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`stop_conditions`),
+		qm.WhereIn(`stop_conditions.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stop_conditions")
+	}
+
+	var resultSlice []*StopCondition
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stop_conditions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stop_conditions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stop_conditions")
+	}
+
+	if len(stopConditionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.StopConditions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stopConditionR{}
+			}
+			foreign.R.Scenario = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.StopConditions = append(local.R.StopConditions, foreign)
+				if foreign.R == nil {
+					foreign.R = &stopConditionR{}
+				}
+				foreign.R.Scenario = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProject of the scenario to the related item.
+// Sets o.R.Project to related.
+// Adds o to related.R.Scenarios.
+func (o *Scenario) SetProject(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Project) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"scenarios\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"project_id"}),
+		strmangle.WhereClause("\"", "\"", 2, scenarioPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ScenarioID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProjectID = related.ID
+	if o.R == nil {
+		o.R = &scenarioR{
+			Project: related,
+		}
+	} else {
+		o.R.Project = related
+	}
+
+	if related.R == nil {
+		related.R = &projectR{
+			Scenarios: ScenarioSlice{o},
+		}
+	} else {
+		related.R.Scenarios = append(related.R.Scenarios, o)
+	}
+
+	return nil
+}
+
+// AddAgentReservations adds the given related objects to the existing relationships
+// of the scenario, optionally inserting them as new records.
+// Appends related to o.R.AgentReservations.
+// Sets related.R.Scenario appropriately.
+func (o *Scenario) AddAgentReservations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AgentReservation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ScenarioID, o.ScenarioID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"agent_reservations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+				strmangle.WhereClause("\"", "\"", 2, agentReservationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ScenarioID, rel.AgentReservationID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ScenarioID, o.ScenarioID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scenarioR{
+			AgentReservations: related,
+		}
+	} else {
+		o.R.AgentReservations = append(o.R.AgentReservations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &agentReservationR{
+				Scenario: o,
+			}
+		} else {
+			rel.R.Scenario = o
+		}
+	}
+	return nil
+}
+
+// SetAgentReservations removes all previously related items of the
+// scenario replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Scenario's AgentReservations accordingly.
+// Replaces o.R.AgentReservations with related.
+// Sets related.R.Scenario's AgentReservations accordingly.
+func (o *Scenario) SetAgentReservations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AgentReservation) error {
+	query := "update \"agent_reservations\" set \"scenario_id\" = null where \"scenario_id\" = $1"
+	values := []interface{}{o.ScenarioID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AgentReservations {
+			queries.SetScanner(&rel.ScenarioID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Scenario = nil
+		}
+		o.R.AgentReservations = nil
+	}
+
+	return o.AddAgentReservations(ctx, exec, insert, related...)
+}
+
+// RemoveAgentReservations relationships from objects passed in.
+// Removes related items from R.AgentReservations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Scenario.
+func (o *Scenario) RemoveAgentReservations(ctx context.Context, exec boil.ContextExecutor, related ...*AgentReservation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ScenarioID, nil)
+		if rel.R != nil {
+			rel.R.Scenario = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("scenario_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AgentReservations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AgentReservations)
+			if ln > 1 && i < ln-1 {
+				o.R.AgentReservations[i] = o.R.AgentReservations[ln-1]
+			}
+			o.R.AgentReservations = o.R.AgentReservations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddScenarioBaselines adds the given related objects to the existing relationships
+// of the scenario, optionally inserting them as new records.
+// Appends related to o.R.ScenarioBaselines.
+// Sets related.R.Scenario appropriately.
+func (o *Scenario) AddScenarioBaselines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ScenarioBaseline) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScenarioID = o.ScenarioID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"scenario_baselines\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scenarioBaselinePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ScenarioID, rel.ScenarioBaselineID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScenarioID = o.ScenarioID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scenarioR{
+			ScenarioBaselines: related,
+		}
+	} else {
+		o.R.ScenarioBaselines = append(o.R.ScenarioBaselines, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scenarioBaselineR{
+				Scenario: o,
+			}
+		} else {
+			rel.R.Scenario = o
+		}
+	}
+	return nil
+}
+
+// AddSchedules adds the given related objects to the existing relationships
+// of the scenario, optionally inserting them as new records.
+// Appends related to o.R.Schedules.
+// Sets related.R.Scenario appropriately.
+func (o *Scenario) AddSchedules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Schedule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScenarioID = o.ScenarioID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"schedules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+				strmangle.WhereClause("\"", "\"", 2, schedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ScenarioID, rel.ScheduleID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScenarioID = o.ScenarioID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scenarioR{
+			Schedules: related,
+		}
+	} else {
+		o.R.Schedules = append(o.R.Schedules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &scheduleR{
+				Scenario: o,
+			}
+		} else {
+			rel.R.Scenario = o
+		}
+	}
+	return nil
+}
+
+// AddScripts adds the given related objects to the existing relationships
+// of the scenario, optionally inserting them as new records.
+// Appends related to o.R.Scripts.
+// Sets related.R.Scenario appropriately.
+func (o *Scenario) AddScripts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Script) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScenarioID = o.ScenarioID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"scripts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+				strmangle.WhereClause("\"", "\"", 2, scriptPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ScenarioID, rel.ScriptID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScenarioID = o.ScenarioID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scenarioR{
+			Scripts: related,
+		}
 	} else {
 		o.R.Scripts = append(o.R.Scripts, related...)
 	}
@@ -1024,6 +1885,59 @@ func (o *Scenario) AddSimpleScripts(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddStopConditions adds the given related objects to the existing relationships
+// of the scenario, optionally inserting them as new records.
+// Appends related to o.R.StopConditions.
+// Sets related.R.Scenario appropriately.
+func (o *Scenario) AddStopConditions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StopCondition) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScenarioID = o.ScenarioID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"stop_conditions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+				strmangle.WhereClause("\"", "\"", 2, stopConditionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ScenarioID, rel.StopConditionID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScenarioID = o.ScenarioID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &scenarioR{
+			StopConditions: related,
+		}
+	} else {
+		o.R.StopConditions = append(o.R.StopConditions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &stopConditionR{
+				Scenario: o,
+			}
+		} else {
+			rel.R.Scenario = o
+		}
+	}
+	return nil
+}
+
 // Scenarios retrieves all the records using an executor.
 func Scenarios(mods ...qm.QueryMod) scenarioQuery {
 	mods = append(mods, qm.From("\"scenarios\""))
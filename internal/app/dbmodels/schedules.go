@@ -0,0 +1,1256 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Schedule is an object representing the database table.
+type Schedule struct {
+	ScheduleID         int32     `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	ProjectID          int32     `boil:"project_id" json:"project_id" toml:"project_id" yaml:"project_id"`
+	ScenarioID         int32     `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
+	Title              string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Cron               string    `boil:"cron" json:"cron" toml:"cron" yaml:"cron"`
+	RunAt              null.Time `boil:"run_at" json:"run_at,omitempty" toml:"run_at" yaml:"run_at,omitempty"`
+	NextRunAt          null.Time `boil:"next_run_at" json:"next_run_at,omitempty" toml:"next_run_at" yaml:"next_run_at,omitempty"`
+	LastRunAt          null.Time `boil:"last_run_at" json:"last_run_at,omitempty" toml:"last_run_at" yaml:"last_run_at,omitempty"`
+	LastRunID          int32     `boil:"last_run_id" json:"last_run_id" toml:"last_run_id" yaml:"last_run_id"`
+	LastMessage        string    `boil:"last_message" json:"last_message" toml:"last_message" yaml:"last_message"`
+	PercentageOfTarget int32     `boil:"percentage_of_target" json:"percentage_of_target" toml:"percentage_of_target" yaml:"percentage_of_target"`
+	UserName           string    `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	PreferredUserName  string    `boil:"preferred_user_name" json:"preferred_user_name" toml:"preferred_user_name" yaml:"preferred_user_name"`
+	Enabled            bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt          time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *scheduleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scheduleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScheduleColumns = struct {
+	ScheduleID         string
+	ProjectID          string
+	ScenarioID         string
+	Title              string
+	Cron               string
+	RunAt              string
+	NextRunAt          string
+	LastRunAt          string
+	LastRunID          string
+	LastMessage        string
+	PercentageOfTarget string
+	UserName           string
+	PreferredUserName  string
+	Enabled            string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	ScheduleID:         "schedule_id",
+	ProjectID:          "project_id",
+	ScenarioID:         "scenario_id",
+	Title:              "title",
+	Cron:               "cron",
+	RunAt:              "run_at",
+	NextRunAt:          "next_run_at",
+	LastRunAt:          "last_run_at",
+	LastRunID:          "last_run_id",
+	LastMessage:        "last_message",
+	PercentageOfTarget: "percentage_of_target",
+	UserName:           "user_name",
+	PreferredUserName:  "preferred_user_name",
+	Enabled:            "enabled",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
+}
+
+var ScheduleTableColumns = struct {
+	ScheduleID         string
+	ProjectID          string
+	ScenarioID         string
+	Title              string
+	Cron               string
+	RunAt              string
+	NextRunAt          string
+	LastRunAt          string
+	LastRunID          string
+	LastMessage        string
+	PercentageOfTarget string
+	UserName           string
+	PreferredUserName  string
+	Enabled            string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
+}{
+	ScheduleID:         "schedules.schedule_id",
+	ProjectID:          "schedules.project_id",
+	ScenarioID:         "schedules.scenario_id",
+	Title:              "schedules.title",
+	Cron:               "schedules.cron",
+	RunAt:              "schedules.run_at",
+	NextRunAt:          "schedules.next_run_at",
+	LastRunAt:          "schedules.last_run_at",
+	LastRunID:          "schedules.last_run_id",
+	LastMessage:        "schedules.last_message",
+	PercentageOfTarget: "schedules.percentage_of_target",
+	UserName:           "schedules.user_name",
+	PreferredUserName:  "schedules.preferred_user_name",
+	Enabled:            "schedules.enabled",
+	CreatedAt:          "schedules.created_at",
+	UpdatedAt:          "schedules.updated_at",
+	DeletedAt:          "schedules.deleted_at",
+}
+
+// Generated where
+
+var ScheduleWhere = struct {
+	ScheduleID         whereHelperint32
+	ProjectID          whereHelperint32
+	ScenarioID         whereHelperint32
+	Title              whereHelperstring
+	Cron               whereHelperstring
+	RunAt              whereHelpernull_Time
+	NextRunAt          whereHelpernull_Time
+	LastRunAt          whereHelpernull_Time
+	LastRunID          whereHelperint32
+	LastMessage        whereHelperstring
+	PercentageOfTarget whereHelperint32
+	UserName           whereHelperstring
+	PreferredUserName  whereHelperstring
+	Enabled            whereHelperbool
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+}{
+	ScheduleID:         whereHelperint32{field: "\"schedules\".\"schedule_id\""},
+	ProjectID:          whereHelperint32{field: "\"schedules\".\"project_id\""},
+	ScenarioID:         whereHelperint32{field: "\"schedules\".\"scenario_id\""},
+	Title:              whereHelperstring{field: "\"schedules\".\"title\""},
+	Cron:               whereHelperstring{field: "\"schedules\".\"cron\""},
+	RunAt:              whereHelpernull_Time{field: "\"schedules\".\"run_at\""},
+	NextRunAt:          whereHelpernull_Time{field: "\"schedules\".\"next_run_at\""},
+	LastRunAt:          whereHelpernull_Time{field: "\"schedules\".\"last_run_at\""},
+	LastRunID:          whereHelperint32{field: "\"schedules\".\"last_run_id\""},
+	LastMessage:        whereHelperstring{field: "\"schedules\".\"last_message\""},
+	PercentageOfTarget: whereHelperint32{field: "\"schedules\".\"percentage_of_target\""},
+	UserName:           whereHelperstring{field: "\"schedules\".\"user_name\""},
+	PreferredUserName:  whereHelperstring{field: "\"schedules\".\"preferred_user_name\""},
+	Enabled:            whereHelperbool{field: "\"schedules\".\"enabled\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"schedules\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"schedules\".\"updated_at\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"schedules\".\"deleted_at\""},
+}
+
+// ScheduleRels is where relationship names are stored.
+var ScheduleRels = struct {
+	Scenario string
+}{
+	Scenario: "Scenario",
+}
+
+// scheduleR is where relationships are stored.
+type scheduleR struct {
+	Scenario *Scenario `boil:"Scenario" json:"Scenario" toml:"Scenario" yaml:"Scenario"`
+}
+
+// NewStruct creates a new relationship struct
+func (*scheduleR) NewStruct() *scheduleR {
+	return &scheduleR{}
+}
+
+func (o *Schedule) GetScenario() *Scenario {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenario()
+}
+
+func (r *scheduleR) GetScenario() *Scenario {
+	if r == nil {
+		return nil
+	}
+
+	return r.Scenario
+}
+
+// scheduleL is where Load methods for each relationship are stored.
+type scheduleL struct{}
+
+var (
+	scheduleAllColumns            = []string{"schedule_id", "project_id", "scenario_id", "title", "cron", "run_at", "next_run_at", "last_run_at", "last_run_id", "last_message", "percentage_of_target", "user_name", "preferred_user_name", "enabled", "created_at", "updated_at", "deleted_at"}
+	scheduleColumnsWithoutDefault = []string{"project_id", "scenario_id"}
+	scheduleColumnsWithDefault    = []string{"schedule_id", "title", "cron", "run_at", "next_run_at", "last_run_at", "last_run_id", "last_message", "percentage_of_target", "user_name", "preferred_user_name", "enabled", "created_at", "updated_at", "deleted_at"}
+	schedulePrimaryKeyColumns     = []string{"schedule_id"}
+	scheduleGeneratedColumns      = []string{}
+)
+
+type (
+	// ScheduleSlice is an alias for a slice of pointers to Schedule.
+	// This should almost always be used instead of []Schedule.
+	ScheduleSlice []*Schedule
+	// ScheduleHook is the signature for custom Schedule hook methods
+	ScheduleHook func(context.Context, boil.ContextExecutor, *Schedule) error
+
+	scheduleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scheduleType                 = reflect.TypeOf(&Schedule{})
+	scheduleMapping              = queries.MakeStructMapping(scheduleType)
+	schedulePrimaryKeyMapping, _ = queries.BindMapping(scheduleType, scheduleMapping, schedulePrimaryKeyColumns)
+	scheduleInsertCacheMut       sync.RWMutex
+	scheduleInsertCache          = make(map[string]insertCache)
+	scheduleUpdateCacheMut       sync.RWMutex
+	scheduleUpdateCache          = make(map[string]updateCache)
+	scheduleUpsertCacheMut       sync.RWMutex
+	scheduleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scheduleAfterSelectMu sync.Mutex
+var scheduleAfterSelectHooks []ScheduleHook
+
+var scheduleBeforeInsertMu sync.Mutex
+var scheduleBeforeInsertHooks []ScheduleHook
+var scheduleAfterInsertMu sync.Mutex
+var scheduleAfterInsertHooks []ScheduleHook
+
+var scheduleBeforeUpdateMu sync.Mutex
+var scheduleBeforeUpdateHooks []ScheduleHook
+var scheduleAfterUpdateMu sync.Mutex
+var scheduleAfterUpdateHooks []ScheduleHook
+
+var scheduleBeforeDeleteMu sync.Mutex
+var scheduleBeforeDeleteHooks []ScheduleHook
+var scheduleAfterDeleteMu sync.Mutex
+var scheduleAfterDeleteHooks []ScheduleHook
+
+var scheduleBeforeUpsertMu sync.Mutex
+var scheduleBeforeUpsertHooks []ScheduleHook
+var scheduleAfterUpsertMu sync.Mutex
+var scheduleAfterUpsertHooks []ScheduleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Schedule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Schedule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Schedule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Schedule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Schedule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Schedule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Schedule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Schedule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Schedule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scheduleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScheduleHook registers your hook function for all future operations.
+func AddScheduleHook(hookPoint boil.HookPoint, scheduleHook ScheduleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		scheduleAfterSelectMu.Lock()
+		scheduleAfterSelectHooks = append(scheduleAfterSelectHooks, scheduleHook)
+		scheduleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		scheduleBeforeInsertMu.Lock()
+		scheduleBeforeInsertHooks = append(scheduleBeforeInsertHooks, scheduleHook)
+		scheduleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		scheduleAfterInsertMu.Lock()
+		scheduleAfterInsertHooks = append(scheduleAfterInsertHooks, scheduleHook)
+		scheduleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		scheduleBeforeUpdateMu.Lock()
+		scheduleBeforeUpdateHooks = append(scheduleBeforeUpdateHooks, scheduleHook)
+		scheduleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		scheduleAfterUpdateMu.Lock()
+		scheduleAfterUpdateHooks = append(scheduleAfterUpdateHooks, scheduleHook)
+		scheduleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		scheduleBeforeDeleteMu.Lock()
+		scheduleBeforeDeleteHooks = append(scheduleBeforeDeleteHooks, scheduleHook)
+		scheduleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		scheduleAfterDeleteMu.Lock()
+		scheduleAfterDeleteHooks = append(scheduleAfterDeleteHooks, scheduleHook)
+		scheduleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		scheduleBeforeUpsertMu.Lock()
+		scheduleBeforeUpsertHooks = append(scheduleBeforeUpsertHooks, scheduleHook)
+		scheduleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		scheduleAfterUpsertMu.Lock()
+		scheduleAfterUpsertHooks = append(scheduleAfterUpsertHooks, scheduleHook)
+		scheduleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single schedule record from the query.
+func (q scheduleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Schedule, error) {
+	o := &Schedule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for schedules")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Schedule records from the query.
+func (q scheduleQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScheduleSlice, error) {
+	var o []*Schedule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Schedule slice")
+	}
+
+	if len(scheduleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Schedule records in the query.
+func (q scheduleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count schedules rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scheduleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if schedules exists")
+	}
+
+	return count > 0, nil
+}
+
+// Scenario pointed to by the foreign key.
+func (o *Schedule) Scenario(mods ...qm.QueryMod) scenarioQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"scenario_id\" = ?", o.ScenarioID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Scenarios(queryMods...)
+}
+
+// LoadScenario allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scheduleL) LoadScenario(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSchedule interface{}, mods queries.Applicator) error {
+	var slice []*Schedule
+	var object *Schedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeSchedule.(*Schedule)
+		if !ok {
+			object = new(Schedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeSchedule.(*[]*Schedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scheduleR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scheduleR{}
+			}
+
+			args[obj.ScenarioID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scenarios`),
+		qm.WhereIn(`scenarios.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Scenario")
+	}
+
+	var resultSlice []*Scenario
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Scenario")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for scenarios")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenarios")
+	}
+
+	if len(scenarioAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Scenario = foreign
+		if foreign.R == nil {
+			foreign.R = &scenarioR{}
+		}
+		foreign.R.Schedules = append(foreign.R.Schedules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.Scenario = foreign
+				if foreign.R == nil {
+					foreign.R = &scenarioR{}
+				}
+				foreign.R.Schedules = append(foreign.R.Schedules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetScenario of the schedule to the related item.
+// Sets o.R.Scenario to related.
+// Adds o to related.R.Schedules.
+func (o *Schedule) SetScenario(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Scenario) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+		strmangle.WhereClause("\"", "\"", 2, schedulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ScenarioID, o.ScheduleID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScenarioID = related.ScenarioID
+	if o.R == nil {
+		o.R = &scheduleR{
+			Scenario: related,
+		}
+	} else {
+		o.R.Scenario = related
+	}
+
+	if related.R == nil {
+		related.R = &scenarioR{
+			Schedules: ScheduleSlice{o},
+		}
+	} else {
+		related.R.Schedules = append(related.R.Schedules, o)
+	}
+
+	return nil
+}
+
+// Schedules retrieves all the records using an executor.
+func Schedules(mods ...qm.QueryMod) scheduleQuery {
+	mods = append(mods, qm.From("\"schedules\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"schedules\".*"})
+	}
+
+	return scheduleQuery{q}
+}
+
+// FindSchedule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSchedule(ctx context.Context, exec boil.ContextExecutor, scheduleID int32, selectCols ...string) (*Schedule, error) {
+	scheduleObj := &Schedule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"schedules\" where \"schedule_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, scheduleID)
+
+	err := q.Bind(ctx, exec, scheduleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from schedules")
+	}
+
+	if err = scheduleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return scheduleObj, err
+	}
+
+	return scheduleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Schedule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no schedules provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scheduleInsertCacheMut.RLock()
+	cache, cached := scheduleInsertCache[key]
+	scheduleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scheduleAllColumns,
+			scheduleColumnsWithDefault,
+			scheduleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scheduleType, scheduleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scheduleType, scheduleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"schedules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"schedules\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into schedules")
+	}
+
+	if !cached {
+		scheduleInsertCacheMut.Lock()
+		scheduleInsertCache[key] = cache
+		scheduleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Schedule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Schedule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scheduleUpdateCacheMut.RLock()
+	cache, cached := scheduleUpdateCache[key]
+	scheduleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scheduleAllColumns,
+			schedulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update schedules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"schedules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, schedulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scheduleType, scheduleMapping, append(wl, schedulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update schedules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for schedules")
+	}
+
+	if !cached {
+		scheduleUpdateCacheMut.Lock()
+		scheduleUpdateCache[key] = cache
+		scheduleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scheduleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScheduleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"schedules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, schedulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in schedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all schedule")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Schedule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no schedules provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scheduleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	scheduleUpsertCacheMut.RLock()
+	cache, cached := scheduleUpsertCache[key]
+	scheduleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			scheduleAllColumns,
+			scheduleColumnsWithDefault,
+			scheduleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			scheduleAllColumns,
+			schedulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert schedules, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(scheduleAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(schedulePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert schedules, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(schedulePrimaryKeyColumns))
+			copy(conflict, schedulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"schedules\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(scheduleType, scheduleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(scheduleType, scheduleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert schedules")
+	}
+
+	if !cached {
+		scheduleUpsertCacheMut.Lock()
+		scheduleUpsertCache[key] = cache
+		scheduleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Schedule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Schedule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Schedule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), schedulePrimaryKeyMapping)
+	sql := "DELETE FROM \"schedules\" WHERE \"schedule_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for schedules")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scheduleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no scheduleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from schedules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for schedules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScheduleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scheduleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"schedules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schedulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from schedule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for schedules")
+	}
+
+	if len(scheduleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Schedule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSchedule(ctx, exec, o.ScheduleID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScheduleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScheduleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), schedulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"schedules\".* FROM \"schedules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, schedulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ScheduleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScheduleExists checks if the Schedule row exists.
+func ScheduleExists(ctx context.Context, exec boil.ContextExecutor, scheduleID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"schedules\" where \"schedule_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, scheduleID)
+	}
+	row := exec.QueryRowContext(ctx, sql, scheduleID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if schedules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Schedule row exists.
+func (o *Schedule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ScheduleExists(ctx, exec, o.ScheduleID)
+}
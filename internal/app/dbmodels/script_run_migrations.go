@@ -0,0 +1,1189 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ScriptRunMigration is an object representing the database table.
+type ScriptRunMigration struct {
+	ScriptRunMigrationID int32     `boil:"script_run_migration_id" json:"script_run_migration_id" toml:"script_run_migration_id" yaml:"script_run_migration_id"`
+	RunID                int32     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	RunScriptID          int32     `boil:"run_script_id" json:"run_script_id" toml:"run_script_id" yaml:"run_script_id"`
+	Pid                  int32     `boil:"pid" json:"pid" toml:"pid" yaml:"pid"`
+	AgentHost            string    `boil:"agent_host" json:"agent_host" toml:"agent_host" yaml:"agent_host"`
+	Reason               string    `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Owner                string    `boil:"owner" json:"owner" toml:"owner" yaml:"owner"`
+	AgentStopped         bool      `boil:"agent_stopped" json:"agent_stopped" toml:"agent_stopped" yaml:"agent_stopped"`
+	CreatedAt            time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *scriptRunMigrationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scriptRunMigrationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScriptRunMigrationColumns = struct {
+	ScriptRunMigrationID string
+	RunID                string
+	RunScriptID          string
+	Pid                  string
+	AgentHost            string
+	Reason               string
+	Owner                string
+	AgentStopped         string
+	CreatedAt            string
+}{
+	ScriptRunMigrationID: "script_run_migration_id",
+	RunID:                "run_id",
+	RunScriptID:          "run_script_id",
+	Pid:                  "pid",
+	AgentHost:            "agent_host",
+	Reason:               "reason",
+	Owner:                "owner",
+	AgentStopped:         "agent_stopped",
+	CreatedAt:            "created_at",
+}
+
+var ScriptRunMigrationTableColumns = struct {
+	ScriptRunMigrationID string
+	RunID                string
+	RunScriptID          string
+	Pid                  string
+	AgentHost            string
+	Reason               string
+	Owner                string
+	AgentStopped         string
+	CreatedAt            string
+}{
+	ScriptRunMigrationID: "script_run_migrations.script_run_migration_id",
+	RunID:                "script_run_migrations.run_id",
+	RunScriptID:          "script_run_migrations.run_script_id",
+	Pid:                  "script_run_migrations.pid",
+	AgentHost:            "script_run_migrations.agent_host",
+	Reason:               "script_run_migrations.reason",
+	Owner:                "script_run_migrations.owner",
+	AgentStopped:         "script_run_migrations.agent_stopped",
+	CreatedAt:            "script_run_migrations.created_at",
+}
+
+// Generated where
+
+var ScriptRunMigrationWhere = struct {
+	ScriptRunMigrationID whereHelperint32
+	RunID                whereHelperint32
+	RunScriptID          whereHelperint32
+	Pid                  whereHelperint32
+	AgentHost            whereHelperstring
+	Reason               whereHelperstring
+	Owner                whereHelperstring
+	AgentStopped         whereHelperbool
+	CreatedAt            whereHelpertime_Time
+}{
+	ScriptRunMigrationID: whereHelperint32{field: "\"script_run_migrations\".\"script_run_migration_id\""},
+	RunID:                whereHelperint32{field: "\"script_run_migrations\".\"run_id\""},
+	RunScriptID:          whereHelperint32{field: "\"script_run_migrations\".\"run_script_id\""},
+	Pid:                  whereHelperint32{field: "\"script_run_migrations\".\"pid\""},
+	AgentHost:            whereHelperstring{field: "\"script_run_migrations\".\"agent_host\""},
+	Reason:               whereHelperstring{field: "\"script_run_migrations\".\"reason\""},
+	Owner:                whereHelperstring{field: "\"script_run_migrations\".\"owner\""},
+	AgentStopped:         whereHelperbool{field: "\"script_run_migrations\".\"agent_stopped\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"script_run_migrations\".\"created_at\""},
+}
+
+// ScriptRunMigrationRels is where relationship names are stored.
+var ScriptRunMigrationRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// scriptRunMigrationR is where relationships are stored.
+type scriptRunMigrationR struct {
+	Run *Run `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*scriptRunMigrationR) NewStruct() *scriptRunMigrationR {
+	return &scriptRunMigrationR{}
+}
+
+func (o *ScriptRunMigration) GetRun() *Run {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRun()
+}
+
+func (r *scriptRunMigrationR) GetRun() *Run {
+	if r == nil {
+		return nil
+	}
+
+	return r.Run
+}
+
+// scriptRunMigrationL is where Load methods for each relationship are stored.
+type scriptRunMigrationL struct{}
+
+var (
+	scriptRunMigrationAllColumns            = []string{"script_run_migration_id", "run_id", "run_script_id", "pid", "agent_host", "reason", "owner", "agent_stopped", "created_at"}
+	scriptRunMigrationColumnsWithoutDefault = []string{"run_id", "run_script_id", "pid"}
+	scriptRunMigrationColumnsWithDefault    = []string{"script_run_migration_id", "agent_host", "reason", "owner", "agent_stopped", "created_at"}
+	scriptRunMigrationPrimaryKeyColumns     = []string{"script_run_migration_id"}
+	scriptRunMigrationGeneratedColumns      = []string{}
+)
+
+type (
+	// ScriptRunMigrationSlice is an alias for a slice of pointers to ScriptRunMigration.
+	// This should almost always be used instead of []ScriptRunMigration.
+	ScriptRunMigrationSlice []*ScriptRunMigration
+	// ScriptRunMigrationHook is the signature for custom ScriptRunMigration hook methods
+	ScriptRunMigrationHook func(context.Context, boil.ContextExecutor, *ScriptRunMigration) error
+
+	scriptRunMigrationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scriptRunMigrationType                 = reflect.TypeOf(&ScriptRunMigration{})
+	scriptRunMigrationMapping              = queries.MakeStructMapping(scriptRunMigrationType)
+	scriptRunMigrationPrimaryKeyMapping, _ = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, scriptRunMigrationPrimaryKeyColumns)
+	scriptRunMigrationInsertCacheMut       sync.RWMutex
+	scriptRunMigrationInsertCache          = make(map[string]insertCache)
+	scriptRunMigrationUpdateCacheMut       sync.RWMutex
+	scriptRunMigrationUpdateCache          = make(map[string]updateCache)
+	scriptRunMigrationUpsertCacheMut       sync.RWMutex
+	scriptRunMigrationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scriptRunMigrationAfterSelectMu sync.Mutex
+var scriptRunMigrationAfterSelectHooks []ScriptRunMigrationHook
+
+var scriptRunMigrationBeforeInsertMu sync.Mutex
+var scriptRunMigrationBeforeInsertHooks []ScriptRunMigrationHook
+var scriptRunMigrationAfterInsertMu sync.Mutex
+var scriptRunMigrationAfterInsertHooks []ScriptRunMigrationHook
+
+var scriptRunMigrationBeforeUpdateMu sync.Mutex
+var scriptRunMigrationBeforeUpdateHooks []ScriptRunMigrationHook
+var scriptRunMigrationAfterUpdateMu sync.Mutex
+var scriptRunMigrationAfterUpdateHooks []ScriptRunMigrationHook
+
+var scriptRunMigrationBeforeDeleteMu sync.Mutex
+var scriptRunMigrationBeforeDeleteHooks []ScriptRunMigrationHook
+var scriptRunMigrationAfterDeleteMu sync.Mutex
+var scriptRunMigrationAfterDeleteHooks []ScriptRunMigrationHook
+
+var scriptRunMigrationBeforeUpsertMu sync.Mutex
+var scriptRunMigrationBeforeUpsertHooks []ScriptRunMigrationHook
+var scriptRunMigrationAfterUpsertMu sync.Mutex
+var scriptRunMigrationAfterUpsertHooks []ScriptRunMigrationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ScriptRunMigration) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ScriptRunMigration) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ScriptRunMigration) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ScriptRunMigration) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ScriptRunMigration) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ScriptRunMigration) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ScriptRunMigration) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ScriptRunMigration) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ScriptRunMigration) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptRunMigrationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScriptRunMigrationHook registers your hook function for all future operations.
+func AddScriptRunMigrationHook(hookPoint boil.HookPoint, scriptRunMigrationHook ScriptRunMigrationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		scriptRunMigrationAfterSelectMu.Lock()
+		scriptRunMigrationAfterSelectHooks = append(scriptRunMigrationAfterSelectHooks, scriptRunMigrationHook)
+		scriptRunMigrationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		scriptRunMigrationBeforeInsertMu.Lock()
+		scriptRunMigrationBeforeInsertHooks = append(scriptRunMigrationBeforeInsertHooks, scriptRunMigrationHook)
+		scriptRunMigrationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		scriptRunMigrationAfterInsertMu.Lock()
+		scriptRunMigrationAfterInsertHooks = append(scriptRunMigrationAfterInsertHooks, scriptRunMigrationHook)
+		scriptRunMigrationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		scriptRunMigrationBeforeUpdateMu.Lock()
+		scriptRunMigrationBeforeUpdateHooks = append(scriptRunMigrationBeforeUpdateHooks, scriptRunMigrationHook)
+		scriptRunMigrationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		scriptRunMigrationAfterUpdateMu.Lock()
+		scriptRunMigrationAfterUpdateHooks = append(scriptRunMigrationAfterUpdateHooks, scriptRunMigrationHook)
+		scriptRunMigrationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		scriptRunMigrationBeforeDeleteMu.Lock()
+		scriptRunMigrationBeforeDeleteHooks = append(scriptRunMigrationBeforeDeleteHooks, scriptRunMigrationHook)
+		scriptRunMigrationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		scriptRunMigrationAfterDeleteMu.Lock()
+		scriptRunMigrationAfterDeleteHooks = append(scriptRunMigrationAfterDeleteHooks, scriptRunMigrationHook)
+		scriptRunMigrationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		scriptRunMigrationBeforeUpsertMu.Lock()
+		scriptRunMigrationBeforeUpsertHooks = append(scriptRunMigrationBeforeUpsertHooks, scriptRunMigrationHook)
+		scriptRunMigrationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		scriptRunMigrationAfterUpsertMu.Lock()
+		scriptRunMigrationAfterUpsertHooks = append(scriptRunMigrationAfterUpsertHooks, scriptRunMigrationHook)
+		scriptRunMigrationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single scriptRunMigration record from the query.
+func (q scriptRunMigrationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ScriptRunMigration, error) {
+	o := &ScriptRunMigration{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for script_run_migrations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ScriptRunMigration records from the query.
+func (q scriptRunMigrationQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScriptRunMigrationSlice, error) {
+	var o []*ScriptRunMigration
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ScriptRunMigration slice")
+	}
+
+	if len(scriptRunMigrationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ScriptRunMigration records in the query.
+func (q scriptRunMigrationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count script_run_migrations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scriptRunMigrationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if script_run_migrations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *ScriptRunMigration) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Runs(queryMods...)
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (scriptRunMigrationL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeScriptRunMigration interface{}, mods queries.Applicator) error {
+	var slice []*ScriptRunMigration
+	var object *ScriptRunMigration
+
+	if singular {
+		var ok bool
+		object, ok = maybeScriptRunMigration.(*ScriptRunMigration)
+		if !ok {
+			object = new(ScriptRunMigration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeScriptRunMigration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeScriptRunMigration))
+			}
+		}
+	} else {
+		s, ok := maybeScriptRunMigration.(*[]*ScriptRunMigration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeScriptRunMigration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeScriptRunMigration))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &scriptRunMigrationR{}
+		}
+		args[object.RunID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &scriptRunMigrationR{}
+			}
+
+			args[obj.RunID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.run_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.ScriptRunMigrations = append(foreign.R.ScriptRunMigrations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.ScriptRunMigrations = append(foreign.R.ScriptRunMigrations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the scriptRunMigration to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.ScriptRunMigrations.
+func (o *ScriptRunMigration) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"script_run_migrations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, scriptRunMigrationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.ScriptRunMigrationID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &scriptRunMigrationR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			ScriptRunMigrations: ScriptRunMigrationSlice{o},
+		}
+	} else {
+		related.R.ScriptRunMigrations = append(related.R.ScriptRunMigrations, o)
+	}
+
+	return nil
+}
+
+// ScriptRunMigrations retrieves all the records using an executor.
+func ScriptRunMigrations(mods ...qm.QueryMod) scriptRunMigrationQuery {
+	mods = append(mods, qm.From("\"script_run_migrations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"script_run_migrations\".*"})
+	}
+
+	return scriptRunMigrationQuery{q}
+}
+
+// FindScriptRunMigration retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindScriptRunMigration(ctx context.Context, exec boil.ContextExecutor, scriptRunMigrationID int32, selectCols ...string) (*ScriptRunMigration, error) {
+	scriptRunMigrationObj := &ScriptRunMigration{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"script_run_migrations\" where \"script_run_migration_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, scriptRunMigrationID)
+
+	err := q.Bind(ctx, exec, scriptRunMigrationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from script_run_migrations")
+	}
+
+	if err = scriptRunMigrationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return scriptRunMigrationObj, err
+	}
+
+	return scriptRunMigrationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ScriptRunMigration) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no script_run_migrations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scriptRunMigrationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scriptRunMigrationInsertCacheMut.RLock()
+	cache, cached := scriptRunMigrationInsertCache[key]
+	scriptRunMigrationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scriptRunMigrationAllColumns,
+			scriptRunMigrationColumnsWithDefault,
+			scriptRunMigrationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"script_run_migrations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"script_run_migrations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into script_run_migrations")
+	}
+
+	if !cached {
+		scriptRunMigrationInsertCacheMut.Lock()
+		scriptRunMigrationInsertCache[key] = cache
+		scriptRunMigrationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ScriptRunMigration.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ScriptRunMigration) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scriptRunMigrationUpdateCacheMut.RLock()
+	cache, cached := scriptRunMigrationUpdateCache[key]
+	scriptRunMigrationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scriptRunMigrationAllColumns,
+			scriptRunMigrationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update script_run_migrations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"script_run_migrations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, scriptRunMigrationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, append(wl, scriptRunMigrationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update script_run_migrations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for script_run_migrations")
+	}
+
+	if !cached {
+		scriptRunMigrationUpdateCacheMut.Lock()
+		scriptRunMigrationUpdateCache[key] = cache
+		scriptRunMigrationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scriptRunMigrationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for script_run_migrations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for script_run_migrations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScriptRunMigrationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptRunMigrationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"script_run_migrations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, scriptRunMigrationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in scriptRunMigration slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all scriptRunMigration")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ScriptRunMigration) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no script_run_migrations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scriptRunMigrationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	scriptRunMigrationUpsertCacheMut.RLock()
+	cache, cached := scriptRunMigrationUpsertCache[key]
+	scriptRunMigrationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			scriptRunMigrationAllColumns,
+			scriptRunMigrationColumnsWithDefault,
+			scriptRunMigrationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			scriptRunMigrationAllColumns,
+			scriptRunMigrationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert script_run_migrations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(scriptRunMigrationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(scriptRunMigrationPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert script_run_migrations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(scriptRunMigrationPrimaryKeyColumns))
+			copy(conflict, scriptRunMigrationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"script_run_migrations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(scriptRunMigrationType, scriptRunMigrationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert script_run_migrations")
+	}
+
+	if !cached {
+		scriptRunMigrationUpsertCacheMut.Lock()
+		scriptRunMigrationUpsertCache[key] = cache
+		scriptRunMigrationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ScriptRunMigration record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ScriptRunMigration) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ScriptRunMigration provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), scriptRunMigrationPrimaryKeyMapping)
+	sql := "DELETE FROM \"script_run_migrations\" WHERE \"script_run_migration_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from script_run_migrations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for script_run_migrations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scriptRunMigrationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no scriptRunMigrationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from script_run_migrations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for script_run_migrations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScriptRunMigrationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scriptRunMigrationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptRunMigrationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"script_run_migrations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, scriptRunMigrationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from scriptRunMigration slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for script_run_migrations")
+	}
+
+	if len(scriptRunMigrationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ScriptRunMigration) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindScriptRunMigration(ctx, exec, o.ScriptRunMigrationID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScriptRunMigrationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScriptRunMigrationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptRunMigrationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"script_run_migrations\".* FROM \"script_run_migrations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, scriptRunMigrationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ScriptRunMigrationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScriptRunMigrationExists checks if the ScriptRunMigration row exists.
+func ScriptRunMigrationExists(ctx context.Context, exec boil.ContextExecutor, scriptRunMigrationID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"script_run_migrations\" where \"script_run_migration_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, scriptRunMigrationID)
+	}
+	row := exec.QueryRowContext(ctx, sql, scriptRunMigrationID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if script_run_migrations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ScriptRunMigration row exists.
+func (o *ScriptRunMigration) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ScriptRunMigrationExists(ctx, exec, o.ScriptRunMigrationID)
+}
@@ -60,6 +60,11 @@ type SimpleScript struct {
 	CMTErr          string            `boil:"cmt_err" json:"cmt_err" toml:"cmt_err" yaml:"cmt_err"`
 	AdditionalEnv   string            `boil:"additional_env" json:"additional_env" toml:"additional_env" yaml:"additional_env"`
 	Title           string            `boil:"title" json:"title" toml:"title" yaml:"title"`
+	LoadProfile     string            `boil:"load_profile" json:"load_profile" toml:"load_profile" yaml:"load_profile"`
+	Protocol        string            `boil:"protocol" json:"protocol" toml:"protocol" yaml:"protocol"`
+	GRPCService     string            `boil:"grpc_service" json:"grpc_service" toml:"grpc_service" yaml:"grpc_service"`
+	GRPCMethod      string            `boil:"grpc_method" json:"grpc_method" toml:"grpc_method" yaml:"grpc_method"`
+	DescriptorURL   string            `boil:"descriptor_url" json:"descriptor_url" toml:"descriptor_url" yaml:"descriptor_url"`
 
 	R *simpleScriptR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L simpleScriptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -101,6 +106,11 @@ var SimpleScriptColumns = struct {
 	CMTErr          string
 	AdditionalEnv   string
 	Title           string
+	LoadProfile     string
+	Protocol        string
+	GRPCService     string
+	GRPCMethod      string
+	DescriptorURL   string
 }{
 	ScriptID:        "script_id",
 	Name:            "name",
@@ -137,6 +147,11 @@ var SimpleScriptColumns = struct {
 	CMTErr:          "cmt_err",
 	AdditionalEnv:   "additional_env",
 	Title:           "title",
+	LoadProfile:     "load_profile",
+	Protocol:        "protocol",
+	GRPCService:     "grpc_service",
+	GRPCMethod:      "grpc_method",
+	DescriptorURL:   "descriptor_url",
 }
 
 var SimpleScriptTableColumns = struct {
@@ -175,6 +190,11 @@ var SimpleScriptTableColumns = struct {
 	CMTErr          string
 	AdditionalEnv   string
 	Title           string
+	LoadProfile     string
+	Protocol        string
+	GRPCService     string
+	GRPCMethod      string
+	DescriptorURL   string
 }{
 	ScriptID:        "simple_scripts.script_id",
 	Name:            "simple_scripts.name",
@@ -211,6 +231,11 @@ var SimpleScriptTableColumns = struct {
 	CMTErr:          "simple_scripts.cmt_err",
 	AdditionalEnv:   "simple_scripts.additional_env",
 	Title:           "simple_scripts.title",
+	LoadProfile:     "simple_scripts.load_profile",
+	Protocol:        "simple_scripts.protocol",
+	GRPCService:     "simple_scripts.grpc_service",
+	GRPCMethod:      "simple_scripts.grpc_method",
+	DescriptorURL:   "simple_scripts.descriptor_url",
 }
 
 // Generated where
@@ -251,6 +276,11 @@ var SimpleScriptWhere = struct {
 	CMTErr          whereHelperstring
 	AdditionalEnv   whereHelperstring
 	Title           whereHelperstring
+	LoadProfile     whereHelperstring
+	Protocol        whereHelperstring
+	GRPCService     whereHelperstring
+	GRPCMethod      whereHelperstring
+	DescriptorURL   whereHelperstring
 }{
 	ScriptID:        whereHelperint32{field: "\"simple_scripts\".\"script_id\""},
 	Name:            whereHelperstring{field: "\"simple_scripts\".\"name\""},
@@ -287,6 +317,11 @@ var SimpleScriptWhere = struct {
 	CMTErr:          whereHelperstring{field: "\"simple_scripts\".\"cmt_err\""},
 	AdditionalEnv:   whereHelperstring{field: "\"simple_scripts\".\"additional_env\""},
 	Title:           whereHelperstring{field: "\"simple_scripts\".\"title\""},
+	LoadProfile:     whereHelperstring{field: "\"simple_scripts\".\"load_profile\""},
+	Protocol:        whereHelperstring{field: "\"simple_scripts\".\"protocol\""},
+	GRPCService:     whereHelperstring{field: "\"simple_scripts\".\"grpc_service\""},
+	GRPCMethod:      whereHelperstring{field: "\"simple_scripts\".\"grpc_method\""},
+	DescriptorURL:   whereHelperstring{field: "\"simple_scripts\".\"descriptor_url\""},
 }
 
 // SimpleScriptRels is where relationship names are stored.
@@ -326,9 +361,9 @@ func (r *simpleScriptR) GetScenario() *Scenario {
 type simpleScriptL struct{}
 
 var (
-	simpleScriptAllColumns            = []string{"script_id", "name", "description", "project_id", "scenario_id", "enabled", "monitoring_links", "tag", "scheme", "path", "http_method", "script_file_url", "static_ammo", "ammo_url", "is_static_ammo", "rps", "duration", "steps", "max_v_us", "query_params", "headers", "created_at", "updated_at", "deleted_at", "expr_rps", "source_rps", "cmt_rps", "expr_rt", "source_rt", "cmt_rt", "expr_err", "source_err", "cmt_err", "additional_env", "title"}
+	simpleScriptAllColumns            = []string{"script_id", "name", "description", "project_id", "scenario_id", "enabled", "monitoring_links", "tag", "scheme", "path", "http_method", "script_file_url", "static_ammo", "ammo_url", "is_static_ammo", "rps", "duration", "steps", "max_v_us", "query_params", "headers", "created_at", "updated_at", "deleted_at", "expr_rps", "source_rps", "cmt_rps", "expr_rt", "source_rt", "cmt_rt", "expr_err", "source_err", "cmt_err", "additional_env", "title", "load_profile", "protocol", "grpc_service", "grpc_method", "descriptor_url"}
 	simpleScriptColumnsWithoutDefault = []string{"project_id", "scenario_id", "title"}
-	simpleScriptColumnsWithDefault    = []string{"script_id", "name", "description", "enabled", "monitoring_links", "tag", "scheme", "path", "http_method", "script_file_url", "static_ammo", "ammo_url", "is_static_ammo", "rps", "duration", "steps", "max_v_us", "query_params", "headers", "created_at", "updated_at", "deleted_at", "expr_rps", "source_rps", "cmt_rps", "expr_rt", "source_rt", "cmt_rt", "expr_err", "source_err", "cmt_err", "additional_env"}
+	simpleScriptColumnsWithDefault    = []string{"script_id", "name", "description", "enabled", "monitoring_links", "tag", "scheme", "path", "http_method", "script_file_url", "static_ammo", "ammo_url", "is_static_ammo", "rps", "duration", "steps", "max_v_us", "query_params", "headers", "created_at", "updated_at", "deleted_at", "expr_rps", "source_rps", "cmt_rps", "expr_rt", "source_rt", "cmt_rt", "expr_err", "source_err", "cmt_err", "additional_env", "load_profile", "protocol", "grpc_service", "grpc_method", "descriptor_url"}
 	simpleScriptPrimaryKeyColumns     = []string{"script_id"}
 	simpleScriptGeneratedColumns      = []string{}
 )
@@ -45,6 +45,7 @@ type Statistic struct {
 	DataSent               int32             `boil:"data_sent" json:"data_sent" toml:"data_sent" yaml:"data_sent"`
 	DataReceived           int32             `boil:"data_received" json:"data_received" toml:"data_received" yaml:"data_received"`
 	CurrentTestRunDuration string            `boil:"current_test_run_duration" json:"current_test_run_duration" toml:"current_test_run_duration" yaml:"current_test_run_duration"`
+	Requests               int32             `boil:"requests" json:"requests" toml:"requests" yaml:"requests"`
 
 	R *statisticR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L statisticL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -72,6 +73,7 @@ var StatisticColumns = struct {
 	DataSent               string
 	DataReceived           string
 	CurrentTestRunDuration string
+	Requests               string
 }{
 	StatisticID:            "statistic_id",
 	StatisticDumpID:        "statistic_dump_id",
@@ -94,6 +96,7 @@ var StatisticColumns = struct {
 	DataSent:               "data_sent",
 	DataReceived:           "data_received",
 	CurrentTestRunDuration: "current_test_run_duration",
+	Requests:               "requests",
 }
 
 var StatisticTableColumns = struct {
@@ -118,6 +121,7 @@ var StatisticTableColumns = struct {
 	DataSent               string
 	DataReceived           string
 	CurrentTestRunDuration string
+	Requests               string
 }{
 	StatisticID:            "statistic.statistic_id",
 	StatisticDumpID:        "statistic.statistic_dump_id",
@@ -140,6 +144,7 @@ var StatisticTableColumns = struct {
 	DataSent:               "statistic.data_sent",
 	DataReceived:           "statistic.data_received",
 	CurrentTestRunDuration: "statistic.current_test_run_duration",
+	Requests:               "statistic.requests",
 }
 
 // Generated where
@@ -166,6 +171,7 @@ var StatisticWhere = struct {
 	DataSent               whereHelperint32
 	DataReceived           whereHelperint32
 	CurrentTestRunDuration whereHelperstring
+	Requests               whereHelperint32
 }{
 	StatisticID:            whereHelperint32{field: "\"statistic\".\"statistic_id\""},
 	StatisticDumpID:        whereHelperint32{field: "\"statistic\".\"statistic_dump_id\""},
@@ -188,6 +194,7 @@ var StatisticWhere = struct {
 	DataSent:               whereHelperint32{field: "\"statistic\".\"data_sent\""},
 	DataReceived:           whereHelperint32{field: "\"statistic\".\"data_received\""},
 	CurrentTestRunDuration: whereHelperstring{field: "\"statistic\".\"current_test_run_duration\""},
+	Requests:               whereHelperint32{field: "\"statistic\".\"requests\""},
 }
 
 // StatisticRels is where relationship names are stored.
@@ -227,9 +234,9 @@ func (r *statisticR) GetStatisticDump() *StatisticDump {
 type statisticL struct{}
 
 var (
-	statisticAllColumns            = []string{"statistic_id", "statistic_dump_id", "url_path", "url_method", "project_ids", "scenario_ids", "run_ids", "script_run_ids", "script_ids", "trace_ids", "agents", "rps", "rt_90_p", "rt_95_p", "rt_99_p", "rt_max", "vus", "failed", "data_sent", "data_received", "current_test_run_duration"}
+	statisticAllColumns            = []string{"statistic_id", "statistic_dump_id", "url_path", "url_method", "project_ids", "scenario_ids", "run_ids", "script_run_ids", "script_ids", "trace_ids", "agents", "rps", "rt_90_p", "rt_95_p", "rt_99_p", "rt_max", "vus", "failed", "data_sent", "data_received", "current_test_run_duration", "requests"}
 	statisticColumnsWithoutDefault = []string{"statistic_dump_id", "url_path", "url_method", "rps", "rt_90_p", "rt_95_p", "rt_99_p", "rt_max", "vus", "failed", "data_sent", "data_received", "current_test_run_duration"}
-	statisticColumnsWithDefault    = []string{"statistic_id", "project_ids", "scenario_ids", "run_ids", "script_run_ids", "script_ids", "trace_ids", "agents"}
+	statisticColumnsWithDefault    = []string{"statistic_id", "project_ids", "scenario_ids", "run_ids", "script_run_ids", "script_ids", "trace_ids", "agents", "requests"}
 	statisticPrimaryKeyColumns     = []string{"statistic_id"}
 	statisticGeneratedColumns      = []string{}
 )
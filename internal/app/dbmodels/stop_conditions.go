@@ -0,0 +1,1221 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// StopCondition is an object representing the database table.
+type StopCondition struct {
+	StopConditionID int32     `boil:"stop_condition_id" json:"stop_condition_id" toml:"stop_condition_id" yaml:"stop_condition_id"`
+	ScenarioID      int32     `boil:"scenario_id" json:"scenario_id" toml:"scenario_id" yaml:"scenario_id"`
+	ScriptID        int32     `boil:"script_id" json:"script_id" toml:"script_id" yaml:"script_id"`
+	SimpleScript    bool      `boil:"simple_script" json:"simple_script" toml:"simple_script" yaml:"simple_script"`
+	Metric          string    `boil:"metric" json:"metric" toml:"metric" yaml:"metric"`
+	Operator        string    `boil:"operator" json:"operator" toml:"operator" yaml:"operator"`
+	Threshold       float64   `boil:"threshold" json:"threshold" toml:"threshold" yaml:"threshold"`
+	DurationSec     int32     `boil:"duration_sec" json:"duration_sec" toml:"duration_sec" yaml:"duration_sec"`
+	Enabled         bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt       null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *stopConditionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L stopConditionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StopConditionColumns = struct {
+	StopConditionID string
+	ScenarioID      string
+	ScriptID        string
+	SimpleScript    string
+	Metric          string
+	Operator        string
+	Threshold       string
+	DurationSec     string
+	Enabled         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+}{
+	StopConditionID: "stop_condition_id",
+	ScenarioID:      "scenario_id",
+	ScriptID:        "script_id",
+	SimpleScript:    "simple_script",
+	Metric:          "metric",
+	Operator:        "operator",
+	Threshold:       "threshold",
+	DurationSec:     "duration_sec",
+	Enabled:         "enabled",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+}
+
+var StopConditionTableColumns = struct {
+	StopConditionID string
+	ScenarioID      string
+	ScriptID        string
+	SimpleScript    string
+	Metric          string
+	Operator        string
+	Threshold       string
+	DurationSec     string
+	Enabled         string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+}{
+	StopConditionID: "stop_conditions.stop_condition_id",
+	ScenarioID:      "stop_conditions.scenario_id",
+	ScriptID:        "stop_conditions.script_id",
+	SimpleScript:    "stop_conditions.simple_script",
+	Metric:          "stop_conditions.metric",
+	Operator:        "stop_conditions.operator",
+	Threshold:       "stop_conditions.threshold",
+	DurationSec:     "stop_conditions.duration_sec",
+	Enabled:         "stop_conditions.enabled",
+	CreatedAt:       "stop_conditions.created_at",
+	UpdatedAt:       "stop_conditions.updated_at",
+	DeletedAt:       "stop_conditions.deleted_at",
+}
+
+// Generated where
+
+var StopConditionWhere = struct {
+	StopConditionID whereHelperint32
+	ScenarioID      whereHelperint32
+	ScriptID        whereHelperint32
+	SimpleScript    whereHelperbool
+	Metric          whereHelperstring
+	Operator        whereHelperstring
+	Threshold       whereHelperfloat64
+	DurationSec     whereHelperint32
+	Enabled         whereHelperbool
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	DeletedAt       whereHelpernull_Time
+}{
+	StopConditionID: whereHelperint32{field: "\"stop_conditions\".\"stop_condition_id\""},
+	ScenarioID:      whereHelperint32{field: "\"stop_conditions\".\"scenario_id\""},
+	ScriptID:        whereHelperint32{field: "\"stop_conditions\".\"script_id\""},
+	SimpleScript:    whereHelperbool{field: "\"stop_conditions\".\"simple_script\""},
+	Metric:          whereHelperstring{field: "\"stop_conditions\".\"metric\""},
+	Operator:        whereHelperstring{field: "\"stop_conditions\".\"operator\""},
+	Threshold:       whereHelperfloat64{field: "\"stop_conditions\".\"threshold\""},
+	DurationSec:     whereHelperint32{field: "\"stop_conditions\".\"duration_sec\""},
+	Enabled:         whereHelperbool{field: "\"stop_conditions\".\"enabled\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"stop_conditions\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"stop_conditions\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"stop_conditions\".\"deleted_at\""},
+}
+
+// StopConditionRels is where relationship names are stored.
+var StopConditionRels = struct {
+	Scenario string
+}{
+	Scenario: "Scenario",
+}
+
+// stopConditionR is where relationships are stored.
+type stopConditionR struct {
+	Scenario *Scenario `boil:"Scenario" json:"Scenario" toml:"Scenario" yaml:"Scenario"`
+}
+
+// NewStruct creates a new relationship struct
+func (*stopConditionR) NewStruct() *stopConditionR {
+	return &stopConditionR{}
+}
+
+func (o *StopCondition) GetScenario() *Scenario {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScenario()
+}
+
+func (r *stopConditionR) GetScenario() *Scenario {
+	if r == nil {
+		return nil
+	}
+
+	return r.Scenario
+}
+
+// stopConditionL is where Load methods for each relationship are stored.
+type stopConditionL struct{}
+
+var (
+	stopConditionAllColumns            = []string{"stop_condition_id", "scenario_id", "script_id", "simple_script", "metric", "operator", "threshold", "duration_sec", "enabled", "created_at", "updated_at", "deleted_at"}
+	stopConditionColumnsWithoutDefault = []string{"scenario_id"}
+	stopConditionColumnsWithDefault    = []string{"stop_condition_id", "script_id", "simple_script", "metric", "operator", "threshold", "duration_sec", "enabled", "created_at", "updated_at", "deleted_at"}
+	stopConditionPrimaryKeyColumns     = []string{"stop_condition_id"}
+	stopConditionGeneratedColumns      = []string{}
+)
+
+type (
+	// StopConditionSlice is an alias for a slice of pointers to StopCondition.
+	// This should almost always be used instead of []StopCondition.
+	StopConditionSlice []*StopCondition
+	// StopConditionHook is the signature for custom StopCondition hook methods
+	StopConditionHook func(context.Context, boil.ContextExecutor, *StopCondition) error
+
+	stopConditionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	stopConditionType                 = reflect.TypeOf(&StopCondition{})
+	stopConditionMapping              = queries.MakeStructMapping(stopConditionType)
+	stopConditionPrimaryKeyMapping, _ = queries.BindMapping(stopConditionType, stopConditionMapping, stopConditionPrimaryKeyColumns)
+	stopConditionInsertCacheMut       sync.RWMutex
+	stopConditionInsertCache          = make(map[string]insertCache)
+	stopConditionUpdateCacheMut       sync.RWMutex
+	stopConditionUpdateCache          = make(map[string]updateCache)
+	stopConditionUpsertCacheMut       sync.RWMutex
+	stopConditionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var stopConditionAfterSelectMu sync.Mutex
+var stopConditionAfterSelectHooks []StopConditionHook
+
+var stopConditionBeforeInsertMu sync.Mutex
+var stopConditionBeforeInsertHooks []StopConditionHook
+var stopConditionAfterInsertMu sync.Mutex
+var stopConditionAfterInsertHooks []StopConditionHook
+
+var stopConditionBeforeUpdateMu sync.Mutex
+var stopConditionBeforeUpdateHooks []StopConditionHook
+var stopConditionAfterUpdateMu sync.Mutex
+var stopConditionAfterUpdateHooks []StopConditionHook
+
+var stopConditionBeforeDeleteMu sync.Mutex
+var stopConditionBeforeDeleteHooks []StopConditionHook
+var stopConditionAfterDeleteMu sync.Mutex
+var stopConditionAfterDeleteHooks []StopConditionHook
+
+var stopConditionBeforeUpsertMu sync.Mutex
+var stopConditionBeforeUpsertHooks []StopConditionHook
+var stopConditionAfterUpsertMu sync.Mutex
+var stopConditionAfterUpsertHooks []StopConditionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *StopCondition) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *StopCondition) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *StopCondition) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *StopCondition) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *StopCondition) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *StopCondition) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *StopCondition) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *StopCondition) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *StopCondition) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range stopConditionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddStopConditionHook registers your hook function for all future operations.
+func AddStopConditionHook(hookPoint boil.HookPoint, stopConditionHook StopConditionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		stopConditionAfterSelectMu.Lock()
+		stopConditionAfterSelectHooks = append(stopConditionAfterSelectHooks, stopConditionHook)
+		stopConditionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		stopConditionBeforeInsertMu.Lock()
+		stopConditionBeforeInsertHooks = append(stopConditionBeforeInsertHooks, stopConditionHook)
+		stopConditionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		stopConditionAfterInsertMu.Lock()
+		stopConditionAfterInsertHooks = append(stopConditionAfterInsertHooks, stopConditionHook)
+		stopConditionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		stopConditionBeforeUpdateMu.Lock()
+		stopConditionBeforeUpdateHooks = append(stopConditionBeforeUpdateHooks, stopConditionHook)
+		stopConditionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		stopConditionAfterUpdateMu.Lock()
+		stopConditionAfterUpdateHooks = append(stopConditionAfterUpdateHooks, stopConditionHook)
+		stopConditionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		stopConditionBeforeDeleteMu.Lock()
+		stopConditionBeforeDeleteHooks = append(stopConditionBeforeDeleteHooks, stopConditionHook)
+		stopConditionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		stopConditionAfterDeleteMu.Lock()
+		stopConditionAfterDeleteHooks = append(stopConditionAfterDeleteHooks, stopConditionHook)
+		stopConditionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		stopConditionBeforeUpsertMu.Lock()
+		stopConditionBeforeUpsertHooks = append(stopConditionBeforeUpsertHooks, stopConditionHook)
+		stopConditionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		stopConditionAfterUpsertMu.Lock()
+		stopConditionAfterUpsertHooks = append(stopConditionAfterUpsertHooks, stopConditionHook)
+		stopConditionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single stopCondition record from the query.
+func (q stopConditionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*StopCondition, error) {
+	o := &StopCondition{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for stop_conditions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all StopCondition records from the query.
+func (q stopConditionQuery) All(ctx context.Context, exec boil.ContextExecutor) (StopConditionSlice, error) {
+	var o []*StopCondition
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to StopCondition slice")
+	}
+
+	if len(stopConditionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all StopCondition records in the query.
+func (q stopConditionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count stop_conditions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q stopConditionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if stop_conditions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Scenario pointed to by the foreign key.
+func (o *StopCondition) Scenario(mods ...qm.QueryMod) scenarioQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"scenario_id\" = ?", o.ScenarioID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Scenarios(queryMods...)
+}
+
+// LoadScenario allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (stopConditionL) LoadScenario(ctx context.Context, e boil.ContextExecutor, singular bool, maybeStopCondition interface{}, mods queries.Applicator) error {
+	var slice []*StopCondition
+	var object *StopCondition
+
+	if singular {
+		var ok bool
+		object, ok = maybeStopCondition.(*StopCondition)
+		if !ok {
+			object = new(StopCondition)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeStopCondition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeStopCondition))
+			}
+		}
+	} else {
+		s, ok := maybeStopCondition.(*[]*StopCondition)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeStopCondition)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeStopCondition))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &stopConditionR{}
+		}
+		args[object.ScenarioID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &stopConditionR{}
+			}
+
+			args[obj.ScenarioID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`scenarios`),
+		qm.WhereIn(`scenarios.scenario_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Scenario")
+	}
+
+	var resultSlice []*Scenario
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Scenario")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for scenarios")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for scenarios")
+	}
+
+	if len(scenarioAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Scenario = foreign
+		if foreign.R == nil {
+			foreign.R = &scenarioR{}
+		}
+		foreign.R.StopConditions = append(foreign.R.StopConditions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScenarioID == foreign.ScenarioID {
+				local.R.Scenario = foreign
+				if foreign.R == nil {
+					foreign.R = &scenarioR{}
+				}
+				foreign.R.StopConditions = append(foreign.R.StopConditions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetScenario of the stopCondition to the related item.
+// Sets o.R.Scenario to related.
+// Adds o to related.R.StopConditions.
+func (o *StopCondition) SetScenario(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Scenario) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"stop_conditions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"scenario_id"}),
+		strmangle.WhereClause("\"", "\"", 2, stopConditionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ScenarioID, o.StopConditionID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScenarioID = related.ScenarioID
+	if o.R == nil {
+		o.R = &stopConditionR{
+			Scenario: related,
+		}
+	} else {
+		o.R.Scenario = related
+	}
+
+	if related.R == nil {
+		related.R = &scenarioR{
+			StopConditions: StopConditionSlice{o},
+		}
+	} else {
+		related.R.StopConditions = append(related.R.StopConditions, o)
+	}
+
+	return nil
+}
+
+// StopConditions retrieves all the records using an executor.
+func StopConditions(mods ...qm.QueryMod) stopConditionQuery {
+	mods = append(mods, qm.From("\"stop_conditions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"stop_conditions\".*"})
+	}
+
+	return stopConditionQuery{q}
+}
+
+// FindStopCondition retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStopCondition(ctx context.Context, exec boil.ContextExecutor, stopConditionID int32, selectCols ...string) (*StopCondition, error) {
+	stopConditionObj := &StopCondition{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"stop_conditions\" where \"stop_condition_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, stopConditionID)
+
+	err := q.Bind(ctx, exec, stopConditionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from stop_conditions")
+	}
+
+	if err = stopConditionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return stopConditionObj, err
+	}
+
+	return stopConditionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *StopCondition) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no stop_conditions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(stopConditionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	stopConditionInsertCacheMut.RLock()
+	cache, cached := stopConditionInsertCache[key]
+	stopConditionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			stopConditionAllColumns,
+			stopConditionColumnsWithDefault,
+			stopConditionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(stopConditionType, stopConditionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(stopConditionType, stopConditionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"stop_conditions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"stop_conditions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into stop_conditions")
+	}
+
+	if !cached {
+		stopConditionInsertCacheMut.Lock()
+		stopConditionInsertCache[key] = cache
+		stopConditionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the StopCondition.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *StopCondition) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	stopConditionUpdateCacheMut.RLock()
+	cache, cached := stopConditionUpdateCache[key]
+	stopConditionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			stopConditionAllColumns,
+			stopConditionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update stop_conditions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"stop_conditions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, stopConditionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(stopConditionType, stopConditionMapping, append(wl, stopConditionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update stop_conditions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for stop_conditions")
+	}
+
+	if !cached {
+		stopConditionUpdateCacheMut.Lock()
+		stopConditionUpdateCache[key] = cache
+		stopConditionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q stopConditionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for stop_conditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for stop_conditions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StopConditionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stopConditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"stop_conditions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, stopConditionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in stopCondition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all stopCondition")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *StopCondition) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no stop_conditions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(stopConditionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	stopConditionUpsertCacheMut.RLock()
+	cache, cached := stopConditionUpsertCache[key]
+	stopConditionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			stopConditionAllColumns,
+			stopConditionColumnsWithDefault,
+			stopConditionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			stopConditionAllColumns,
+			stopConditionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert stop_conditions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(stopConditionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(stopConditionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert stop_conditions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(stopConditionPrimaryKeyColumns))
+			copy(conflict, stopConditionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"stop_conditions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(stopConditionType, stopConditionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(stopConditionType, stopConditionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert stop_conditions")
+	}
+
+	if !cached {
+		stopConditionUpsertCacheMut.Lock()
+		stopConditionUpsertCache[key] = cache
+		stopConditionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single StopCondition record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *StopCondition) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no StopCondition provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), stopConditionPrimaryKeyMapping)
+	sql := "DELETE FROM \"stop_conditions\" WHERE \"stop_condition_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from stop_conditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for stop_conditions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q stopConditionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no stopConditionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from stop_conditions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for stop_conditions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StopConditionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(stopConditionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stopConditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"stop_conditions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, stopConditionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from stopCondition slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for stop_conditions")
+	}
+
+	if len(stopConditionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *StopCondition) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStopCondition(ctx, exec, o.StopConditionID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StopConditionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StopConditionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stopConditionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"stop_conditions\".* FROM \"stop_conditions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, stopConditionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in StopConditionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StopConditionExists checks if the StopCondition row exists.
+func StopConditionExists(ctx context.Context, exec boil.ContextExecutor, stopConditionID int32) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"stop_conditions\" where \"stop_condition_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, stopConditionID)
+	}
+	row := exec.QueryRowContext(ctx, sql, stopConditionID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if stop_conditions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the StopCondition row exists.
+func (o *StopCondition) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StopConditionExists(ctx, exec, o.StopConditionID)
+}
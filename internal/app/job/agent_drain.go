@@ -0,0 +1,74 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// drainingAgent обслуживание выводимого агента: при DrainMigrate запущенные ScriptRun`ы перезапускаются
+// на других агентах, иначе - дорабатывают. Агент без запущенных ScriptRun`ов выключается
+func (p *ProcessorPool) drainingAgent(ctx context.Context, mAgent *models.Agent) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "drainingAgent failed: '%+v'", err)
+		}
+	}()
+
+	host := undecided.GetMHost(mAgent)
+
+	mRuns, err := p.db.GetActiveMRuns(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "Draining agent '%v': '%v'", host, err)
+
+		return
+	}
+
+	running := 0
+
+	for _, mRun := range mRuns {
+		for _, scriptRun := range conv.ModelToPBScriptRuns(ctx, mRun.ScriptRuns) {
+			if scriptRun.GetStatus() != pb.ScriptRun_STATUS_RUNNING || scriptRun.GetAgent() == nil ||
+				undecided.GetPBHost(scriptRun.GetAgent()) != host {
+				continue
+			}
+
+			running++
+
+			if !mAgent.DrainMigrate {
+				continue
+			}
+
+			err = p.migrateScriptRun(ctx, scriptRun, fmt.Sprintf("agent '%v' is draining", host), true)
+			if err != nil && !errors.Is(err, errScriptRunMigrated) {
+				logger.Warnf(ctx, "Draining agent '%v': '%v'", host, err)
+			}
+		}
+	}
+
+	if running > 0 {
+		logger.Infof(ctx, "Agent '%v' is draining, running script runs: %v", host, running)
+
+		return
+	}
+
+	mAgent.Enabled = false
+	mAgent.Draining = false
+	mAgent.DrainMigrate = false
+	mAgent.DrainStartedAt = null.Time{}
+
+	if err = p.db.UpdateMAgentDrain(ctx, mAgent); err != nil {
+		logger.Errorf(ctx, "Draining agent '%v': '%v'", host, err)
+
+		return
+	}
+
+	logger.Warnf(ctx, "Agent '%v' is drained and disabled", host)
+}
@@ -6,10 +6,10 @@ import (
 	"sync"
 	"time"
 
-	v1 "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
-
+	"github.com/aarondl/null/v8"
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	agentpkg "github.com/aliexpressru/alilo-backend/internal/pkg/agent"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
 )
@@ -19,10 +19,15 @@ const (
 	weightSpu               = 0.35
 	weightMem               = 0.6
 	weightPorts             = 0.05
+
+	// agentsTrackerLockKey advisory lock лидера AgentsTracker
+	agentsTrackerLockKey int64 = 7_001
 )
 
 var d time.Duration
 
+// AgentsTracker проверка агентов, карантин, токены, вывод агентов из пула.
+// Работает только на реплике-лидере(advisory lock в БД), остальные реплики ждут освобождения блокировки
 func AgentsTracker(ctx context.Context, p *ProcessorPool) {
 	ctx = undecided.NewContextWithMarker(ctx, agentTrackingContextKey, "")
 	defer func() {
@@ -32,8 +37,25 @@ func AgentsTracker(ctx context.Context, p *ProcessorPool) {
 	}()
 
 	cfg := config.Get(ctx)
+	leader := p.db.NewLeader(agentsTrackerLockKey)
+	isLeader := false
+
 	d = cfg.JobAgentTrackingFrequency
 	for range time.Tick(d) {
+		acquired, err := leader.Acquire(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "AgentsTracker leader lock: '%+v'", err)
+		}
+
+		if acquired != isLeader {
+			logger.Warnf(ctx, "AgentsTracker leadership on '%v': %v", cfg.Hostname, acquired)
+			isLeader = acquired
+		}
+
+		if !acquired {
+			continue
+		}
+
 		logger.Info(ctx, "Start AgentsTracker")
 		p.agentsTracking(ctx)
 	}
@@ -56,6 +78,14 @@ func (p *ProcessorPool) agentsTracking(ctx context.Context) {
 	}
 	logger.Infof(ctx, "agents count: %v", len(agents))
 	for i, agent := range agents {
+		// ушедший из пула агент не проверяется, его клиент закрывается до следующего RegisterAgent/Heartbeat
+		if agentpkg.IsExpired(ctx, agent) {
+			logger.Infof(ctx, "Agent '%v' is expired, skip tracking", undecided.GetMHost(agent))
+			p.agentManager.RemoveClient(ctx, agent)
+
+			continue
+		}
+
 		trackingWaitGroup.Add(1)
 		logger.Infof(ctx, "Trekking (%v) %+v", i, agent)
 
@@ -65,8 +95,52 @@ func (p *ProcessorPool) agentsTracking(ctx context.Context) {
 	}
 
 	trackingWaitGroup.Wait()
+
+	p.stoppingOrphanedScriptRuns(ctx, agents)
+	p.removingExpiredAgents(ctx)
+
+	for _, agent := range agents {
+		if agent.Draining {
+			p.drainingAgent(ctx, agent)
+		}
+	}
+}
+
+// removingExpiredAgents удаление саморегистрированных агентов без Heartbeat`а дольше AgentExpiredRemoveAfter:
+// агент, вернувшийся позже, зарегистрируется заново
+func (p *ProcessorPool) removingExpiredAgents(ctx context.Context) {
+	removeAfter := config.Get(ctx).AgentExpiredRemoveAfter
+	if removeAfter <= 0 {
+		return
+	}
+
+	mAgents, err := p.db.GetMAgentsExpiredBefore(ctx, time.Now().Add(-removeAfter))
+	if err != nil {
+		logger.Errorf(ctx, "Removing expired agents: '%+v'", err)
+
+		return
+	}
+
+	for _, mAgent := range mAgents {
+		if p.agentManager.CountRunningScriptRuns(ctx, undecided.GetMHost(mAgent)) > 0 {
+			continue
+		}
+
+		p.agentManager.RemoveClient(ctx, mAgent)
+
+		if err = p.db.DeleteAgent(ctx, mAgent); err != nil {
+			logger.Errorf(ctx, "Removing expired agent '%v': '%+v'", undecided.GetMHost(mAgent), err)
+
+			continue
+		}
+
+		logger.Warnf(ctx, "Agent '%v' is removed: no heartbeat since '%v'",
+			undecided.GetMHost(mAgent), mAgent.LastHeartbeatAt.Time)
+	}
 }
 
+// updateStatusMAgent проверка агента: метрики и состояние(last_seen_at, failure_count, quarantined).
+// После AgentQuarantineFailures неудачных проверок подряд агент уходит в карантин, первая успешная проверка его возвращает
 func (p *ProcessorPool) updateStatusMAgent(ctx context.Context, agent *models.Agent, group *sync.WaitGroup) {
 	defer func(group *sync.WaitGroup) {
 		if err := recover(); err != nil {
@@ -81,107 +155,77 @@ func (p *ProcessorPool) updateStatusMAgent(ctx context.Context, agent *models.Ag
 
 	defer undecided.InfoTimer(ctx, fmt.Sprintf("UpdateStatusAgent: '%v'", agent.HostName))()
 
-	// Initial status check
-	agent = p.getStatusMAgent(ctx, agent)
+	cfg := config.Get(ctx)
 
-	// Retry configuration
-	maxRetries := 3
-	baseDelay := time.Second * 2
+	err := p.getStatusMAgent(ctx, agent)
+	if err != nil {
+		agent.FailureCount++
+		agent.LastError = err.Error()
 
-	for i := 0; i <= maxRetries; i++ {
-		getMAgent, err := p.db.GetMAgent(ctx, agent.AgentID)
-		if err != nil {
-			logger.Errorf(
-				ctx,
-				"GetMAgent to get the current status (attempt %d/%d). error: '%+v'",
-				i+1,
-				maxRetries,
-				err,
-			)
-			if i == maxRetries {
-				return
-			}
-			time.Sleep(baseDelay * time.Duration(i+1))
-			continue
+		if !agent.Quarantined && agent.FailureCount >= cfg.AgentQuarantineFailures {
+			agent.Quarantined = true
+			logger.Warnf(ctx, "Agent '%v' is quarantined after %v failed probes: '%v'",
+				undecided.GetMHost(agent), agent.FailureCount, err)
 		}
-
-		if getMAgent == nil {
-			logger.Errorf(ctx, "GetMAgent to get the current status (attempt %d/%d). Agent is nil", i+1, maxRetries)
-			if i == maxRetries {
-				return
-			}
-			time.Sleep(baseDelay * time.Duration(i+1))
-			continue
+	} else {
+		if agent.Quarantined {
+			logger.Warnf(ctx, "Agent '%v' is recovered after %v failed probes",
+				undecided.GetMHost(agent), agent.FailureCount)
 		}
 
-		// If agent is still enabled, no need for retry
-		if getMAgent.Enabled {
-			agent.Enabled = getMAgent.Enabled
-			_, err = p.db.UpdateMAgent(ctx, agent)
-			if err != nil {
-				logger.Errorf(ctx, "updateStatusMAgent update MAgent error: '%+v'", err)
-			}
-			return
-		}
+		agent.LastSeenAt = null.TimeFrom(time.Now())
+		agent.FailureCount = 0
+		agent.Quarantined = false
+		agent.LastError = ""
 
-		// If we get here, the agent is disabled
-		if i < maxRetries {
-			logger.Infof(ctx, "Agent %s is disabled (was enabled), retrying in %v (attempt %d/%d)",
-				agent.HostName, baseDelay*time.Duration(i+1), i+1, maxRetries)
-			time.Sleep(baseDelay * time.Duration(i+1))
-			continue
+		if err = p.agentManager.EnsureToken(ctx, agent); err != nil {
+			logger.Warnf(ctx, "Agent '%v' token: '%v'", undecided.GetMHost(agent), err)
 		}
+	}
 
-		// Final attempt and still disabled
-		logger.Infof(ctx, "Agent %s is now disabled after %d retries", agent.HostName, maxRetries)
-		agent.Enabled = false
-		_, err = p.db.UpdateMAgent(ctx, agent)
-		if err != nil {
-			logger.Errorf(ctx, "updateStatusMAgent update MAgent error: '%+v'", err)
-		}
-		return
+	if err = p.db.UpdateMAgentHealth(ctx, agent); err != nil {
+		logger.Errorf(ctx, "updateStatusMAgent update MAgent error: '%+v'", err)
 	}
 }
 
-func (p *ProcessorPool) getStatusMAgent(ctx context.Context, agent *models.Agent) *models.Agent {
+// getStatusMAgent запрос метрик агента, ошибка - агент не ответил за AgentProbeTimeout
+func (p *ProcessorPool) getStatusMAgent(ctx context.Context, agent *models.Agent) (err error) {
 	defer func() {
-		if err := recover(); err != nil {
-			logger.Errorf(ctx, "getStatusMAgent failed: '%+v'", err)
+		if r := recover(); r != nil {
+			logger.Errorf(ctx, "getStatusMAgent failed: '%+v'", r)
+			err = fmt.Errorf("get status agent panic: '%v'", r)
 		}
 	}()
 
 	defer undecided.InfoTimer(ctx, fmt.Sprintf("GetStatusAgent: '%v'", agent.HostName))()
 
-	c, err := p.agentManager.GetClientM(ctx, agent)
-	if err != nil {
-		logger.Errorf(ctx, "get Client agent error: '%+v'", err)
-
-		return agent
-	}
+	probeCtx, cancel := context.WithTimeout(ctx, config.Get(ctx).AgentProbeTimeout)
+	defer cancel()
 
-	rsGetMetrics, err := c.Metrics(ctx, &v1.MetricsRequest{})
+	rsGetMetrics, err := p.agentManager.Metrics(probeCtx, undecided.GetMHost(agent))
 	if err != nil {
 		logger.Errorf(ctx, "get metrics agent{%v} execute request error: '%+v'", agent.HostName, err)
 
-		return agent
+		return fmt.Errorf("get metrics: %w", err)
 	}
 
 	utilization := rsGetMetrics.GetAgentUtilization()
 	if utilization == nil {
 		logger.Errorf(ctx, "get status agent execute request fail. Utilization: '%+v'", utilization)
 
-		return agent
+		return fmt.Errorf("get metrics: empty agent utilization")
 	}
 
 	logger.Infof(ctx, "get status agent response: '%+v'", utilization)
 	agent.CPUUsed = int32(utilization.GetCpu())
 	agent.MemUsed = int32(utilization.GetMem())
+	// агент отдает процент свободных портов, в PortsUsed хранится процент занятых
 	//nolint:gosec
-	agent.PortsUsed = int32(utilization.GetPercentAvailablePorts())
+	agent.PortsUsed = int32(100 - utilization.GetPercentAvailablePorts())
 	agent.TotalLoading = totalLoading(agent)
 	logger.Infof(ctx, "Total agent{%v} utilization: '%+v'", agent.HostName, agent.TotalLoading)
 
-	return agent
+	return nil
 }
 
 func totalLoading(agent *models.Agent) (value int16) {
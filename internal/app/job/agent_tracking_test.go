@@ -0,0 +1,26 @@
+package job
+
+import (
+	"testing"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+)
+
+func TestTotalLoading(t *testing.T) {
+	tests := []struct {
+		name  string
+		agent *models.Agent
+		want  int16
+	}{
+		{name: "idle", agent: &models.Agent{}, want: 0},
+		{name: "all ports used", agent: &models.Agent{PortsUsed: 100}, want: 5},
+		{name: "busy", agent: &models.Agent{CPUUsed: 100, MemUsed: 100, PortsUsed: 100}, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := totalLoading(tt.agent); got != tt.want {
+				t.Errorf("totalLoading() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// capacitySearchUser пользователь команд, созданных поиском предельной нагрузки
+const capacitySearchUser = "capacity-search"
+
+// capacityBreaches время первого нарушения лимита на шаге поиска, ключ - runID:current_percentage
+var capacityBreaches = &breachTracker{firstBreach: make(map[string]time.Time)}
+
+// checkingCapacitySearches шаги поиска предельной нагрузки по собранной статистике.
+// Пока метрики укладываются в лимиты, после step_duration_sec нагрузка повышается на step_percentage.
+// Если лимит нарушается дольше breach_sustain_sec, нагрузка возвращается на последний успешный шаг,
+// держится на нем breach_sustain_sec и Run останавливается, результат - последний успешный шаг
+func (p *ProcessorPool) checkingCapacitySearches(ctx context.Context,
+	runs []*pb.Run, values map[int32]*runSLOValues) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "checkingCapacitySearches failed: '%+v'", err)
+		}
+	}()
+
+	mCapacitySearches, err := p.db.GetSearchingMCapacitySearches(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "checkingCapacitySearches: '%+v'", err)
+
+		return
+	}
+
+	ctx = undecided.ContextWithUser(ctx, capacitySearchUser)
+
+	runningRunIDs := make(map[int32]bool, len(runs))
+	for _, run := range runs {
+		runningRunIDs[run.GetRunId()] = true
+	}
+	defer capacityBreaches.cleanup(runningRunIDs)
+
+	now := time.Now().UTC()
+	for _, mCapacitySearch := range mCapacitySearches {
+		if !runningRunIDs[mCapacitySearch.RunID] {
+			p.interruptingCapacitySearch(ctx, mCapacitySearch)
+
+			continue
+		}
+
+		runValues, ok := values[mCapacitySearch.RunID]
+		if !ok {
+			continue
+		}
+
+		p.capacitySearchStep(ctx, mCapacitySearch, runValues.scenario, now)
+	}
+}
+
+// steppingBack нагрузка возвращена на последний успешный шаг после нарушения лимита.
+// При поиске текущий шаг всегда выше успешного, поэтому отдельный статус не нужен
+func steppingBack(mCapacitySearch *models.CapacitySearch) bool {
+	return mCapacitySearch.PassedPercentage > 0 &&
+		mCapacitySearch.CurrentPercentage == mCapacitySearch.PassedPercentage
+}
+
+func (p *ProcessorPool) capacitySearchStep(ctx context.Context,
+	mCapacitySearch *models.CapacitySearch, values *sloValues, now time.Time) {
+	fromPercentage := mCapacitySearch.CurrentPercentage
+	sustain := time.Duration(mCapacitySearch.BreachSustainSec) * time.Second
+
+	if steppingBack(mCapacitySearch) {
+		// последний успешный шаг держится breach_sustain_sec, затем Run останавливается
+		if now.Sub(mCapacitySearch.StepStartedAt) < sustain {
+			return
+		}
+
+		mCapacitySearch.Status = models.CapacitySearchStatusSTATUS_LIMIT_REACHED
+		mCapacitySearch.Info = fmt.Sprintf("%v. %v", mCapacitySearch.Info, capacitySearchResult(mCapacitySearch))
+		p.finishingCapacitySearch(ctx, mCapacitySearch, fromPercentage)
+
+		return
+	}
+
+	key := fmt.Sprintf("%d:%d", mCapacitySearch.RunID, fromPercentage)
+
+	breach, breachedFor := capacityLimitBreach(mCapacitySearch, values), time.Duration(0)
+	if breach == "" {
+		capacityBreaches.reset(key)
+	} else {
+		breachedFor = now.Sub(capacityBreaches.since(key, now))
+	}
+
+	switch {
+	case breach != "" && breachedFor >= sustain:
+		capacityBreaches.reset(key)
+		p.steppingBackCapacitySearch(ctx, mCapacitySearch,
+			fmt.Sprintf("Capacity search: limit reached at %v%% (%v for %v)",
+				fromPercentage, breach, breachedFor.Round(time.Second)), now)
+
+		return
+	case breach != "":
+		// пока лимит нарушается, шаг не считается успешным
+		logger.Infof(ctx, "Run{%v} capacity search limit is breached at %v%% for %v: %v",
+			mCapacitySearch.RunID, fromPercentage, breachedFor, breach)
+
+		return
+	case now.Sub(mCapacitySearch.StepStartedAt) < time.Duration(mCapacitySearch.StepDurationSec)*time.Second:
+		return
+	}
+
+	// шаг продержался step_duration_sec в рамках лимитов
+	mCapacitySearch.PassedPercentage = fromPercentage
+	//nolint:gosec
+	mCapacitySearch.PassedRPS = int32(values.rps)
+
+	if fromPercentage >= mCapacitySearch.MaxPercentage || mCapacitySearch.StepPercentage == 0 {
+		mCapacitySearch.Status = models.CapacitySearchStatusSTATUS_MAX_PERCENTAGE_REACHED
+		mCapacitySearch.Info = fmt.Sprintf("Capacity search: max percentage reached without breaking the limits. %v",
+			capacitySearchResult(mCapacitySearch))
+		p.finishingCapacitySearch(ctx, mCapacitySearch, fromPercentage)
+
+		return
+	}
+
+	mCapacitySearch.CurrentPercentage = min(fromPercentage+mCapacitySearch.StepPercentage, mCapacitySearch.MaxPercentage)
+	mCapacitySearch.StepStartedAt = now
+	mCapacitySearch.Info = fmt.Sprintf("Step %v%% passed(%v RPS), next step %v%%",
+		fromPercentage, mCapacitySearch.PassedRPS, mCapacitySearch.CurrentPercentage)
+
+	p.adjustingCapacitySearch(ctx, mCapacitySearch, fromPercentage)
+}
+
+// steppingBackCapacitySearch возврат нагрузки на последний успешный шаг после нарушения лимита,
+// без успешных шагов поиск завершается сразу
+func (p *ProcessorPool) steppingBackCapacitySearch(ctx context.Context,
+	mCapacitySearch *models.CapacitySearch, reason string, now time.Time) {
+	fromPercentage := mCapacitySearch.CurrentPercentage
+
+	if mCapacitySearch.PassedPercentage == 0 {
+		mCapacitySearch.Status = models.CapacitySearchStatusSTATUS_LIMIT_REACHED
+		mCapacitySearch.Info = fmt.Sprintf("%v. %v", reason, capacitySearchResult(mCapacitySearch))
+		p.finishingCapacitySearch(ctx, mCapacitySearch, fromPercentage)
+
+		return
+	}
+
+	mCapacitySearch.CurrentPercentage = mCapacitySearch.PassedPercentage
+	mCapacitySearch.StepStartedAt = now
+	mCapacitySearch.Info = fmt.Sprintf("%v, stepping back to %v%%", reason, mCapacitySearch.PassedPercentage)
+
+	p.adjustingCapacitySearch(ctx, mCapacitySearch, fromPercentage)
+}
+
+// adjustingCapacitySearch сохранение шага и корректировка нагрузки Run`а на current_percentage
+func (p *ProcessorPool) adjustingCapacitySearch(ctx context.Context,
+	mCapacitySearch *models.CapacitySearch, fromPercentage int32) {
+	claimed, err := p.db.UpdateMCapacitySearchStep(ctx, mCapacitySearch, fromPercentage)
+	if err != nil || !claimed {
+		logger.Infof(ctx, "Capacity search{%v} step is not claimed: '%v'", mCapacitySearch.CapacitySearchID, err)
+
+		return
+	}
+
+	logger.Infof(ctx, "Run{%v} capacity search: %v", mCapacitySearch.RunID, mCapacitySearch.Info)
+
+	message := processing.ScenarioToAdjustment(ctx, mCapacitySearch.RunID, mCapacitySearch.CurrentPercentage,
+		p.db, p.dbPB)
+	if message != "" {
+		logger.Warnf(ctx, "Run{%v} capacity search adjustment message: '%v'", mCapacitySearch.RunID, message)
+	}
+}
+
+// interruptingCapacitySearch завершение поиска, если Run остановили до окончания поиска
+func (p *ProcessorPool) interruptingCapacitySearch(ctx context.Context, mCapacitySearch *models.CapacitySearch) {
+	mRun, err := p.db.GetMRunning(ctx, mCapacitySearch.RunID)
+	if err != nil || mRun.Status != models.EstatusSTATUS_STOPPED_UNSPECIFIED {
+		return
+	}
+
+	mCapacitySearch.Status = models.CapacitySearchStatusSTATUS_INTERRUPTED
+	mCapacitySearch.Info = fmt.Sprintf("Capacity search: the run was stopped at %v%%. %v",
+		mCapacitySearch.CurrentPercentage, capacitySearchResult(mCapacitySearch))
+
+	claimed, err := p.db.UpdateMCapacitySearchStep(ctx, mCapacitySearch, mCapacitySearch.CurrentPercentage)
+	if err != nil || !claimed {
+		return
+	}
+
+	if err = p.db.AppendMRunInfo(ctx, mCapacitySearch.RunID, mCapacitySearch.Info); err != nil {
+		logger.Errorf(ctx, "Run{%v} save capacity search result error: '%+v'", mCapacitySearch.RunID, err)
+	}
+}
+
+func (p *ProcessorPool) finishingCapacitySearch(ctx context.Context,
+	mCapacitySearch *models.CapacitySearch, fromPercentage int32) {
+	claimed, err := p.db.UpdateMCapacitySearchStep(ctx, mCapacitySearch, fromPercentage)
+	if err != nil || !claimed {
+		logger.Infof(ctx, "Capacity search{%v} finish is not claimed: '%v'", mCapacitySearch.CapacitySearchID, err)
+
+		return
+	}
+
+	logger.Warnf(ctx, "Run{%v} %v", mCapacitySearch.RunID, mCapacitySearch.Info)
+
+	if err = p.db.AppendMRunInfo(ctx, mCapacitySearch.RunID, mCapacitySearch.Info); err != nil {
+		logger.Errorf(ctx, "Run{%v} save capacity search result error: '%+v'", mCapacitySearch.RunID, err)
+	}
+
+	_, message := processing.ScenarioToStop(ctx, mCapacitySearch.RunID, p.db, p.dbPB)
+	if message != "" {
+		logger.Errorf(ctx, "Run{%v} stop by capacity search error: '%v'", mCapacitySearch.RunID, message)
+	}
+}
+
+// capacityLimitBreach возвращает описание нарушенного лимита, пустая строка - лимиты соблюдаются
+func capacityLimitBreach(mCapacitySearch *models.CapacitySearch, values *sloValues) string {
+	if mCapacitySearch.MaxRT95P > 0 && values.rt95P > mCapacitySearch.MaxRT95P {
+		return fmt.Sprintf("rt95p %vms > %vms", values.rt95P, mCapacitySearch.MaxRT95P)
+	}
+
+	failedRate := values.value(models.StopMetricMETRIC_FAILED_RATE)
+	if mCapacitySearch.MaxFailedRate > 0 && failedRate > mCapacitySearch.MaxFailedRate {
+		return fmt.Sprintf("failed rate %.2f%% > %v%%", failedRate, mCapacitySearch.MaxFailedRate)
+	}
+
+	return ""
+}
+
+func capacitySearchResult(mCapacitySearch *models.CapacitySearch) string {
+	if mCapacitySearch.PassedPercentage == 0 {
+		return "No step has passed within the limits"
+	}
+
+	return fmt.Sprintf("The highest passing load: %v%% (%v RPS)",
+		mCapacitySearch.PassedPercentage, mCapacitySearch.PassedRPS)
+}
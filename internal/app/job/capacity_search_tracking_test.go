@@ -0,0 +1,97 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+)
+
+func TestCapacitySearchStep_breachIsNotSustained(t *testing.T) {
+	now := time.Now().UTC()
+	mCapacitySearch := &models.CapacitySearch{
+		RunID:             1,
+		CurrentPercentage: 30,
+		PassedPercentage:  20,
+		StepPercentage:    10,
+		MaxPercentage:     100,
+		StepDurationSec:   60,
+		BreachSustainSec:  30,
+		MaxRT95P:          500,
+		StepStartedAt:     now.Add(-2 * time.Minute),
+		Status:            models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED,
+	}
+	values := &sloValues{rps: 300, rt95P: 900}
+
+	// без базы: шаг не должен ни завершиться, ни перейти дальше, пока нарушение не продержится breach_sustain_sec
+	p := &ProcessorPool{}
+	for _, sample := range []time.Duration{0, 10 * time.Second, 29 * time.Second} {
+		p.capacitySearchStep(context.Background(), mCapacitySearch, values, now.Add(sample))
+	}
+
+	if mCapacitySearch.CurrentPercentage != 30 || mCapacitySearch.PassedPercentage != 20 ||
+		mCapacitySearch.Status != models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED {
+		t.Errorf("capacitySearchStep() changed the search on a short breach: %+v", mCapacitySearch)
+	}
+
+	capacityBreaches.cleanup(nil)
+}
+
+func TestCapacitySearchStep_holdsLastPassingStep(t *testing.T) {
+	now := time.Now().UTC()
+	mCapacitySearch := &models.CapacitySearch{
+		RunID:             2,
+		CurrentPercentage: 40,
+		PassedPercentage:  40,
+		BreachSustainSec:  30,
+		MaxRT95P:          500,
+		StepStartedAt:     now.Add(-10 * time.Second),
+		Status:            models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED,
+	}
+
+	if !steppingBack(mCapacitySearch) {
+		t.Fatalf("steppingBack() = false, want true")
+	}
+
+	(&ProcessorPool{}).capacitySearchStep(context.Background(), mCapacitySearch, &sloValues{rt95P: 900}, now)
+
+	if mCapacitySearch.Status != models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED {
+		t.Errorf("capacitySearchStep() finished the search before the last passing step was held: %+v",
+			mCapacitySearch)
+	}
+}
+
+func TestCapacityLimitBreach(t *testing.T) {
+	tests := []struct {
+		name   string
+		search *models.CapacitySearch
+		values *sloValues
+		want   string
+	}{
+		{
+			name:   "within limits",
+			search: &models.CapacitySearch{MaxRT95P: 500, MaxFailedRate: 5},
+			values: &sloValues{rt95P: 400, intervalFailed: 1, intervalRequests: 100},
+		},
+		{
+			name:   "latency",
+			search: &models.CapacitySearch{MaxRT95P: 500},
+			values: &sloValues{rt95P: 600},
+			want:   "rt95p 600ms > 500ms",
+		},
+		{
+			name:   "failed rate",
+			search: &models.CapacitySearch{MaxFailedRate: 5},
+			values: &sloValues{intervalFailed: 10, intervalRequests: 100},
+			want:   "failed rate 10.00% > 5%",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := capacityLimitBreach(tt.search, tt.values); got != tt.want {
+				t.Errorf("capacityLimitBreach() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+const cmdCleanerContextKey = "_command_cleaner"
+
+// CommandCleaner удаление истории завершенных команд старше CMD_RETENTION.
+// Удаление идемпотентно, поэтому работает на всех подах
+func CommandCleaner(ctx context.Context, store *data.Store) {
+	ctx = undecided.NewContextWithMarker(ctx, cmdCleanerContextKey, "")
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "CommandCleaner failed: '%+v'", err)
+		}
+	}()
+
+	cfg := config.Get(ctx)
+	logger.Infof(ctx, "CommandCleaner started: '%v', retention: '%v'", cfg.JobCmdCleanupFrequency, cfg.CmdRetention)
+
+	for range time.Tick(cfg.JobCmdCleanupFrequency) {
+		deleted, err := store.DeleteFinishedMCommands(ctx, time.Now().UTC().Add(-cfg.CmdRetention))
+		if err != nil {
+			logger.Errorf(ctx, "CommandCleaner: '%+v'", err)
+
+			continue
+		}
+
+		if deleted > 0 {
+			logger.Infof(ctx, "CommandCleaner: deleted %v finished commands", deleted)
+		}
+	}
+}
@@ -4,11 +4,14 @@ package job
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/watch"
 	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
@@ -16,6 +19,9 @@ import (
 
 const cMDProcessorContextKey = "_command_processor"
 
+// processorSeq номер обработчика в реплике, входит в идентификатор владельца аренды
+var processorSeq atomic.Int32
+
 ////var logger = zap.S().With(zap.Any("_job", ""))
 
 type ProcessorPool struct {
@@ -23,6 +29,7 @@ type ProcessorPool struct {
 	dbPB *datapb.Store
 
 	agentManager *agent.Manager
+	runWatcher   *watch.Hub
 }
 
 func NewProcessorPool(dataStore *data.Store, pbStore *datapb.Store, aManager *agent.Manager) *ProcessorPool {
@@ -30,9 +37,19 @@ func NewProcessorPool(dataStore *data.Store, pbStore *datapb.Store, aManager *ag
 		db:           dataStore,
 		dbPB:         pbStore,
 		agentManager: aManager,
+		runWatcher:   watch.NewHub(),
 	}
 }
 
+// RunWatcher подписки на изменения Run`ов(WatchRun)
+func (p *ProcessorPool) RunWatcher() *watch.Hub {
+	return p.runWatcher
+}
+
+//	StartProcessor обработчик очереди команд.
+//
+// Команда захватывается из БД в аренду(ClaimMCommand), пока команда обрабатывается - аренда продлевается.
+// Если реплика упала, после истечения аренды команду заберет любой обработчик
 func (p *ProcessorPool) StartProcessor(ctx context.Context) {
 	ctx = undecided.NewContextWithMarker(ctx, cMDProcessorContextKey, "")
 	defer func() {
@@ -42,57 +59,132 @@ func (p *ProcessorPool) StartProcessor(ctx context.Context) {
 	}()
 
 	cfg := config.Get(ctx)
-	hostname := cfg.Hostname
-	logger.Infof(ctx, "Processor started. Hostname: '%v'", hostname)
+	owner := fmt.Sprintf("%v/%v-%v", cfg.Hostname, time.Now().UnixNano(), processorSeq.Add(1))
+	logger.Infof(ctx, "Processor started. Lease owner: '%v'", owner)
 
-	for cmd := range cfg.CommandChan {
-		logger.Warn(ctx, "Start Processor")
+	for {
+		cmd, err := p.db.ClaimMCommand(ctx, owner, cfg.CmdLeaseDuration, cfg.CmdMaxAttempts)
+		if err != nil {
+			logger.Errorf(ctx, "Claim command error: '%+v'", err)
+		}
 
-		logger.Infof(ctx, "Command received '%v', '%+v'", cmd.CommandID, cmd)
+		if cmd == nil {
+			time.Sleep(cfg.JobCmdProcessorFrequency)
 
-		var switchResult bool
+			continue
+		}
 
-		childCmdCtx := undecided.NewContextWithMarker(ctx, cMDProcessorContextKey, cmd.Type)
-		logger.Warnf(ctx, cmd.Type)
+		p.processingCommand(ctx, cmd, cfg.CmdLeaseDuration)
 
-		switch cmd.Type {
-		case models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED:
-			switchResult = p.startingRunning(childCmdCtx, cmd)
+		// Если команда UPDATE, и других новых команд нет, немного поспать
+		if cmd.Type == models.CmdtypeTYPE_UPDATE {
+			countNewCmd, er := p.db.CountNewCmd(ctx)
+			if er != nil {
+				logger.Errorf(ctx, "Error get Count cmdUpdate: %v", er.Error())
+			} else if countNewCmd == 0 {
+				time.Sleep(cfg.JobCmdProcessorFrequency)
+			}
+		}
 
-		case models.CmdtypeTYPE_STOP_SCENARIO:
-			switchResult = p.stoppingRunning(childCmdCtx, cmd)
+		logger.Infof(ctx, "CommandProcessor go to start")
+	}
+}
+
+func (p *ProcessorPool) processingCommand(ctx context.Context, cmd *models.Command, lease time.Duration) {
+	defer func() {
+		if err := recover(); err != nil {
+			// команда остается в аренде и после ее истечения будет обработана повторно
+			logger.Errorf(ctx, "Processing command '%v' failed: '%+v'", cmd.CommandID, err)
+		}
+	}()
 
-		case models.CmdtypeTYPE_STOP_SCRIPT:
-			switchResult = p.stoppingScript(childCmdCtx, cmd)
+	// команды, созданные при обработке, наследуют пользователя исходной команды
+	ctx = undecided.ContextWithUser(ctx, cmd.UserName)
 
-		case models.CmdtypeTYPE_ADJUSTMENT:
-			switchResult = p.adjustment(childCmdCtx, cmd)
+	// обработка отменяется, если аренда потеряна: команду уже обрабатывает другой обработчик
+	cmdCtx, cancelCmd := context.WithCancel(ctx)
+	defer cancelCmd()
 
-		case models.CmdtypeTYPE_RUN_SCRIPT:
-			switchResult = p.startingScript(childCmdCtx, cmd)
+	go p.leaseHeartbeat(cmdCtx, cmd, lease, cancelCmd)
 
-		case models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT:
-			switchResult = p.startingSimpleScript(childCmdCtx, cmd)
+	logger.Infof(ctx, "Command received '%v', '%+v'", cmd.CommandID, cmd)
 
-		case models.CmdtypeTYPE_UPDATE:
-			switchResult = p.updatingRun(childCmdCtx, cmd)
+	var switchResult bool
 
-		default:
-			message := fmt.Sprintf("_-_-_-_-_- The case %v is not implemented! -_-_-_-_-_", cmd.Type)
-			p.db.UpdateStatusMCommand(ctx, cmd, models.CmdstatusSTATUS_FAILED, message)
-			continue
-		}
-		if !switchResult {
-			logger.Warnf(ctx, "Cmd ")
+	childCmdCtx := undecided.NewContextWithMarker(cmdCtx, cMDProcessorContextKey, cmd.Type)
+	logger.Warnf(ctx, cmd.Type)
+
+	switch cmd.Type {
+	case models.CmdtypeTYPE_RUN_SCENARIO_UNSPECIFIED:
+		switchResult = p.startingRunning(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_STOP_SCENARIO:
+		switchResult = p.stoppingRunning(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_STOP_SCRIPT:
+		switchResult = p.stoppingScript(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_ADJUSTMENT:
+		switchResult = p.adjustment(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_RUN_SCRIPT:
+		switchResult = p.startingScript(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_RUN_SIMPLE_SCRIPT:
+		switchResult = p.startingSimpleScript(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_UPDATE:
+		switchResult = p.updatingRun(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_PAUSE_SCENARIO:
+		switchResult = p.pausingRunning(childCmdCtx, cmd)
+
+	case models.CmdtypeTYPE_RESUME_SCENARIO:
+		switchResult = p.resumingRunning(childCmdCtx, cmd)
+
+	default:
+		message := fmt.Sprintf("_-_-_-_-_- The case %v is not implemented! -_-_-_-_-_", cmd.Type)
+		p.db.UpdateStatusMCommand(ctx, cmd, models.CmdstatusSTATUS_FAILED, message)
+
+		return
+	}
+	if !switchResult {
+		logger.Warnf(ctx, "Cmd ")
+	}
+	logger.Infof(
+		ctx,
+		"%v:'%v', CommandID:'%v', CommandStatus:'%v'",
+		cmd.Type,
+		switchResult,
+		cmd.CommandID,
+		cmd.Status,
+	)
+}
+
+// leaseHeartbeat продление аренды команды, пока она обрабатывается. Если аренда потеряна, обработка отменяется
+func (p *ProcessorPool) leaseHeartbeat(ctx context.Context, cmd *models.Command, lease time.Duration,
+	cancel context.CancelFunc) {
+	ticker := time.NewTicker(lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			extended, err := p.db.ExtendMCommandLease(ctx, cmd, lease)
+			if err != nil {
+				logger.Errorf(ctx, "Lease heartbeat of command '%v': '%+v'", cmd.CommandID, err)
+
+				continue
+			}
+
+			if !extended {
+				logger.Warnf(ctx, "Lease of command '%v' is lost, processing is canceled", cmd.CommandID)
+				cancel()
+
+				return
+			}
 		}
-		logger.Infof(
-			ctx,
-			"%v:'%v', CommandID:'%v', CommandStatus:'%v'",
-			cmd.Type,
-			switchResult,
-			cmd.CommandID,
-			cmd.Status,
-		)
-		logger.Infof(ctx, "CommandProcessor go to start")
 	}
 }
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestLeaseHeartbeat(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	defer db.Close()
+
+	// первое продление успешно, ко второму команду перехватил другой обработчик
+	mock.ExpectExec(`UPDATE "command" SET "lease_expires_at" = \$1 WHERE .*"lease_owner" = \$4`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "command" SET "lease_expires_at" = \$1 WHERE .*"lease_owner" = \$4`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	p := &ProcessorPool{db: data.NewStore(sqlx.NewDb(db, "postgres"))}
+	cmd := &models.Command{CommandID: 7, Status: models.CmdstatusSTATUS_PROCESSED, LeaseOwner: "replica-1"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		p.leaseHeartbeat(ctx, cmd, 30*time.Millisecond, cancel)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("leaseHeartbeat() is not finished after the lease is lost")
+	}
+
+	if ctx.Err() == nil {
+		t.Error("leaseHeartbeat() did not cancel processing of the command")
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
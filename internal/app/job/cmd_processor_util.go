@@ -16,9 +16,6 @@ import (
 	"time"
 
 	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
-	agentapi2 "github.com/aliexpressru/alilo-backend/pkg/model/agentapi"
-	"github.com/aliexpressru/alilo-backend/pkg/util/httputil"
-	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
@@ -30,6 +27,7 @@ import (
 	"github.com/aliexpressru/alilo-backend/pkg/util/promutil"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -96,6 +94,7 @@ func (p *ProcessorPool) prepareTaskToRun(ctx context.Context, scriptRun *pb.Scri
 			},
 		}
 	case pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE:
+		apiURL, ammoURL := simpleScriptEnvs(scriptRun.GetSimpleScript())
 		task = &agentapi.Task{
 			ScriptUrl:     scriptRun.GetSimpleScript().GetScriptFileUrl(),
 			ScenarioTitle: scenarioTitle,
@@ -107,15 +106,11 @@ func (p *ProcessorPool) prepareTaskToRun(ctx context.Context, scriptRun *pb.Scri
 			RunId:       int64(scriptRun.RunId),
 			ScriptRunId: int64(scriptRun.RunScriptId),
 			Envs: &agentapi.Envs{
-				Steps:    scriptRun.GetSimpleScript().GetSteps(),
-				Rps:      scriptRun.GetSimpleScript().GetRps(),
-				Duration: scriptRun.GetSimpleScript().GetDuration(),
-				ApiUrl: fmt.Sprintf(
-					"%v://%v",
-					scriptRun.GetSimpleScript().GetScheme(),
-					scriptRun.GetSimpleScript().GetPath(),
-				),
-				AmmoUrl:       scriptRun.GetSimpleScript().GetAmmoUrl(),
+				Steps:         scriptRun.GetSimpleScript().GetSteps(),
+				Rps:           scriptRun.GetSimpleScript().GetRps(),
+				Duration:      scriptRun.GetSimpleScript().GetDuration(),
+				ApiUrl:        apiURL,
+				AmmoUrl:       ammoURL,
 				AdditionalEnv: scriptRun.GetSimpleScript().GetAdditionalEnv(),
 			},
 		}
@@ -136,6 +131,21 @@ func (p *ProcessorPool) prepareTaskToRun(ctx context.Context, scriptRun *pb.Scri
 	return task
 }
 
+// simpleScriptEnvs адрес и файл, который агент загружает перед запуском простого скрипта. gRPC скрипт получает
+// адрес host:port, а вместо патронов - набор дескрипторов: loadProtoset в k6 читает только локальный файл,
+// патроны при этом встроены в скрипт
+func simpleScriptEnvs(simpleScript *pb.SimpleScript) (apiURL string, ammoURL string) {
+	if simpleScript.GetProtocol() != pb.SimpleScript_PROTOCOL_GRPC {
+		return fmt.Sprintf("%v://%v", simpleScript.GetScheme(), simpleScript.GetPath()), simpleScript.GetAmmoUrl()
+	}
+
+	if simpleScript.GetDescriptorUrl() != "" {
+		return simpleScript.GetPath(), simpleScript.GetDescriptorUrl()
+	}
+
+	return simpleScript.GetPath(), simpleScript.GetAmmoUrl()
+}
+
 func (p *ProcessorPool) checkSmoke(scenarioTitle string) bool {
 	return strings.Contains(scenarioTitle, config.SmokeMarker)
 }
@@ -213,52 +223,16 @@ func (p *ProcessorPool) updateStatusScriptRun(ctx context.Context,
 	agentHost := scriptRun.GetAgent()
 	// runScriptStatusRq := p.prepareRequest(ctx, scriptRun)
 
-	logger.Infof(ctx, "GetClient(%s:%s)", agentHost.HostName, agentHost.Port)
+	logger.Infof(ctx, "GetStatus(%s:%s)", agentHost.HostName, agentHost.Port)
 
-	agent, err := p.agentManager.GetClientPB(ctx, agentHost)
-	if err != nil {
-		logger.Errorf(ctx, "execute GetClientPB error: '%v' ", err)
-		err = errors.Wrapf(err, "execute GetClient: '%v':'%v'", scriptRun.GetRunId(), agentHost.HostName)
-
-		errorDescription := strings.Builder{}
-		errorDescription.WriteString(cmdUpdating.ErrorDescription)
-		errorDescription.WriteString(" RunScriptId:")
-		errorDescription.WriteString(strconv.FormatInt(int64(scriptRun.GetRunScriptId()), 10))
-		errorDescription.WriteString(" ERROR:")
-		errorDescription.WriteString(err.Error())
-		errorDescription.WriteString("; ")
-		p.db.UpdateStatusMCommand(ctx, cmdUpdating, cmdUpdating.Status, errorDescription.String())
-
-		mess := "mistake getting AgentClient"
-		if !strings.Contains(scriptRun.Info, mess) {
-			scriptRun.Info = fmt.Sprintf("%s %s; ", scriptRun.Info, mess)
-		}
-
-		return scriptRun
-	}
-
-	//rs, err := agent.GetStatus(ctx, &agentapi.GetStatusRequest{Pid: scriptRun.Pid})
-	bytes, err := httputil.Post(
-		ctx,
-		fmt.Sprintf("http://%s:%s/api/v1/getStatus", scriptRun.Agent.HostName, scriptRun.Agent.Port),
-		"application/json",
-		map[string]string{},
-		&agentapi2.GetStatusRequest{Pid: scriptRun.Pid})
-	if err != nil {
-		logger.Errorf(ctx, "HTTP Start request failed: %v", err)
-		return nil
-	}
-
-	rs := &agentapi2.ResponseGetStatus{}
-
-	err = json.Unmarshal(bytes, rs)
-	if err != nil {
-		logger.Errorf(ctx, "HTTP get status request failed: %v", err)
-
-		return nil
-	}
+	rs, err := p.agentManager.GetStatus(ctx, undecided.GetPBHost(agentHost), scriptRun.Pid)
 	if err != nil && !strings.Contains(err.Error(), "no such test run") {
 		logger.Errorf(ctx, "executeRequest GetStatus ToAgentAndReturnResponse error: '%v' ", err)
+		if isAgentUnreachable(err) && p.agentQuarantined(ctx, agentHost) &&
+			p.failoverScriptRun(ctx, scriptRun, fmt.Sprintf("agent '%v' is unreachable", agentHost.HostName), false) {
+			return scriptRun
+		}
+
 		err = errors.Wrapf(
 			err,
 			"executeRequestToAgentAndReturnResponse GetStatus: '%v':'%v'",
@@ -293,10 +267,16 @@ func (p *ProcessorPool) updateStatusScriptRun(ctx context.Context,
 		agentHost.HostName, scriptRun.RunScriptId, rs)
 	if err != nil || rs == nil {
 		if strings.Contains(err.Error(), "no such test run") {
+			if isEndedEarly(scriptRun) &&
+				p.failoverScriptRun(ctx, scriptRun, fmt.Sprintf("agent '%v' lost the task", agentHost.HostName), true) {
+				return scriptRun
+			}
+
 			scriptRun.Status = pb.ScriptRun_STATUS_STOPPED_UNSPECIFIED
 			if scriptRun.Metrics.ExecutionStatus != executionStatusInterrupted {
 				scriptRun.Metrics.ExecutionStatus = executionStatusEnded
 			}
+			processing.ArchiveScriptRunLog(ctx, scriptRun, p.agentManager, p.dbPB)
 		} else {
 			logger.Errorf(ctx, "error getting status scriptRun %v/%v", cmdUpdating.RunID, scriptRun.RunScriptId)
 			if !strings.Contains(scriptRun.Info, err.Error()) {
@@ -328,36 +308,28 @@ func (p *ProcessorPool) updateStatusScriptRun(ctx context.Context,
 	logger.Debugf(ctx, "---Metrics: Run '%v'('%v') scriptRun'%v'('%v') curData: '%+v'",
 		testName, scriptRun.GetRunId(), scriptName, scriptRun.GetRunScriptId(), rs.Metrics)
 
-	failedValue, err := strconv.ParseInt(rs.Metrics.Failed, 10, 64)
-	if err != nil {
-		logger.Errorf(ctx, "failed to parse failed value: %v", err)
-	}
-
 	if rs.Metrics != nil {
 		scriptRun.Metrics = &pb.Metrics{
-			Rps:               rs.Metrics.Rps,
-			Rt90P:             rs.Metrics.Rt90P,
-			Rt95P:             rs.Metrics.Rt95P,
-			RtMax:             rs.Metrics.RtMax,
-			Rt99P:             rs.Metrics.Rt99P,
-			Failed:            failedValue,
-			Vus:               rs.Metrics.Vus,
-			Sent:              rs.Metrics.Sent,
-			Received:          rs.Metrics.Received,
-			VarietyTs:         0,
-			Checks:            0,
-			ProgressBar:       "",
-			FailedRate:        "",
-			ActiveVusCount:    "",
-			DroppedIterations: "",
-			CurrentTestRunDuration: &durationpb.Duration{
-				Seconds: 0,
-				Nanos:   0,
-			},
-			HasStarted:         true,
-			HasEnded:           true,
-			FullIterationCount: 0,
-			ExecutionStatus:    "",
+			Rps:                    rs.Metrics.GetRps(),
+			Rt90P:                  rs.Metrics.GetRt_90P(),
+			Rt95P:                  rs.Metrics.GetRt_95P(),
+			RtMax:                  rs.Metrics.GetRtMax(),
+			Rt99P:                  rs.Metrics.GetRt_99P(),
+			Failed:                 rs.Metrics.GetFailed(),
+			Vus:                    rs.Metrics.GetVus(),
+			Sent:                   rs.Metrics.GetDataSent(),
+			Received:               rs.Metrics.GetDataReceived(),
+			VarietyTs:              rs.Metrics.GetVarietyTs(),
+			Checks:                 rs.Metrics.GetChecks(),
+			ProgressBar:            rs.Metrics.GetProgressBar(),
+			FailedRate:             rs.Metrics.GetFailedRate(),
+			ActiveVusCount:         rs.Metrics.GetActiveVusCount(),
+			DroppedIterations:      rs.Metrics.GetDroppedIterations(),
+			CurrentTestRunDuration: rs.Metrics.GetCurrentTestRunDuration(),
+			HasStarted:             rs.Metrics.GetHasStarted(),
+			HasEnded:               rs.Metrics.GetHasEnded(),
+			FullIterationCount:     rs.Metrics.GetFullIterationCount(),
+			ExecutionStatus:        rs.Metrics.GetExecutionStatus(),
 		}
 
 		maksURLsInOneScriptRun := config.Get(ctx).MaksURLsInOneScriptRun
@@ -370,7 +342,7 @@ func (p *ProcessorPool) updateStatusScriptRun(ctx context.Context,
 			mess := ""
 			logger.Warnf(ctx, "Stop scriptRun{scriptName:%v, RunScriptId:%v, Pid:%v}",
 				scriptName, scriptRun.RunScriptId, scriptRun.Pid)
-			_, stopErr := agent.Stop(ctx, &agentapi.StopRequest{Pid: scriptRun.Pid})
+			stopErr := p.agentManager.Stop(ctx, undecided.GetPBHost(agentHost), scriptRun.Pid)
 			if stopErr != nil {
 				stopMes := fmt.Sprintf("error script{%v/%v} Stop {%+v}",
 					scriptRun.RunId, scriptRun.RunScriptId, stopErr)
@@ -419,6 +391,14 @@ func (p *ProcessorPool) updatingRun(ctx context.Context, cmdUpdating *models.Com
 	logger.Debugf(ctx, "ProjectID: '%v'; ScenarioID: '%v'; RunID: '%v'; RunToUpdate: '%+v';",
 		runToUpdate.GetProjectId(), runToUpdate.GetScenarioId(), runToUpdate.GetRunId(), runToUpdate)
 
+	// на паузе скрипты остановлены намеренно, наблюдение возобновится при продолжении Run`а
+	if runToUpdate.GetStatus() == pb.Run_STATUS_PAUSED {
+		logger.Infof(ctx, "Run '%v' is paused, observing is stopped", runToUpdate.GetRunId())
+		p.db.UpdateStatusMCommand(ctx, cmdUpdating, models.CmdstatusSTATUS_COMPLETED, "")
+
+		return p.db.FinishMCmd(ctx, cmdUpdating)
+	}
+
 	var countRunningScriptsUpdate = 0
 
 	var wg sync.WaitGroup
@@ -472,6 +452,7 @@ func (p *ProcessorPool) updatingRun(ctx context.Context, cmdUpdating *models.Com
 
 		return false
 	}
+	p.runWatcher.PublishRun(runToUpdate)
 
 	if !p.observingForStatusRun(ctx, cmdUpdating, runToUpdate) &&
 		runToUpdate.GetStatus() != pb.Run_STATUS_STOPPING {
@@ -483,7 +464,7 @@ func (p *ProcessorPool) updatingRun(ctx context.Context, cmdUpdating *models.Com
 
 	p.db.UpdateStatusMCommand(ctx, cmdUpdating, models.CmdstatusSTATUS_COMPLETED, "")
 
-	return p.db.DeleteMCmd(ctx, cmdUpdating)
+	return p.db.FinishMCmd(ctx, cmdUpdating)
 }
 
 func (p *ProcessorPool) stoppingScript(ctx context.Context, cmdStoppingScript *models.Command) bool {
@@ -537,7 +518,7 @@ func (p *ProcessorPool) stoppingScript(ctx context.Context, cmdStoppingScript *m
 	}
 	p.db.UpdateStatusMCommand(ctx, cmdStoppingScript, models.CmdstatusSTATUS_COMPLETED, message)
 
-	return p.db.DeleteMCmd(ctx, cmdStoppingScript)
+	return p.db.FinishMCmd(ctx, cmdStoppingScript)
 }
 
 // TODO make it 40 lines of code tops
@@ -634,30 +615,19 @@ func (p *ProcessorPool) startingScript(ctx context.Context, cmdStartScript *mode
 		scriptRunTask := p.prepareTaskToRun(ctx, pbScriptRunToRunning, getScenario.Title)
 
 		runTask := func() (*agentapi.StartResponse, error) {
-			rs := &agentapi.StartResponse{}
-			er := httputil.ProxyCall(ctx, httputil.ProxyCallConfig{
-				URL: fmt.Sprintf(
-					"http://%s:%s",
-					pbScriptRunToRunning.Agent.HostName,
-					pbScriptRunToRunning.Agent.Port,
-				),
-				Path:   "api/v1/start",
-				Method: http.MethodPost,
-				RequestBody: &agentapi.StartRequest{
-					ScenarioTitle: getScenario.Title,
-					ScriptId:      scriptID,
-					ScriptTitle:   scriptToRun.Title,
-					ScriptURL:     scriptToRun.BaseUrl,
-					Params:        nil,
-					ScriptRunId:   scriptRunTask.ScriptRunId,
-				},
-				ResponseBody: rs,
+			return p.agentManager.Start(ctx, undecided.GetPBHost(pbScriptRunToRunning.Agent), &agentapi.StartRequest{
+				ScenarioTitle: getScenario.Title,
+				ScriptTitle:   scriptToRun.Title,
+				ScriptURL:     scriptToRun.BaseUrl,
+
+				ProjectId:   scriptRunTask.ProjectId,
+				ScenarioId:  scriptRunTask.ScenarioId,
+				ScriptId:    scriptID,
+				RunId:       scriptRunTask.RunId,
+				ScriptRunId: scriptRunTask.ScriptRunId,
+
+				Envs: scriptRunTask.Envs,
 			})
-			if er != nil {
-				logger.Errorf(ctx, "HTTP Start request failed: %v", er)
-				return nil, er
-			}
-			return rs, nil
 		}
 
 		rs, err := runTask()
@@ -702,7 +672,7 @@ func (p *ProcessorPool) startingScript(ctx context.Context, cmdStartScript *mode
 
 	p.db.UpdateStatusMCommand(ctx, cmdStartScript, models.CmdstatusSTATUS_COMPLETED, "")
 
-	return p.db.DeleteMCmd(ctx, cmdStartScript)
+	return p.db.FinishMCmd(ctx, cmdStartScript)
 }
 
 // TODO remove, because identical to startingScript in essence
@@ -735,8 +705,22 @@ func (p *ProcessorPool) startingRunning(ctx context.Context, cmdRunning *models.
 	}
 
 	if len(pbRun.ScriptRuns) > 0 {
+		// профиль нагрузки сценария передается в расширенные скрипты через env STAGES
+		scenario, mes := p.dbPB.GetScenario(ctx, pbRun.GetScenarioId())
+		if mes != "" {
+			logger.Warnf(ctx, "startingRunning GetScenario{%v} message: '%v'", pbRun.GetScenarioId(), mes)
+		}
+
 		var wg sync.WaitGroup
 		for i, scriptRun := range pbRun.ScriptRuns {
+			// команда с истекшей арендой обрабатывается повторно, уже запущенные скрипты второй раз не запускаются
+			if scriptRunStarted(scriptRun) {
+				logger.Warnf(ctx, "ScriptRun '%v' is already running on '%v' pid '%v', skip start",
+					scriptRun.GetRunScriptId(), scriptRun.GetAgent().GetHostName(), scriptRun.GetPid())
+
+				continue
+			}
+
 			wg.Add(1)
 			logger.Debugf(ctx, "ScriptRun: %+v", scriptRun)
 
@@ -790,43 +774,8 @@ func (p *ProcessorPool) startingRunning(ctx context.Context, cmdRunning *models.
 					//}
 
 					curScriptRun.Agent = agentHost
-					// todo params builder
-					startRequest := agentapi2.AgentStartRequest{
-						ScenarioTitle: scenarioRunTask.ScenarioTitle,
-						ScriptTitle:   scriptName,
-						ScriptURL:     scenarioRunTask.ScriptUrl,
-						AmmoURL:       scenarioRunTask.Envs.AmmoUrl,
-						Params: []string{"-e", fmt.Sprintf("RPS=%s", scenarioRunTask.Envs.Rps),
-							"-e", fmt.Sprintf("DURATION=%s", scenarioRunTask.Envs.Duration),
-							"-e", fmt.Sprintf("STEPS=%s", scenarioRunTask.Envs.Steps),
-						},
-					}
-					logger.Infof(ctx, "Start Script{%s} Request %+v", scenarioRunTask.ScriptTitle, startRequest)
-					// todo apiURl имеет странный префикс: ://
-					//return agent.Start(ctx, startRequest)
-
-					bytes, err := httputil.Post(
-						ctx,
-						fmt.Sprintf("http://%s:%s/api/v1/start",
-							agentHost.HostName,
-							agentHost.Port),
-						"application/json",
-						map[string]string{}, startRequest)
-					if err != nil {
-						logger.Errorf(ctx, "HTTP Start request failed: %v", er)
-						return nil, er
-					}
-
-					rr := &agentapi.StartResponse{}
-					err = json.Unmarshal(bytes, rr)
-					if err != nil {
-						logger.Errorf(ctx, "Report{%v} Unmarshal err: %+v", rr, err)
 
-						return nil, err
-					}
-					logger.Infof(ctx, "pid processed: %d", rr.Pid)
-
-					return rr, nil
+					return p.startScriptRunOnAgent(ctx, curScriptRun, scenarioRunTask, scriptName, scenario)
 				}
 
 				rs, er := runTask()
@@ -874,8 +823,8 @@ func (p *ProcessorPool) startingRunning(ctx context.Context, cmdRunning *models.
 		p.db.UpdateStatusMCommand(ctx, cmdRunning, models.CmdstatusSTATUS_COMPLETED, "")
 		p.dbPB.UpdatePbRunningInTheDB(ctx, pbRun)
 
-		if !p.db.DeleteMCmd(ctx, cmdRunning) {
-			p.db.UpdateStatusMCommand(ctx, cmdRunning, models.CmdstatusSTATUS_FAILED, "Error DeleteMCmd: false.")
+		if !p.db.FinishMCmd(ctx, cmdRunning) {
+			p.db.UpdateStatusMCommand(ctx, cmdRunning, models.CmdstatusSTATUS_FAILED, "Error FinishMCmd: false.")
 
 			return false
 		}
@@ -944,6 +893,54 @@ func (p *ProcessorPool) startingRunning(ctx context.Context, cmdRunning *models.
 	return true
 }
 
+// scriptRunStarted ScriptRun уже запущен на агенте
+func scriptRunStarted(scriptRun *pb.ScriptRun) bool {
+	return scriptRun.GetStatus() == pb.ScriptRun_STATUS_RUNNING && scriptRun.GetPid() != 0
+}
+
+// startScriptRunOnAgent запуск ScriptRun`а на агенте curScriptRun.Agent
+func (p *ProcessorPool) startScriptRunOnAgent(ctx context.Context, curScriptRun *pb.ScriptRun,
+	scenarioRunTask *agentapi.Task, scriptName string, scenario *pb.Scenario) (*agentapi.StartResponse, error) {
+	startRequest := &agentapi.StartRequest{
+		ScenarioTitle: scenarioRunTask.ScenarioTitle,
+		ScriptTitle:   scriptName,
+		ScriptURL:     scenarioRunTask.ScriptUrl,
+
+		ProjectId:   scenarioRunTask.ProjectId,
+		ScenarioId:  scenarioRunTask.ScenarioId,
+		ScriptId:    scenarioRunTask.ScriptId,
+		RunId:       scenarioRunTask.RunId,
+		ScriptRunId: scenarioRunTask.ScriptRunId,
+
+		Envs: scenarioRunTask.Envs,
+	}
+	if curScriptRun.TypeScriptRun == pb.ScriptRun_TYPE_SCRIPT_RUN_EXTENDED_UNSPECIFIED &&
+		len(scenario.GetLoadProfile()) > 0 {
+		stages, e := processing.LoadProfileStagesToEnv(scenario.GetLoadProfile(), scenarioRunTask.Envs.Rps)
+		if e != nil {
+			logger.Errorf(ctx, "Script{%v} load profile error: '%v'", scriptName, e)
+		} else {
+			envs := proto.Clone(startRequest.Envs).(*agentapi.Envs)
+			if envs.AdditionalEnv == nil {
+				envs.AdditionalEnv = make(map[string]string)
+			}
+			envs.AdditionalEnv["STAGES"] = stages
+			startRequest.Envs = envs
+		}
+	}
+	logger.Infof(ctx, "Start Script{%s} Request %+v", scenarioRunTask.ScriptTitle, startRequest)
+
+	rr, err := p.agentManager.Start(ctx, undecided.GetPBHost(curScriptRun.Agent), startRequest)
+	if err != nil {
+		logger.Errorf(ctx, "Start request failed: %v", err)
+
+		return nil, err
+	}
+	logger.Infof(ctx, "pid processed: %d", rr.Pid)
+
+	return rr, nil
+}
+
 // failureToComplyWithTheConditionsForNotification its test env, or not
 func (p *ProcessorPool) failureToComplyWithTheConditionsForNotification(ctx context.Context, pbRun *pb.Run) bool {
 	checkSmoke := p.checkSmoke(pbRun.Title)
@@ -1041,12 +1038,19 @@ func (p *ProcessorPool) stoppingRunning(ctx context.Context, cmdStopping *models
 		return false
 	}
 
+	// остановка приостановленного Run`а тоже проходит здесь, его пауза больше не нужна
+	if pbRun.GetStatus() == pb.Run_STATUS_STOPPED_UNSPECIFIED {
+		if err := p.db.CloseMActiveRunPause(context.WithoutCancel(ctx), pbRun.GetRunId()); err != nil {
+			logger.Errorf(ctx, "Close pause of stopped Run '%v': '%+v'", pbRun.GetRunId(), err)
+		}
+	}
+
 	p.db.UpdateStatusMCommand(ctx, cmdStopping, models.CmdstatusSTATUS_COMPLETED, "")
 
 	// Удаление лишней команды для обновления Рана
 	p.db.StopObservingForStatusRun(ctx, pbRun.RunId)
 
-	if !p.db.DeleteMCmd(ctx, cmdStopping) {
+	if !p.db.FinishMCmd(ctx, cmdStopping) {
 		return false
 	}
 
@@ -1223,7 +1227,7 @@ func (p *ProcessorPool) adjustment(ctx context.Context, cmdAdjustment *models.Co
 
 	p.db.UpdateStatusMCommand(ctx, cmdAdjustment, models.CmdstatusSTATUS_COMPLETED, "")
 
-	return p.db.DeleteMCmd(ctx, cmdAdjustment)
+	return p.db.FinishMCmd(ctx, cmdAdjustment)
 }
 
 // Процесс снижения нагрузки Сценария по скриптам
@@ -1770,33 +1774,7 @@ func (p *ProcessorPool) scriptStop(ctx context.Context, runID int32, scriptRunID
 			return returnScriptRun, message
 		}
 
-		stopTask := func() (*agentapi.StopResponse, error) {
-			defer func() {
-				if err := recover(); err != nil {
-					logger.Errorf(ctx, "stopTask failed: '%+v'", err)
-				}
-			}()
-			agent, err := p.agentManager.GetClientPB(ctx, returnScriptRun.Agent)
-			if err != nil {
-				logger.Errorf(ctx, "stopTask GetClientPB failed: '%+v'", err)
-				return nil, err
-			}
-			if agent == nil {
-				err = fmt.Errorf("invalid getting agentClientPB")
-				logger.Errorf(ctx, "stopTask GetClientPB{%v -> %v} failed: '%+v'",
-					scriptRunID, returnScriptRun.Agent, err)
-
-				return nil, err
-			}
-
-			ctxWithTimeout, canc := context.WithTimeout(ctx, 11*time.Second)
-			defer canc()
-			return agent.Stop(ctxWithTimeout, &agentapi.StopRequest{
-				Pid: returnScriptRun.Pid,
-			})
-		}
-
-		_, err := stopTask()
+		err := p.agentManager.Stop(ctx, undecided.GetPBHost(returnScriptRun.Agent), returnScriptRun.Pid)
 		if err != nil {
 			logger.Debugf(ctx, "stopTask err: %v", err)
 			message = fmt.Sprintf("Execute request Stop error '%v'", err.Error())
@@ -1819,6 +1797,8 @@ func (p *ProcessorPool) scriptStop(ctx context.Context, runID int32, scriptRunID
 		returnScriptRun.Status = pb.ScriptRun_STATUS_STOPPED_UNSPECIFIED
 		logger.Infof(ctx, "Set RunScriptId '%v' status:'%+v'",
 			returnScriptRun.GetRunScriptId(), returnScriptRun.Status)
+
+		processing.ArchiveScriptRunLog(ctx, returnScriptRun, p.agentManager, p.dbPB)
 	}
 
 	if returnScriptRun.Metrics.ExecutionStatus != executionStatusInterrupted {
@@ -1880,6 +1860,7 @@ func (p *ProcessorPool) scenarioStop(ctx context.Context, runID int32) (returnRu
 
 				return returnRun, message
 			}
+			p.runWatcher.PublishRun(returnRun)
 		} else {
 			message = fmt.Sprintf(
 				"The script is not stopped, number of runned:'%v'; number of scriptRuns:'%v';",
@@ -2111,13 +2092,7 @@ func (p *ProcessorPool) startingSimpleScript(ctx context.Context, cmdStartSimple
 		p.loadValidation(ctx, cmdStartSimpleScript.PercentageOfTarget.Int32, pbScriptRunToRunning)
 		simpleScriptRunTask := p.prepareTaskToRun(ctx, pbScriptRunToRunning, getScenario.Title)
 		runTask := func() (*agentapi.StartResponse, error) {
-			agent, err2 := p.agentManager.GetClientPB(ctx, pbScriptRunToRunning.Agent)
-			if err2 != nil {
-				logger.Errorf(ctx, "error GetClientPB %+v", err2)
-				return nil, err2
-			}
-
-			return agent.Start(ctx, &agentapi.StartRequest{
+			return p.agentManager.Start(ctx, undecided.GetPBHost(pbScriptRunToRunning.Agent), &agentapi.StartRequest{
 				ScenarioTitle: simpleScriptRunTask.ScenarioTitle,
 				ScriptTitle:   simpleScriptRunTask.ScriptTitle,
 				ScriptURL:     simpleScriptRunTask.ScriptUrl,
@@ -2175,5 +2150,5 @@ func (p *ProcessorPool) startingSimpleScript(ctx context.Context, cmdStartSimple
 
 	p.db.UpdateStatusMCommand(ctx, cmdStartSimpleScript, models.CmdstatusSTATUS_COMPLETED, "")
 
-	return p.db.DeleteMCmd(ctx, cmdStartSimpleScript)
+	return p.db.FinishMCmd(ctx, cmdStartSimpleScript)
 }
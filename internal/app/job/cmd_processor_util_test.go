@@ -0,0 +1,73 @@
+package job
+
+import (
+	"testing"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+)
+
+func TestScriptRunStarted(t *testing.T) {
+	tests := []struct {
+		name      string
+		scriptRun *pb.ScriptRun
+		want      bool
+	}{
+		{name: "not started", scriptRun: &pb.ScriptRun{}},
+		{name: "running", scriptRun: &pb.ScriptRun{Status: pb.ScriptRun_STATUS_RUNNING, Pid: 42}, want: true},
+		{name: "running without pid", scriptRun: &pb.ScriptRun{Status: pb.ScriptRun_STATUS_RUNNING}},
+		{name: "failed to start", scriptRun: &pb.ScriptRun{Status: pb.ScriptRun_STATUS_FAILED, Pid: 42}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scriptRunStarted(tt.scriptRun); got != tt.want {
+				t.Errorf("scriptRunStarted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimpleScriptEnvs(t *testing.T) {
+	tests := []struct {
+		name         string
+		simpleScript *pb.SimpleScript
+		wantAPIURL   string
+		wantAmmoURL  string
+	}{
+		{
+			name: "http",
+			simpleScript: &pb.SimpleScript{
+				Scheme: "https", Path: "example.com/items", AmmoUrl: "http://minio/bucket/ammo.json",
+			},
+			wantAPIURL:  "https://example.com/items",
+			wantAmmoURL: "http://minio/bucket/ammo.json",
+		},
+		{
+			name: "grpc with reflection",
+			simpleScript: &pb.SimpleScript{
+				Protocol: pb.SimpleScript_PROTOCOL_GRPC, Path: "example.com:443",
+				AmmoUrl: "http://minio/bucket/ammo.json",
+			},
+			wantAPIURL:  "example.com:443",
+			wantAmmoURL: "http://minio/bucket/ammo.json",
+		},
+		{
+			name: "grpc with descriptor",
+			simpleScript: &pb.SimpleScript{
+				Protocol: pb.SimpleScript_PROTOCOL_GRPC, Path: "example.com:443",
+				AmmoUrl:       "http://minio/bucket/ammo.json",
+				DescriptorUrl: "http://minio/bucket/shop.protoset",
+			},
+			wantAPIURL: "example.com:443",
+			// патроны встроены в скрипт, агент загружает набор дескрипторов
+			wantAmmoURL: "http://minio/bucket/shop.protoset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiURL, ammoURL := simpleScriptEnvs(tt.simpleScript)
+			if apiURL != tt.wantAPIURL || ammoURL != tt.wantAmmoURL {
+				t.Errorf("simpleScriptEnvs() = %v, %v, want %v, %v", apiURL, ammoURL, tt.wantAPIURL, tt.wantAmmoURL)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// pausingRunning приостановка Run`а: запущенные скрипты останавливаются, их RPS и агенты сохраняются в run_pauses.
+// Run сохраняет идентификатор, ScriptRun`ы и историю, при продолжении скрипты запускаются с теми же RunScriptId
+func (p *ProcessorPool) pausingRunning(ctx context.Context, cmdPause *models.Command) bool {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "pausingRunning failed: '%+v'", err)
+		}
+	}()
+
+	if !p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_PROCESSED, "") {
+		return false
+	}
+	defer undecided.InfoTimer(ctx, fmt.Sprint("Pausing Running - ", cmdPause.RunID))()
+
+	pbRun, message := p.dbPB.GetRunning(ctx, cmdPause.RunID)
+	if message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED,
+			fmt.Sprintf("Pb.GetRunning('%v') error:'%v'", cmdPause.RunID, message))
+
+		return false
+	} else if pbRun.GetStatus() != pb.Run_STATUS_RUNNING {
+		errorMessage := fmt.Sprintf("Interruption due to incorrect status '%v' RunID '%v'",
+			pbRun.GetStatus(), cmdPause.RunID)
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED, errorMessage)
+
+		return false
+	}
+
+	// пауза и статус PAUSED сохраняются до остановки скриптов: если сохранить не удалось - Run продолжает работать,
+	// а остановленные скрипты Run`а в статусе PAUSED не считаются упавшими(updatingRun снимает наблюдение)
+	runPause := newRunPause(pbRun, undecided.UserFromContext(ctx))
+
+	mRunPause, message := conv.PBToModelRunPause(ctx, runPause)
+	if message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED, message)
+
+		return false
+	}
+
+	if _, err := p.db.CreateMRunPause(ctx, mRunPause); err != nil {
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED, err.Error())
+
+		return false
+	}
+
+	pbRun.Status = pb.Run_STATUS_PAUSED
+	if _, message = p.dbPB.UpdatePbRunningInTheDB(ctx, pbRun); message != "" {
+		// откат: пауза закрывается, Run остается RUNNING под наблюдением
+		if _, err := p.db.ResumeMRunPause(ctx, mRunPause); err != nil {
+			logger.Errorf(ctx, "Rollback pause of Run '%v': '%+v'", pbRun.GetRunId(), err)
+		}
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED, message)
+
+		return false
+	}
+
+	p.db.StopObservingForStatusRun(ctx, pbRun.GetRunId())
+
+	for _, scriptRun := range pbRun.GetScriptRuns() {
+		if scriptRun.GetStatus() != pb.ScriptRun_STATUS_RUNNING {
+			continue
+		}
+
+		if _, mes := p.stoppingScriptRunAndSetTheStatusStopped(ctx, scriptRun); mes != "" {
+			logger.Warnf(ctx, "Pausing RunScriptId '%v': '%v'", scriptRun.GetRunScriptId(), mes)
+		}
+	}
+
+	// ScriptRun`ы обновлены в БД при остановке, поэтому Run перечитывается
+	pbRun, message = p.dbPB.GetRunning(ctx, cmdPause.RunID)
+	if message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_FAILED, message)
+
+		return false
+	}
+
+	p.runWatcher.PublishRun(pbRun)
+	p.markerForTheAnnotation(ctx, markerStop, pbRun.GetTitle(), pbRun.GetRunId())
+	logger.Infof(ctx, "The run is paused '%v', stopped scripts: %v", pbRun.GetRunId(), len(runPause.GetScriptRuns()))
+
+	p.db.UpdateStatusMCommand(ctx, cmdPause, models.CmdstatusSTATUS_COMPLETED, "")
+
+	return p.db.FinishMCmd(ctx, cmdPause)
+}
+
+// resumingRunning продолжение приостановленного Run`а: скрипты паузы запускаются с сохраненным RPS
+// на том же агенте, а если он недоступен - на любом свободном агенте с тем же тегом.
+// Пауза закрывается после запуска скриптов, поэтому прерванное продолжение можно повторить:
+// уже запущенные скрипты пропускаются, а Run может быть уже RUNNING
+func (p *ProcessorPool) resumingRunning(ctx context.Context, cmdResume *models.Command) bool {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "resumingRunning failed: '%+v'", err)
+		}
+	}()
+
+	if !p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_PROCESSED, "") {
+		return false
+	}
+	defer undecided.InfoTimer(ctx, fmt.Sprint("Resuming Running - ", cmdResume.RunID))()
+
+	pbRun, message := p.dbPB.GetRunning(ctx, cmdResume.RunID)
+	if message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED,
+			fmt.Sprintf("Pb.GetRunning('%v') error:'%v'", cmdResume.RunID, message))
+
+		return false
+	} else if pbRun.GetStatus() != pb.Run_STATUS_PAUSED && pbRun.GetStatus() != pb.Run_STATUS_RUNNING {
+		errorMessage := fmt.Sprintf("Interruption due to incorrect status '%v' RunID '%v'",
+			pbRun.GetStatus(), cmdResume.RunID)
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED, errorMessage)
+
+		return false
+	}
+
+	mRunPause, err := p.db.GetMActiveRunPause(ctx, cmdResume.RunID)
+	if err != nil || mRunPause == nil {
+		errorMessage := fmt.Sprintf("Run '%v' has no active pause: '%v'", cmdResume.RunID, err)
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED, errorMessage)
+
+		return false
+	}
+
+	runPause, message := conv.ModelToPBRunPause(ctx, mRunPause)
+	if message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED, message)
+
+		return false
+	}
+
+	pausedScriptRuns := make(map[int32]*pb.PausedScriptRun, len(runPause.GetScriptRuns()))
+	for _, pausedScriptRun := range runPause.GetScriptRuns() {
+		pausedScriptRuns[pausedScriptRun.GetRunScriptId()] = pausedScriptRun
+	}
+
+	var wg sync.WaitGroup
+	for i, scriptRun := range pbRun.GetScriptRuns() {
+		pausedScriptRun, ok := pausedScriptRuns[scriptRun.GetRunScriptId()]
+		// запущен предыдущей попыткой продолжения
+		if !ok || scriptRun.GetStatus() == pb.ScriptRun_STATUS_RUNNING {
+			continue
+		}
+
+		wg.Add(1)
+		go func(curScriptRun *pb.ScriptRun, i int) {
+			defer func() {
+				if er := recover(); er != nil {
+					logger.Errorf(ctx, "resumingRunning script run failed: '%+v'", er)
+				}
+
+				wg.Done()
+			}()
+
+			p.resumingScriptRun(ctx, curScriptRun, pausedScriptRun, pbRun.GetTitle(), i)
+		}(scriptRun, i)
+	}
+
+	wg.Wait()
+
+	p.setTheRunScenarioStatusRunning(ctx, pbRun)
+	pbRun.PercentageOfTarget = runPause.GetPercentageOfTarget()
+
+	if pbRun, message = p.dbPB.UpdatePbRunningInTheDB(ctx, pbRun); message != "" {
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED, message)
+
+		return false
+	}
+
+	p.runWatcher.PublishRun(pbRun)
+
+	if !p.observingForStatusRun(ctx, cmdResume, pbRun) {
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED,
+			fmt.Sprintf("No scripts were resumed, Run '%v' status '%v'", pbRun.GetRunId(), pbRun.GetStatus()))
+
+		return false
+	}
+
+	claimed, err := p.db.ResumeMRunPause(ctx, mRunPause)
+	if err != nil {
+		errorMessage := fmt.Sprintf("Close pause '%v' of Run '%v' error: '%v'",
+			mRunPause.RunPauseID, cmdResume.RunID, err)
+		p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_FAILED, errorMessage)
+
+		return false
+	} else if !claimed {
+		logger.Warnf(ctx, "The pause '%v' of Run '%v' is already closed", mRunPause.RunPauseID, cmdResume.RunID)
+	}
+
+	p.markerForTheAnnotation(ctx, markerStart, pbRun.GetTitle(), pbRun.GetRunId())
+	logger.Infof(ctx, "The run is resumed '%v'", pbRun.GetRunId())
+
+	p.db.UpdateStatusMCommand(ctx, cmdResume, models.CmdstatusSTATUS_COMPLETED, "")
+
+	return p.db.FinishMCmd(ctx, cmdResume)
+}
+
+func (p *ProcessorPool) resumingScriptRun(ctx context.Context,
+	scriptRun *pb.ScriptRun, pausedScriptRun *pb.PausedScriptRun, runTitle string, i int) {
+	setScriptRunRps(scriptRun, pausedScriptRun.GetRps())
+
+	tag, scriptName := scriptRun.GetScript().GetTag(), scriptRun.GetScript().GetName()
+	if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+		tag, scriptName = scriptRun.GetSimpleScript().GetTag(), scriptRun.GetSimpleScript().GetName()
+	}
+
+	agentHost, err := p.agentManager.GetAgentOrEquivalent(ctx,
+		undecided.GetHost(pausedScriptRun.GetAgentHostName(), pausedScriptRun.GetAgentPort()), tag)
+	if err != nil {
+		scriptRun.Info = fmt.Sprint(scriptRun.Info, "Resume error: ", err.Error(), "; ")
+		logger.Errorf(ctx, "no agents were received by tag{%v}. Script{%v}: %v", tag, scriptName, err)
+
+		return
+	}
+
+	moveLogArchiveKeyToInfo(scriptRun, fmt.Sprintf("log before pause on '%s'", undecided.GetPBHost(scriptRun.GetAgent())))
+
+	scriptRun.Agent = agentHost
+	task := p.prepareTaskToRun(ctx, scriptRun, runTitle)
+
+	// профиль нагрузки(STAGES) не передается: после паузы скрипт сразу выходит на сохраненный RPS
+	rs, err := p.startScriptRunOnAgent(ctx, scriptRun, task, scriptName, nil)
+	if err != nil {
+		scriptRun.Info = fmt.Sprint(scriptRun.Info, "Resume error: ", err.Error(), "; ")
+		logger.Errorf(ctx, "Resume RunScriptId '%v' error: '%v'", scriptRun.GetRunScriptId(), err)
+
+		return
+	}
+
+	agentMessage := p.processingTheResponseFromTheAgent(ctx, rs, scriptRun)
+	if statusMessage := p.setTheScriptRunStatusRunning(ctx, agentMessage, i, scriptRun); statusMessage != "" {
+		logger.Errorf(ctx, "setTheScriptRunStatusRunning '%v'", statusMessage)
+	}
+}
+
+// newRunPause пауза Run`а: выполняющиеся ScriptRun`ы, их RPS и агенты
+func newRunPause(pbRun *pb.Run, userName string) *pb.RunPause {
+	runPause := &pb.RunPause{
+		RunId:              pbRun.GetRunId(),
+		PercentageOfTarget: pbRun.GetPercentageOfTarget(),
+		UserName:           userName,
+	}
+
+	for _, scriptRun := range pbRun.GetScriptRuns() {
+		if scriptRun.GetStatus() != pb.ScriptRun_STATUS_RUNNING {
+			continue
+		}
+
+		runPause.ScriptRuns = append(runPause.ScriptRuns, &pb.PausedScriptRun{
+			RunScriptId:   scriptRun.GetRunScriptId(),
+			Rps:           scriptRunRps(scriptRun),
+			AgentHostName: scriptRun.GetAgent().GetHostName(),
+			AgentPort:     scriptRun.GetAgent().GetPort(),
+		})
+	}
+
+	return runPause
+}
+
+// scriptRunRps текущий RPS ScriptRun`а(с учетом процента от целевой нагрузки)
+func scriptRunRps(scriptRun *pb.ScriptRun) string {
+	if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+		return scriptRun.GetSimpleScript().GetRps()
+	}
+
+	return scriptRun.GetScript().GetOptions().GetRps()
+}
+
+func setScriptRunRps(scriptRun *pb.ScriptRun, rps string) {
+	if rps == "" {
+		return
+	}
+
+	switch scriptRun.GetTypeScriptRun() {
+	case pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE:
+		if scriptRun.GetSimpleScript() != nil {
+			scriptRun.SimpleScript.Rps = rps
+		}
+	case pb.ScriptRun_TYPE_SCRIPT_RUN_EXTENDED_UNSPECIFIED:
+		if scriptRun.GetScript().GetOptions() != nil {
+			scriptRun.Script.Options.Rps = rps
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package job
+
+import (
+	"testing"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+)
+
+func TestNewRunPause(t *testing.T) {
+	pbRun := &pb.Run{
+		RunId:              5,
+		PercentageOfTarget: 50,
+		ScriptRuns: []*pb.ScriptRun{
+			{
+				RunScriptId:   1,
+				Status:        pb.ScriptRun_STATUS_RUNNING,
+				TypeScriptRun: pb.ScriptRun_TYPE_SCRIPT_RUN_EXTENDED_UNSPECIFIED,
+				Script:        &pb.Script{Options: &pb.Options{Rps: "100"}},
+				Agent:         &pb.Agent{HostName: "agent-1", Port: "8888"},
+			},
+			{
+				RunScriptId:   2,
+				Status:        pb.ScriptRun_STATUS_RUNNING,
+				TypeScriptRun: pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE,
+				SimpleScript:  &pb.SimpleScript{Rps: "20"},
+				Agent:         &pb.Agent{HostName: "agent-1", Port: "8889"},
+			},
+			{RunScriptId: 3, Status: pb.ScriptRun_STATUS_FAILED},
+		},
+	}
+
+	runPause := newRunPause(pbRun, "user")
+
+	if runPause.GetRunId() != 5 || runPause.GetPercentageOfTarget() != 50 || runPause.GetUserName() != "user" {
+		t.Fatalf("newRunPause() = %+v", runPause)
+	}
+
+	want := []*pb.PausedScriptRun{
+		{RunScriptId: 1, Rps: "100", AgentHostName: "agent-1", AgentPort: "8888"},
+		{RunScriptId: 2, Rps: "20", AgentHostName: "agent-1", AgentPort: "8889"},
+	}
+	if len(runPause.GetScriptRuns()) != len(want) {
+		t.Fatalf("newRunPause() paused %v script runs, want %v", len(runPause.GetScriptRuns()), len(want))
+	}
+
+	for i, pausedScriptRun := range runPause.GetScriptRuns() {
+		if pausedScriptRun.String() != want[i].String() {
+			t.Errorf("newRunPause() script run %v = %v, want %v", i, pausedScriptRun, want[i])
+		}
+	}
+}
+
+func TestSetScriptRunRps(t *testing.T) {
+	tests := []struct {
+		name      string
+		scriptRun *pb.ScriptRun
+		rps       string
+		want      string
+	}{
+		{
+			name: "extended",
+			scriptRun: &pb.ScriptRun{
+				TypeScriptRun: pb.ScriptRun_TYPE_SCRIPT_RUN_EXTENDED_UNSPECIFIED,
+				Script:        &pb.Script{Options: &pb.Options{Rps: "100"}},
+			},
+			rps:  "50",
+			want: "50",
+		},
+		{
+			name: "simple",
+			scriptRun: &pb.ScriptRun{
+				TypeScriptRun: pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE,
+				SimpleScript:  &pb.SimpleScript{Rps: "100"},
+			},
+			rps:  "30",
+			want: "30",
+		},
+		{
+			name: "empty rps is ignored",
+			scriptRun: &pb.ScriptRun{
+				TypeScriptRun: pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE,
+				SimpleScript:  &pb.SimpleScript{Rps: "100"},
+			},
+			want: "100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setScriptRunRps(tt.scriptRun, tt.rps)
+
+			if got := scriptRunRps(tt.scriptRun); got != tt.want {
+				t.Errorf("scriptRunRps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveLogArchiveKeyToInfo(t *testing.T) {
+	scriptRun := &pb.ScriptRun{Info: "started;", LogArchiveKey: "logs/run_1/script_run_2_10_k6.log"}
+
+	moveLogArchiveKeyToInfo(scriptRun, "log before pause on 'agent-1:8888'")
+
+	if scriptRun.GetLogArchiveKey() != "" {
+		t.Errorf("moveLogArchiveKeyToInfo() kept the key '%v'", scriptRun.GetLogArchiveKey())
+	}
+
+	want := "started; log before pause on 'agent-1:8888': logs/run_1/script_run_2_10_k6.log; "
+	if scriptRun.GetInfo() != want {
+		t.Errorf("moveLogArchiveKeyToInfo() info = %q, want %q", scriptRun.GetInfo(), want)
+	}
+}
@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+const (
+	// verdictSettleDuration пауза после остановки Run`а, чтобы последний дамп статистики успел сохраниться
+	verdictSettleDuration = 30 * time.Second
+	verdictMaxAge         = 24 * time.Hour
+)
+
+// evaluatingRunVerdicts сравнение завершенных Run`ов с базовым Run`ом сценария.
+// Вердикт сохраняется один раз(уникальный run_id), поэтому при нескольких подах повторной записи не будет
+func (p *ProcessorPool) evaluatingRunVerdicts(ctx context.Context) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "evaluatingRunVerdicts failed: '%+v'", err)
+		}
+	}()
+
+	mRuns, err := p.db.GetMRunsAwaitingVerdict(ctx, verdictSettleDuration, verdictMaxAge)
+	if err != nil {
+		logger.Errorf(ctx, "evaluatingRunVerdicts: '%+v'", err)
+
+		return
+	}
+
+	for _, mRun := range mRuns {
+		runVerdict, message := processing.EvaluateRunVerdict(ctx, mRun, p.dbPB)
+		if message != "" {
+			logger.Warnf(ctx, "Run{%v} verdict message: '%v'", mRun.RunID, message)
+
+			continue
+		}
+
+		logger.Infof(ctx, "Run{%v} %v", mRun.RunID, runVerdict.GetInfo())
+	}
+}
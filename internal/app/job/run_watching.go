@@ -0,0 +1,56 @@
+package job
+
+import (
+	"context"
+	"strconv"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+// publishingWatchedRuns рассылка состояния Run`ов, на которые есть подписчики в этой реплике.
+// Изменения, сделанные другими репликами, подписчики получают отсюда
+func (p *ProcessorPool) publishingWatchedRuns(ctx context.Context) {
+	for _, runID := range p.runWatcher.WatchedRunIDs() {
+		run, message := p.dbPB.GetRunning(ctx, runID)
+		if message != "" {
+			logger.Warnf(ctx, "Watching run '%v': '%v'", runID, message)
+
+			continue
+		}
+
+		p.runWatcher.PublishRun(run)
+	}
+}
+
+// publishingMetrics рассылка метрик собранного дампа по ScriptRun`ам
+func (p *ProcessorPool) publishingMetrics(statisticsD *dumpStat) {
+	statisticsD.RLock()
+	defer statisticsD.RUnlock()
+
+	for _, statistic := range statisticsD.mapStat {
+		for i, runID := range statistic.RunIds {
+			if i >= len(statistic.ScriptRunIds) {
+				break
+			}
+
+			//nolint:gosec
+			p.runWatcher.PublishMetrics(int32(runID), int32(statistic.ScriptRunIds[i]), statisticToMetrics(statistic))
+		}
+	}
+}
+
+func statisticToMetrics(statistic *models.Statistic) *pb.Metrics {
+	return &pb.Metrics{
+		Rps:      strconv.Itoa(int(statistic.RPS)),
+		Rt90P:    strconv.Itoa(int(statistic.RT90P)),
+		Rt95P:    strconv.Itoa(int(statistic.RT95P)),
+		Rt99P:    strconv.Itoa(int(statistic.RT99P)),
+		RtMax:    strconv.Itoa(int(statistic.RTMax)),
+		Failed:   int64(statistic.Failed),
+		Vus:      strconv.Itoa(int(statistic.Vus)),
+		Sent:     strconv.Itoa(int(statistic.DataSent)),
+		Received: strconv.Itoa(int(statistic.DataReceived)),
+	}
+}
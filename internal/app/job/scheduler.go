@@ -0,0 +1,123 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+const schedulerContextKey = "_scheduler"
+
+// Scheduler запуск сценариев по расписанию.
+// Если задан SCHEDULER_HOST, то расписания обрабатываются только на этом хосте,
+// иначе на всех подах(повторный запуск исключается захватом расписания в БД)
+func Scheduler(ctx context.Context, store *datapb.Store) {
+	ctx = undecided.NewContextWithMarker(ctx, schedulerContextKey, "")
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "Scheduler failed: '%+v'", err)
+		}
+	}()
+
+	cfg := config.Get(ctx)
+	if cfg.SchedulerHost != "" && cfg.SchedulerHost != "-" && cfg.SchedulerHost != cfg.Hostname {
+		logger.Warnf(ctx, "Scheduler is disabled on this host('%v'), SchedulerHost: '%v'",
+			cfg.Hostname, cfg.SchedulerHost)
+
+		return
+	}
+
+	logger.Infof(ctx, "Scheduler started: '%v'", cfg.JobSchedulerFrequency)
+
+	for range time.Tick(cfg.JobSchedulerFrequency) {
+		runScheduledScenarios(ctx, store)
+	}
+}
+
+func runScheduledScenarios(ctx context.Context, store *datapb.Store) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "runScheduledScenarios failed: '%+v'", err)
+		}
+	}()
+
+	now := time.Now().UTC()
+
+	mSchedules, err := store.GetDataStore().GetMSchedulesToRun(ctx, now)
+	if err != nil {
+		logger.Errorf(ctx, "Scheduler error getting schedules: '%+v'", err)
+
+		return
+	}
+
+	for _, mSchedule := range mSchedules {
+		runSchedule(ctx, mSchedule, now, store)
+	}
+}
+
+// runSchedule захват расписания и создание команды на запуск сценария, аналогично ScenarioToRunning
+func runSchedule(ctx context.Context, mSchedule *models.Schedule, now time.Time, store *datapb.Store) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "runSchedule failed: '%+v'", err)
+		}
+	}()
+
+	dataStore := store.GetDataStore()
+
+	// разовое расписание после запуска выключается
+	var nextRunAt null.Time
+	enabled := mSchedule.Cron != ""
+	if enabled {
+		var err error
+		nextRunAt, err = processing.NextScheduleRun(mSchedule.Cron, mSchedule.RunAt, now)
+		if err != nil {
+			logger.Errorf(ctx, "Schedule '%v' next run error: '%v'", mSchedule.ScheduleID, err)
+			enabled = false
+		}
+	}
+
+	claimed, err := dataStore.ClaimMSchedule(ctx, mSchedule, nextRunAt, enabled, now)
+	if err != nil {
+		logger.Errorf(ctx, "Schedule '%v' claim error: '%+v'", mSchedule.ScheduleID, err)
+
+		return
+	}
+
+	if !claimed {
+		logger.Infof(ctx, "Schedule '%v' already claimed by another host", mSchedule.ScheduleID)
+
+		return
+	}
+
+	logger.Infof(ctx, "Running scheduled scenario(ScheduleID:'%v'; ScenarioID:'%v'; percentageOfTarget:'%v')",
+		mSchedule.ScheduleID, mSchedule.ScenarioID, mSchedule.PercentageOfTarget)
+
+	run, message := processing.ScenarioToRunning(ctx,
+		mSchedule.ScenarioID,
+		mSchedule.PercentageOfTarget,
+		mSchedule.UserName,
+		mSchedule.PreferredUserName,
+		store,
+	)
+	if run == nil && message == "" {
+		message = fmt.Sprintf("Scenario '%v' has not been started", mSchedule.ScenarioID)
+	}
+
+	if message != "" {
+		logger.Warnf(ctx, "Scheduled run of scenario '%v' message: '%v'", mSchedule.ScenarioID, message)
+	}
+
+	err = dataStore.UpdateMScheduleLastRun(ctx, mSchedule.ScheduleID, run.GetRunId(), message)
+	if err != nil {
+		logger.Errorf(ctx, "Schedule '%v' update last run error: '%+v'", mSchedule.ScheduleID, err)
+	}
+}
@@ -0,0 +1,125 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// failoverTimeout на запуск ScriptRun`а на другом агенте, не зависит от таймаута обновления статуса
+	failoverTimeout = time.Minute
+	// failoverEndSlack ScriptRun, пропавший с агента ближе этого к концу длительности, считается завершенным
+	failoverEndSlack = 30 * time.Second
+)
+
+// failoverScriptRun перезапуск ScriptRun`а потерянного агента на другом агенте с тем же тегом и тем же RPS,
+// если у сценария включен failover и не исчерпан лимит перезапусков. true - ScriptRun снова запущен
+func (p *ProcessorPool) failoverScriptRun(ctx context.Context, scriptRun *pb.ScriptRun, cause string,
+	agentAlive bool) bool {
+	scenarioID := scriptRun.GetScript().GetScenarioId()
+	if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+		scenarioID = scriptRun.GetSimpleScript().GetScenarioId()
+	}
+
+	scenario, message := p.dbPB.GetScenario(ctx, scenarioID)
+	if message != "" || !scenario.GetFailoverEnabled() {
+		return false
+	}
+
+	maxFailovers := scenario.GetMaxFailovers()
+	if maxFailovers <= 0 {
+		maxFailovers = config.Get(ctx).ScriptRunMaxFailovers
+	}
+
+	if scriptRun.GetFailoverCount() >= maxFailovers {
+		mess := fmt.Sprintf("failover limit %v is reached", maxFailovers)
+		if !strings.Contains(scriptRun.Info, mess) {
+			scriptRun.Info = fmt.Sprintf("%s %s; ", scriptRun.Info, mess)
+		}
+
+		return false
+	}
+
+	// обновление статуса ограничено по времени, перезапуск на другом агенте выполняется со своим таймаутом
+	failoverCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), failoverTimeout)
+	defer cancel()
+
+	scriptRun.FailoverCount++
+	reason := fmt.Sprintf("failover %v/%v: %v", scriptRun.GetFailoverCount(), maxFailovers, cause)
+
+	err := p.migrateScriptRun(failoverCtx, scriptRun, reason, agentAlive)
+	if errors.Is(err, errScriptRunMigrated) {
+		// ScriptRun перезапустила другая реплика, устаревшее состояние заменяется сохраненным в БД
+		actual, mes := p.dbPB.GetScriptRunning(ctx, scriptRun.GetRunId(), scriptRun.GetRunScriptId())
+		if mes != "" || actual == nil {
+			logger.Warnf(ctx, "Failover ScriptRun %v/%v: '%v'", scriptRun.GetRunId(), scriptRun.GetRunScriptId(), mes)
+
+			return false
+		}
+
+		proto.Reset(scriptRun)
+		proto.Merge(scriptRun, actual)
+
+		return true
+	} else if err != nil {
+		logger.Warnf(ctx, "Failover ScriptRun %v/%v: '%v'", scriptRun.GetRunId(), scriptRun.GetRunScriptId(), err)
+		// неудачный запуск на другом агенте migrateScriptRun уже записал в Info
+		if !strings.Contains(scriptRun.Info, reason) {
+			scriptRun.Info = fmt.Sprintf("%s %s failed: %v; ", scriptRun.Info, reason, err)
+		}
+
+		return false
+	}
+
+	return scriptRun.GetStatus() == pb.ScriptRun_STATUS_RUNNING
+}
+
+// agentQuarantined агент в карантине(AgentsTracker не смог проверить его AgentQuarantineFailures раз подряд)
+// или удален. Единичная ошибка вызова - не повод переносить скрипт: он может продолжать работать на агенте
+func (p *ProcessorPool) agentQuarantined(ctx context.Context, agent *pb.Agent) bool {
+	mAgent, err := p.db.GetMAgentByHost(ctx, agent.GetHostName(), agent.GetPort())
+	if err != nil {
+		logger.Warnf(ctx, "Agent '%v:%v' quarantine check: '%v'", agent.GetHostName(), agent.GetPort(), err)
+
+		return false
+	}
+
+	return mAgent == nil || mAgent.Quarantined
+}
+
+// isAgentUnreachable агент не ответил с учетом повторов вызова
+func isAgentUnreachable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isEndedEarly ScriptRun пропал с агента до окончания своей длительности(агент перезапущен или процесс убит)
+func isEndedEarly(scriptRun *pb.ScriptRun) bool {
+	duration := scriptRun.GetScript().GetOptions().GetDuration()
+	if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+		duration = scriptRun.GetSimpleScript().GetDuration()
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil || d <= 0 || scriptRun.GetMetrics().GetCurrentTestRunDuration() == nil {
+		return false
+	}
+
+	slack := max(d/20, failoverEndSlack)
+
+	return scriptRun.GetMetrics().GetCurrentTestRunDuration().AsDuration() < d-slack
+}
@@ -0,0 +1,198 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/internal/app/util"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+	"google.golang.org/protobuf/proto"
+)
+
+// orphanedScriptRunTTL после этого срока скрипт на вернувшемся агенте не ищется: он завершился по длительности
+const orphanedScriptRunTTL = 24 * time.Hour
+
+// errScriptRunMigrated перенос запуска ScriptRun`а уже захвачен другой репликой, ScriptRun в памяти устарел
+var errScriptRunMigrated = errors.New("the script run is already migrated by another replica")
+
+// migrateScriptRun перезапуск выполняющегося ScriptRun`а на другом агенте с тем же тегом.
+// Если свободного агента нет, ScriptRun остается на прежнем агенте. Причина переноса записывается в ScriptRun.Info.
+// agentAlive=true - скрипт сначала запускается на новом агенте, прежний останавливается после успешного запуска;
+// agentAlive=false - прежний агент не отвечает: скрипт останавливается до запуска с ограничением по времени,
+// лог не архивируется.
+// Перенос одного запуска(pid) выполняет только одна реплика - захватившая его в script_run_migrations
+func (p *ProcessorPool) migrateScriptRun(ctx context.Context, scriptRun *pb.ScriptRun, reason string,
+	agentAlive bool) error {
+	pbRun, message := p.dbPB.GetRunning(ctx, scriptRun.GetRunId())
+	if message != "" {
+		return fmt.Errorf("get running '%v': %v", scriptRun.GetRunId(), message)
+	}
+
+	tag, scriptName := scriptRun.GetScript().GetTag(), scriptRun.GetScript().GetName()
+	if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+		tag, scriptName = scriptRun.GetSimpleScript().GetTag(), scriptRun.GetSimpleScript().GetName()
+	}
+
+	tag, err := util.CheckingTagForPresenceInDB(ctx, tag, p.db)
+	if err != nil {
+		return err
+	}
+
+	oldHost := undecided.GetPBHost(scriptRun.GetAgent())
+
+	agentHost, err := p.agentManager.GetAFreeAgent(ctx, tag)
+	if err != nil {
+		return fmt.Errorf("no agent to migrate ScriptRun '%v' from '%v': %w", scriptRun.GetRunScriptId(), oldHost, err)
+	} else if undecided.GetPBHost(agentHost) == oldHost {
+		return fmt.Errorf("no other agent to migrate ScriptRun '%v' from '%v'", scriptRun.GetRunScriptId(), oldHost)
+	}
+
+	// перенос захватывается в БД: агенты проверяются и Run`ы обновляются на нескольких репликах,
+	// без захвата каждая из них перезапустила бы скрипт
+	mMigration := &models.ScriptRunMigration{
+		RunID:       scriptRun.GetRunId(),
+		RunScriptID: scriptRun.GetRunScriptId(),
+		Pid:         int32(scriptRun.GetPid()), //nolint:gosec
+		AgentHost:   oldHost,
+		Reason:      reason,
+		Owner:       config.Get(ctx).Hostname,
+	}
+
+	claimed, err := p.db.ClaimMScriptRunMigration(ctx, mMigration)
+	if err != nil {
+		return err
+	} else if !claimed {
+		return errScriptRunMigrated
+	}
+
+	if !agentAlive {
+		// скрипт останавливается и на не отвечающем агенте, иначе после его возвращения цель получит двойную нагрузку.
+		// Не остановленный скрипт остановит AgentsTracker, когда агент снова начнет отвечать
+		// (stoppingOrphanedScriptRuns)
+		p.stoppingMigratedScriptRun(ctx, mMigration)
+	}
+
+	previous := proto.Clone(scriptRun).(*pb.ScriptRun)
+
+	moveLogArchiveKeyToInfo(scriptRun, fmt.Sprintf("log on '%s'", oldHost))
+
+	scriptRun.Agent = agentHost
+	task := p.prepareTaskToRun(ctx, scriptRun, pbRun.GetTitle())
+
+	// профиль нагрузки(STAGES) не передается: перезапущенный скрипт сразу выходит на текущий RPS
+	rs, err := p.startScriptRunOnAgent(ctx, scriptRun, task, scriptName, nil)
+	switch {
+	case err != nil && agentAlive:
+		// прежний агент отвечает и еще не остановлен: скрипт остается на нем, перенос можно повторить
+		proto.Reset(scriptRun)
+		proto.Merge(scriptRun, previous)
+		scriptRun.Info = fmt.Sprintf("%s migration from '%s' (%s) failed, left on the agent: %v; ",
+			scriptRun.Info, oldHost, reason, err)
+
+		if er := p.db.DeleteMScriptRunMigration(ctx, mMigration); er != nil {
+			logger.Errorf(ctx, "Migrated ScriptRun %v/%v: '%+v'", mMigration.RunID, mMigration.RunScriptID, er)
+		}
+	case err != nil:
+		scriptRun.Status = pb.ScriptRun_STATUS_FAILED
+		scriptRun.Info = fmt.Sprintf("%s migration from '%s' (%s) failed: %v; ", scriptRun.Info, oldHost, reason, err)
+	default:
+		agentMessage := p.processingTheResponseFromTheAgent(ctx, rs, scriptRun)
+		if statusMessage := p.setTheScriptRunStatusRunning(ctx, agentMessage, 0, scriptRun); statusMessage != "" {
+			logger.Errorf(ctx, "setTheScriptRunStatusRunning '%v'", statusMessage)
+		}
+
+		if agentAlive {
+			// прежний агент останавливается только после запуска на новом, чтобы цель не оставалась без нагрузки
+			p.stoppingMigratedScriptRun(ctx, mMigration)
+
+			// лог на прежнем агенте сохраняется, у перезапущенного скрипта будет свой лог.
+			// Ключ уже архивированного лога перенесен в Info до запуска
+			if previous.GetLogArchiveKey() == "" {
+				processing.ArchiveScriptRunLog(ctx, previous, p.agentManager, p.dbPB)
+
+				if previous.GetLogArchiveKey() != "" {
+					scriptRun.Info = fmt.Sprintf("%s log on '%s': %s; ",
+						scriptRun.Info, oldHost, previous.GetLogArchiveKey())
+				}
+			}
+		}
+
+		scriptRun.Info = fmt.Sprintf("%s migrated from '%s' to '%s' (%s); ",
+			scriptRun.Info, oldHost, undecided.GetPBHost(agentHost), reason)
+	}
+
+	logger.Warnf(ctx, "ScriptRun %v/%v: %v", scriptRun.GetRunId(), scriptRun.GetRunScriptId(), scriptRun.Info)
+
+	if message = p.dbPB.UpdatePbScriptRunInDB(ctx, scriptRun.GetRunId(), scriptRun); message != "" {
+		return fmt.Errorf("update ScriptRun '%v': %v", scriptRun.GetRunScriptId(), message)
+	}
+
+	return err
+}
+
+// stoppingMigratedScriptRun остановка перенесенного скрипта на прежнем агенте, true - скрипта на агенте больше нет
+func (p *ProcessorPool) stoppingMigratedScriptRun(ctx context.Context, mMigration *models.ScriptRunMigration) bool {
+	stopCtx, cancel := context.WithTimeout(ctx, config.Get(ctx).AgentProbeTimeout)
+	defer cancel()
+
+	err := p.agentManager.Stop(stopCtx, mMigration.AgentHost, int64(mMigration.Pid))
+	if err != nil && !strings.Contains(err.Error(), "no such test run") {
+		logger.Warnf(ctx, "Migrated ScriptRun %v/%v: stop pid '%v' on '%v' error: '%v'",
+			mMigration.RunID, mMigration.RunScriptID, mMigration.Pid, mMigration.AgentHost, err)
+
+		return false
+	}
+
+	if err = p.db.SetMScriptRunMigrationAgentStopped(ctx, mMigration); err != nil {
+		logger.Errorf(ctx, "Migrated ScriptRun %v/%v: '%+v'", mMigration.RunID, mMigration.RunScriptID, err)
+	}
+
+	return true
+}
+
+// stoppingOrphanedScriptRuns остановка скриптов, перенесенных с не отвечавших агентов, когда агенты снова отвечают
+func (p *ProcessorPool) stoppingOrphanedScriptRuns(ctx context.Context, agents []*models.Agent) {
+	healthy := make(map[string]bool, len(agents))
+	for _, mAgent := range agents {
+		if mAgent.Enabled && !mAgent.Quarantined && mAgent.FailureCount == 0 {
+			healthy[undecided.GetMHost(mAgent)] = true
+		}
+	}
+
+	mMigrations, err := p.db.GetMScriptRunMigrationsAgentNotStopped(ctx, time.Now().UTC().Add(-orphanedScriptRunTTL))
+	if err != nil {
+		logger.Errorf(ctx, "Stopping orphaned script runs: '%+v'", err)
+
+		return
+	}
+
+	for _, mMigration := range mMigrations {
+		if !healthy[mMigration.AgentHost] {
+			continue
+		}
+
+		if p.stoppingMigratedScriptRun(ctx, mMigration) {
+			logger.Warnf(ctx, "Orphaned ScriptRun %v/%v pid '%v' is stopped on '%v'",
+				mMigration.RunID, mMigration.RunScriptID, mMigration.Pid, mMigration.AgentHost)
+		}
+	}
+}
+
+// moveLogArchiveKeyToInfo ключ архива лога прошлого запуска переносится в Info:
+// у перезапущенного скрипта свой лог, и его архив не должен пропускаться из-за старого ключа
+func moveLogArchiveKeyToInfo(scriptRun *pb.ScriptRun, note string) {
+	if scriptRun.GetLogArchiveKey() == "" {
+		return
+	}
+
+	scriptRun.Info = fmt.Sprintf("%s %s: %s; ", scriptRun.Info, note, scriptRun.GetLogArchiveKey())
+	scriptRun.LogArchiveKey = ""
+}
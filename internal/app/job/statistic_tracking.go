@@ -2,13 +2,11 @@ package job
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
 
-	agentapi2 "github.com/aliexpressru/alilo-backend/pkg/model/agentapi"
-	"github.com/aliexpressru/alilo-backend/pkg/util/httputil"
+	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
@@ -41,6 +39,9 @@ func StatisticTracker(ctx context.Context, p *ProcessorPool) {
 	dStat = cfg.JobStatisticTrackingFrequency
 	for range time.Tick(dStat) {
 		logger.Info(ctx, "Start StatisticTracker")
+		p.evaluatingRunVerdicts(ctx)
+		p.publishingWatchedRuns(ctx)
+
 		countRunsByStatusRunning, err := p.db.GetCountRunsByStatus(ctx, pb.Run_STATUS_RUNNING)
 		if err != nil {
 			logger.Warnf(ctx, "Error getting the number of running tests: %v", err)
@@ -61,10 +62,12 @@ type dumpStat struct {
 	sync.RWMutex
 	ID      int32
 	mapStat map[int64]*models.Statistic
-	traces  []*models.Trace
+	// накопленное число запросов(итераций) скрипт-рана по pid, для расчета доли ошибок за интервал
+	requests map[int64]int64
+	traces   []*models.Trace
 }
 
-func (ds *dumpStat) appendStatus(ctx context.Context, resp *agentapi2.ResponseGetStatus, agentHostName string) {
+func (ds *dumpStat) appendStatus(ctx context.Context, resp *agentapi.GetStatusResponse, agentHostName string) {
 	if resp == nil || resp.Metrics == nil {
 		return
 	}
@@ -97,35 +100,39 @@ func (ds *dumpStat) appendStatus(ctx context.Context, resp *agentapi2.ResponseGe
 
 			RPS:   mathutil.Int32Fm(resp.Metrics.Rps),
 			RTMax: mathutil.Int32Fm(resp.Metrics.RtMax),
-			RT90P: mathutil.Int32Fm(resp.Metrics.Rt90P),
-			RT95P: mathutil.Int32Fm(resp.Metrics.Rt95P),
-			RT99P: mathutil.Int32Fm(resp.Metrics.Rt99P),
+			RT90P: mathutil.Int32Fm(resp.Metrics.Rt_90P),
+			RT95P: mathutil.Int32Fm(resp.Metrics.Rt_95P),
+			RT99P: mathutil.Int32Fm(resp.Metrics.Rt_99P),
 
 			//nolint:gosec
-			Failed: mathutil.Int32Fm(resp.Metrics.Failed),
-			Vus:    mathutil.Int32Fm(resp.Metrics.Vus),
+			Failed: int32(resp.Metrics.Failed),
+			//nolint:gosec
+			Requests: int32(resp.Metrics.FullIterationCount),
+			Vus:      mathutil.Int32Fm(resp.Metrics.Vus),
 
-			DataSent:     mathutil.Int32Fm(resp.Metrics.Sent),
-			DataReceived: mathutil.Int32Fm(resp.Metrics.Received),
+			DataSent:     mathutil.Int32Fm(resp.Metrics.DataSent),
+			DataReceived: mathutil.Int32Fm(resp.Metrics.DataReceived),
 
 			//CurrentTestRunDuration: strconv.FormatInt(resp.Metrics.CurrentTestRunDuration.AsDuration().Nanoseconds(), 10),
 			Agents: []string{agentHostName},
 		}
 		ds.mapStat[key] = curStat
+		//nolint:gosec
+		ds.requests[key] = int64(resp.Metrics.FullIterationCount)
 	} else {
 		// если запись есть → объединяем
 		statVal.RPS += mathutil.Int32Fm(resp.Metrics.Rps)
 		statVal.RTMax = max(statVal.RTMax, mathutil.Int32Fm(resp.Metrics.RtMax))
-		statVal.RT90P = max(statVal.RT90P, mathutil.Int32Fm(resp.Metrics.Rt90P))
-		statVal.RT95P = max(statVal.RT95P, mathutil.Int32Fm(resp.Metrics.Rt95P))
-		statVal.RT99P = max(statVal.RT99P, mathutil.Int32Fm(resp.Metrics.Rt99P))
+		statVal.RT90P = max(statVal.RT90P, mathutil.Int32Fm(resp.Metrics.Rt_90P))
+		statVal.RT95P = max(statVal.RT95P, mathutil.Int32Fm(resp.Metrics.Rt_95P))
+		statVal.RT99P = max(statVal.RT99P, mathutil.Int32Fm(resp.Metrics.Rt_99P))
 
 		//nolint:gosec
 		//statVal.Failed += (resp.Metrics.Failed)
 
 		statVal.Vus += mathutil.Int32Fm(resp.Metrics.Vus)
-		statVal.DataSent += mathutil.Int32Fm(resp.Metrics.Sent)
-		statVal.DataReceived += mathutil.Int32Fm(resp.Metrics.Received)
+		statVal.DataSent += mathutil.Int32Fm(resp.Metrics.DataSent)
+		statVal.DataReceived += mathutil.Int32Fm(resp.Metrics.DataReceived)
 
 		// апдейт продолжительности (берём макс)
 		//statVal.CurrentTestRunDuration = strconv.FormatInt(
@@ -177,8 +184,9 @@ func (p *ProcessorPool) CollectingDump(ctx context.Context) {
 	}
 	logger.Infof(ctx, "CreateMStatisticDump ID{%v}", mStatisticDump.StatisticDumpID)
 	statisticsD := &dumpStat{
-		mapStat: make(map[int64]*models.Statistic, 0),
-		ID:      mStatisticDump.StatisticDumpID,
+		mapStat:  make(map[int64]*models.Statistic, 0),
+		requests: make(map[int64]int64),
+		ID:       mStatisticDump.StatisticDumpID,
 	}
 
 	dumpWaitGroup := &sync.WaitGroup{}
@@ -197,6 +205,11 @@ func (p *ProcessorPool) CollectingDump(ctx context.Context) {
 	dumpWaitGroup.Wait()
 	logger.Infof(ctx, "dumpGroup waited{%v}", statisticsD.ID)
 
+	runs, runsValues := p.linkingStatisticsToRuns(ctx, statisticsD)
+	p.publishingMetrics(statisticsD)
+	p.checkingStopConditions(ctx, runs, runsValues)
+	p.checkingCapacitySearches(ctx, runs, runsValues)
+
 	if len(statisticsD.mapStat) > 0 {
 		logger.Infof(ctx, "Load statID{%v}, traces{%v}", statisticsD.ID, len(statisticsD.traces))
 		logger.Infof(ctx, "Save the collected statistics{%v}", statisticsD.ID)
@@ -234,37 +247,19 @@ func (p *ProcessorPool) collectingStatistics(ctx context.Context,
 
 	defer undecided.InfoTimer(ctx, fmt.Sprintf("CollectingStatistics{%v}", agent.HostName))()
 
-	url := fmt.Sprintf("http://%s:%s", agent.HostName, agent.Port)
+	agentHost := undecided.GetMHost(agent)
 
-	b, err := httputil.Get(ctx, fmt.Sprintf("%s/api/v1/getAllTasks", url))
+	tasks, err := p.agentManager.GetAllTasks(ctx, agentHost)
 	if err != nil {
 		logger.Errorf(ctx, "get all tasks failed: '%+v'", err)
 		return
 	}
-	tasks := &agentapi2.ResponseGetAllTasks{}
-	err = json.Unmarshal(b, tasks)
-	if err != nil {
-		logger.Errorf(ctx, "failed unmarshal get all tasks response: '%+v'", err)
-		return
-	}
-	for pid := range tasks.Tasks {
-		var bytes []byte
-		bytes, err = httputil.Post(
-			ctx,
-			fmt.Sprintf("%s/api/v1/getStatus", url),
-			"application/json",
-			map[string]string{}, &agentapi2.GetStatusRequest{Pid: pid})
-		if err != nil {
-			logger.Errorf(ctx, "HTTP Start request failed: %v", err)
-			return
-		}
-
-		status := &agentapi2.ResponseGetStatus{}
+	for pid := range tasks.GetTasks() {
+		var status *agentapi.GetStatusResponse
 
-		err = json.Unmarshal(bytes, status)
+		status, err = p.agentManager.GetStatus(ctx, agentHost, pid)
 		if err != nil {
-			logger.Errorf(ctx, "HTTP get status request failed: %v", err)
-
+			logger.Errorf(ctx, "get status{%v} request failed: %v", pid, err)
 			return
 		}
 
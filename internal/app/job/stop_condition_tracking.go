@@ -0,0 +1,372 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/types"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// stopConditionUser пользователь команд остановки по условиям SLO
+const stopConditionUser = "stop-condition"
+
+// breachTracker хранит время первого нарушения условия остановки, ключ - runID:stopConditionID
+type breachTracker struct {
+	sync.Mutex
+	firstBreach map[string]time.Time
+}
+
+var breaches = &breachTracker{firstBreach: make(map[string]time.Time)}
+
+// since возвращает время с которого условие нарушается непрерывно
+func (b *breachTracker) since(key string, now time.Time) time.Time {
+	b.Lock()
+	defer b.Unlock()
+
+	if first, ok := b.firstBreach[key]; ok {
+		return first
+	}
+	b.firstBreach[key] = now
+
+	return now
+}
+
+func (b *breachTracker) reset(key string) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.firstBreach, key)
+}
+
+// cleanup удаление данных о Run`ах, которые уже не запущены
+func (b *breachTracker) cleanup(runningRunIDs map[int32]bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	for key := range b.firstBreach {
+		var runID, conditionID int32
+		if _, err := fmt.Sscanf(key, "%d:%d", &runID, &conditionID); err != nil || !runningRunIDs[runID] {
+			delete(b.firstBreach, key)
+		}
+	}
+}
+
+// requestCounters накопленные счетчики скрипт-ранов на прошлом сборе статистики, ключ - agentPidKey
+type requestCounters struct {
+	sync.Mutex
+	last map[string]counters
+}
+
+// counters счетчики k6 с начала работы скрипт-рана: неуспешные запросы и все запросы(итерации)
+type counters struct {
+	failed   int64
+	requests int64
+}
+
+var requestDeltas = &requestCounters{last: make(map[string]counters)}
+
+// delta прирост счетчиков с прошлого сбора статистики. Для первого сбора и после сброса счетчиков
+// (скрипт-ран перезапущен) приростом считаются текущие значения
+func (c *requestCounters) delta(key string, current counters) counters {
+	c.Lock()
+	defer c.Unlock()
+
+	previous, ok := c.last[key]
+	c.last[key] = current
+
+	if !ok || current.failed < previous.failed || current.requests < previous.requests {
+		return current
+	}
+
+	return counters{failed: current.failed - previous.failed, requests: current.requests - previous.requests}
+}
+
+// cleanup удаление счетчиков скрипт-ранов, которых нет в последнем сборе
+func (c *requestCounters) cleanup(collected map[string]bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	for key := range c.last {
+		if !collected[key] {
+			delete(c.last, key)
+		}
+	}
+}
+
+// sloValues агрегированные метрики одного дампа для сценария или скрипта
+type sloValues struct {
+	rps    int64
+	failed int64
+	// неуспешные и все запросы за интервал между сборами статистики
+	intervalFailed   int64
+	intervalRequests int64
+	rt90P            int32
+	rt95P            int32
+	rt99P            int32
+	rtMax            int32
+}
+
+func (v *sloValues) add(statistic *models.Statistic, interval counters) {
+	v.rps += int64(statistic.RPS)
+	v.failed += int64(statistic.Failed)
+	v.intervalFailed += interval.failed
+	v.intervalRequests += interval.requests
+	v.rt90P = max(v.rt90P, statistic.RT90P)
+	v.rt95P = max(v.rt95P, statistic.RT95P)
+	v.rt99P = max(v.rt99P, statistic.RT99P)
+	v.rtMax = max(v.rtMax, statistic.RTMax)
+}
+
+func (v *sloValues) value(metric string) float64 {
+	switch metric {
+	case models.StopMetricMETRIC_RT_90_P:
+		return float64(v.rt90P)
+	case models.StopMetricMETRIC_RT_99_P:
+		return float64(v.rt99P)
+	case models.StopMetricMETRIC_RT_MAX:
+		return float64(v.rtMax)
+	case models.StopMetricMETRIC_RPS:
+		return float64(v.rps)
+	case models.StopMetricMETRIC_FAILED:
+		return float64(v.failed)
+	case models.StopMetricMETRIC_FAILED_RATE:
+		// процент неуспешных запросов за интервал: накопленный failed нельзя сравнивать с текущим RPS
+		if v.intervalRequests <= 0 {
+			return 0
+		}
+
+		return min(float64(v.intervalFailed)*100/float64(v.intervalRequests), 100)
+	default:
+		return float64(v.rt95P)
+	}
+}
+
+// runSLOValues метрики Run`а: по всему сценарию и по каждому скрипту
+type runSLOValues struct {
+	scenario *sloValues
+	scripts  map[string]*sloValues
+}
+
+func scriptSLOKey(scriptID int32, simpleScript bool) string {
+	return fmt.Sprintf("%t:%d", simpleScript, scriptID)
+}
+
+func agentPidKey(hostName string, pid int64) string {
+	return fmt.Sprintf("%s:%d", hostName, pid)
+}
+
+// linkingStatisticsToRuns привязка собранной статистики к запущенным Run`ам по агенту и pid скрипт-рана
+func (p *ProcessorPool) linkingStatisticsToRuns(ctx context.Context, dumpS *dumpStat) (
+	runs []*pb.Run, values map[int32]*runSLOValues) {
+	values = make(map[int32]*runSLOValues)
+
+	countRunning, err := p.db.GetCountRunsByStatus(ctx, pb.Run_STATUS_RUNNING)
+	if err != nil || countRunning == 0 {
+		if err == nil {
+			requestDeltas.cleanup(nil)
+		}
+
+		return runs, values
+	}
+
+	//nolint:gosec
+	runs, _, message := p.dbPB.GetRunsByStatus(ctx, pb.Run_STATUS_RUNNING, int32(countRunning), 1)
+	if message != "" {
+		logger.Warnf(ctx, "Dump{%v} linking statistics, get running runs: '%v'", dumpS.ID, message)
+	}
+
+	scriptRuns := make(map[string]*pb.ScriptRun)
+	for _, run := range runs {
+		for _, scriptRun := range run.GetScriptRuns() {
+			if scriptRun.GetStatus() == pb.ScriptRun_STATUS_RUNNING {
+				scriptRuns[agentPidKey(scriptRun.GetAgent().GetHostName(), scriptRun.GetPid())] = scriptRun
+			}
+		}
+	}
+
+	dumpS.Lock()
+	defer dumpS.Unlock()
+
+	collected := make(map[string]bool, len(dumpS.mapStat))
+	defer requestDeltas.cleanup(collected)
+
+	for pid, statistic := range dumpS.mapStat {
+		if len(statistic.Agents) == 0 {
+			continue
+		}
+
+		key := agentPidKey(statistic.Agents[0], pid)
+
+		scriptRun, ok := scriptRuns[key]
+		if !ok {
+			continue
+		}
+
+		collected[key] = true
+		interval := requestDeltas.delta(key, counters{failed: int64(statistic.Failed), requests: dumpS.requests[pid]})
+
+		scriptID, projectID, scenarioID := scriptRun.GetScript().GetScriptId(),
+			scriptRun.GetScript().GetProjectId(), scriptRun.GetScript().GetScenarioId()
+		statistic.URLPath = scriptRun.GetScript().GetBaseUrl()
+		simpleScript := scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE
+		if simpleScript {
+			scriptID, projectID, scenarioID = scriptRun.GetSimpleScript().GetScriptId(),
+				scriptRun.GetSimpleScript().GetProjectId(), scriptRun.GetSimpleScript().GetScenarioId()
+			statistic.URLMethod = strings.ToUpper(scriptRun.GetSimpleScript().GetHttpMethod())
+			statistic.URLPath = scriptRun.GetSimpleScript().GetPath()
+		}
+
+		statistic.RunIds = types.Int64Array{int64(scriptRun.GetRunId())}
+		statistic.ScriptRunIds = types.Int64Array{int64(scriptRun.GetRunScriptId())}
+		statistic.ScriptIds = types.Int64Array{int64(scriptID)}
+		statistic.ProjectIds = types.Int64Array{int64(projectID)}
+		statistic.ScenarioIds = types.Int64Array{int64(scenarioID)}
+
+		runValues, ok := values[scriptRun.GetRunId()]
+		if !ok {
+			runValues = &runSLOValues{scenario: &sloValues{}, scripts: make(map[string]*sloValues)}
+			values[scriptRun.GetRunId()] = runValues
+		}
+
+		runValues.scenario.add(statistic, interval)
+
+		scriptKey := scriptSLOKey(scriptID, simpleScript)
+		if _, ok = runValues.scripts[scriptKey]; !ok {
+			runValues.scripts[scriptKey] = &sloValues{}
+		}
+		runValues.scripts[scriptKey].add(statistic, interval)
+	}
+
+	return runs, values
+}
+
+// checkingStopConditions проверка условий автоматической остановки(SLO) запущенных Run`ов.
+// Если условие нарушается дольше duration_sec, создается команда на остановку сценария,
+// а причина записывается в Run.Info
+func (p *ProcessorPool) checkingStopConditions(ctx context.Context,
+	runs []*pb.Run, values map[int32]*runSLOValues) {
+	defer func() {
+		if err := recover(); err != nil {
+			logger.Errorf(ctx, "checkingStopConditions failed: '%+v'", err)
+		}
+	}()
+
+	runningRunIDs := make(map[int32]bool, len(runs))
+	scenarioIDs := make([]int32, 0, len(runs))
+	for _, run := range runs {
+		runningRunIDs[run.GetRunId()] = true
+		scenarioIDs = append(scenarioIDs, run.GetScenarioId())
+	}
+	defer breaches.cleanup(runningRunIDs)
+
+	mStopConditions, err := p.db.GetEnabledMStopConditions(ctx, scenarioIDs)
+	if err != nil {
+		logger.Errorf(ctx, "checkingStopConditions: '%+v'", err)
+
+		return
+	}
+
+	now := time.Now()
+	for _, run := range runs {
+		runValues, ok := values[run.GetRunId()]
+		if !ok {
+			continue
+		}
+
+		for _, mStopCondition := range mStopConditions {
+			if mStopCondition.ScenarioID != run.GetScenarioId() {
+				continue
+			}
+
+			key := fmt.Sprintf("%d:%d", run.GetRunId(), mStopCondition.StopConditionID)
+
+			current, breached := stopConditionBreached(mStopCondition, runValues)
+			if !breached {
+				breaches.reset(key)
+
+				continue
+			}
+
+			breachedFor := now.Sub(breaches.since(key, now))
+			if breachedFor < time.Duration(mStopCondition.DurationSec)*time.Second {
+				logger.Infof(ctx, "Run{%v} stop condition{%v} is breached for %v",
+					run.GetRunId(), mStopCondition.StopConditionID, breachedFor)
+
+				continue
+			}
+
+			p.stoppingRunByStopCondition(ctx, run, stopConditionReason(mStopCondition, current, breachedFor))
+			breaches.reset(key)
+
+			break
+		}
+	}
+}
+
+// stopConditionBreached возвращает текущее значение метрики и признак нарушения условия
+func stopConditionBreached(mStopCondition *models.StopCondition, runValues *runSLOValues) (float64, bool) {
+	values := runValues.scenario
+	if mStopCondition.ScriptID != 0 {
+		var ok bool
+		values, ok = runValues.scripts[scriptSLOKey(mStopCondition.ScriptID, mStopCondition.SimpleScript)]
+		if !ok {
+			return 0, false
+		}
+	}
+
+	current := values.value(mStopCondition.Metric)
+	if mStopCondition.Operator == models.StopOperatorOPERATOR_LESS {
+		return current, current < mStopCondition.Threshold
+	}
+
+	return current, current > mStopCondition.Threshold
+}
+
+func stopConditionReason(mStopCondition *models.StopCondition, current float64, breachedFor time.Duration) string {
+	operator := ">"
+	if mStopCondition.Operator == models.StopOperatorOPERATOR_LESS {
+		operator = "<"
+	}
+
+	target := "scenario"
+	if mStopCondition.ScriptID != 0 {
+		target = fmt.Sprintf("script %v", mStopCondition.ScriptID)
+		if mStopCondition.SimpleScript {
+			target = fmt.Sprintf("simple script %v", mStopCondition.ScriptID)
+		}
+	}
+
+	return fmt.Sprintf("Stopped by stop condition{%v}: %v %v %v %v (current %.2f) for %v",
+		mStopCondition.StopConditionID, target, mStopCondition.Metric, operator, mStopCondition.Threshold,
+		current, breachedFor.Round(time.Second))
+}
+
+func (p *ProcessorPool) stoppingRunByStopCondition(ctx context.Context, run *pb.Run, reason string) {
+	// статистику собирают все поды, по этому Run мог быть уже остановлен другим
+	mRun, err := p.db.GetMRunning(ctx, run.GetRunId())
+	if err != nil || mRun.Status != models.EstatusSTATUS_RUNNING {
+		return
+	}
+
+	ctx = undecided.ContextWithUser(ctx, stopConditionUser)
+
+	logger.Warnf(ctx, "Run{%v} %v", run.GetRunId(), reason)
+
+	_, message := processing.ScenarioToStop(ctx, run.GetRunId(), p.db, p.dbPB)
+	if message != "" {
+		logger.Errorf(ctx, "Run{%v} stop by stop condition error: '%v'", run.GetRunId(), message)
+
+		return
+	}
+
+	if err = p.db.AppendMRunInfo(ctx, run.GetRunId(), reason); err != nil {
+		logger.Errorf(ctx, "Run{%v} save stop reason error: '%+v'", run.GetRunId(), err)
+	}
+}
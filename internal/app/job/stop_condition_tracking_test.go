@@ -0,0 +1,131 @@
+package job
+
+import (
+	"testing"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+)
+
+func TestRequestCounters_delta(t *testing.T) {
+	c := &requestCounters{last: make(map[string]counters)}
+
+	steps := []struct {
+		name    string
+		current counters
+		want    counters
+	}{
+		{name: "first collection", current: counters{failed: 5, requests: 100}, want: counters{failed: 5, requests: 100}},
+		{name: "growth", current: counters{failed: 7, requests: 300}, want: counters{failed: 2, requests: 200}},
+		{name: "no requests", current: counters{failed: 7, requests: 300}, want: counters{}},
+		{name: "restarted", current: counters{failed: 1, requests: 50}, want: counters{failed: 1, requests: 50}},
+	}
+	for _, step := range steps {
+		if got := c.delta("agent:1", step.current); got != step.want {
+			t.Fatalf("%v: delta() = %+v, want %+v", step.name, got, step.want)
+		}
+	}
+
+	c.cleanup(map[string]bool{"agent:2": true})
+
+	if got := c.delta("agent:1", counters{failed: 2, requests: 60}); got != (counters{failed: 2, requests: 60}) {
+		t.Errorf("delta() after cleanup = %+v, want current counters", got)
+	}
+}
+
+func TestSloValues_failedRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []counters
+		want      float64
+	}{
+		{name: "no requests", want: 0},
+		{name: "no failures", intervals: []counters{{requests: 1000}}, want: 0},
+		{name: "one script", intervals: []counters{{failed: 5, requests: 100}}, want: 5},
+		{name: "sum of scripts", intervals: []counters{{failed: 10, requests: 100}, {failed: 0, requests: 300}}, want: 2.5},
+		{name: "capped", intervals: []counters{{failed: 20, requests: 10}}, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := &sloValues{}
+			for _, interval := range tt.intervals {
+				// накопленный failed не влияет на долю ошибок за интервал
+				values.add(&models.Statistic{RPS: 10, Failed: 100000}, interval)
+			}
+
+			if got := values.value(models.StopMetricMETRIC_FAILED_RATE); got != tt.want {
+				t.Errorf("value(FAILED_RATE) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStopConditionBreached(t *testing.T) {
+	scenario := &sloValues{}
+	scenario.add(&models.Statistic{RPS: 100, RT95P: 300, Failed: 4}, counters{failed: 4, requests: 100})
+
+	script := &sloValues{}
+	script.add(&models.Statistic{RPS: 40, RT95P: 900}, counters{requests: 40})
+
+	runValues := &runSLOValues{
+		scenario: scenario,
+		scripts:  map[string]*sloValues{scriptSLOKey(7, true): script},
+	}
+
+	tests := []struct {
+		name          string
+		stopCondition *models.StopCondition
+		wantCurrent   float64
+		wantBreached  bool
+	}{
+		{
+			name: "rt95p greater",
+			stopCondition: &models.StopCondition{
+				Metric: models.StopMetricMETRIC_RT_95_P_UNSPECIFIED, Operator: models.StopOperatorOPERATOR_GREATER_UNSPECIFIED,
+				Threshold: 200,
+			},
+			wantCurrent:  300,
+			wantBreached: true,
+		},
+		{
+			name: "rps less is not breached",
+			stopCondition: &models.StopCondition{
+				Metric: models.StopMetricMETRIC_RPS, Operator: models.StopOperatorOPERATOR_LESS, Threshold: 50,
+			},
+			wantCurrent: 100,
+		},
+		{
+			name: "failed rate",
+			stopCondition: &models.StopCondition{
+				Metric: models.StopMetricMETRIC_FAILED_RATE, Operator: models.StopOperatorOPERATOR_GREATER_UNSPECIFIED,
+				Threshold: 3,
+			},
+			wantCurrent:  4,
+			wantBreached: true,
+		},
+		{
+			name: "simple script",
+			stopCondition: &models.StopCondition{
+				ScriptID: 7, SimpleScript: true, Metric: models.StopMetricMETRIC_RT_95_P_UNSPECIFIED,
+				Operator: models.StopOperatorOPERATOR_GREATER_UNSPECIFIED, Threshold: 500,
+			},
+			wantCurrent:  900,
+			wantBreached: true,
+		},
+		{
+			name: "script without statistics",
+			stopCondition: &models.StopCondition{
+				ScriptID: 7, Metric: models.StopMetricMETRIC_RT_95_P_UNSPECIFIED,
+				Operator: models.StopOperatorOPERATOR_GREATER_UNSPECIFIED, Threshold: 500,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, breached := stopConditionBreached(tt.stopCondition, runValues)
+			if current != tt.wantCurrent || breached != tt.wantBreached {
+				t.Errorf("stopConditionBreached() = %v, %v, want %v, %v",
+					current, breached, tt.wantCurrent, tt.wantBreached)
+			}
+		})
+	}
+}
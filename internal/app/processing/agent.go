@@ -3,25 +3,63 @@ package processing
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"regexp"
 	"sync"
+	"time"
 
 	v1 "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
 
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	dataPb "github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
 	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
 )
 
 // var logger = zap.S()
 
-func UpdateAgent(ctx context.Context, agent *pb.Agent, db *dataPb.Store) (status bool, message string) {
+// UpdateAgent обновление свойств агента. Выключение агента с запущенными скриптами
+// заменяется выводом на обслуживание(draining): агент выключится, когда скрипты доработают
+func UpdateAgent(ctx context.Context, agent *pb.Agent, db *dataPb.Store, mdb *data.Store, am *agent.Manager) (
+	status bool, message string) {
 	logger.Infof(ctx, "Update agent(AgentId:'%v'; Agent:'%+v')", agent.AgentId, agent)
 	if len(agent.Tags) == 0 {
 		agent.Tags = []string{""}
 	}
+
+	if !agent.GetEnabled() {
+		mAgent, err := mdb.GetMAgent(ctx, agent.GetAgentId())
+		if err != nil {
+			return false, fmt.Sprintf("Select agent error: '%v'", err)
+		}
+
+		running := am.CountRunningScriptRuns(ctx, undecided.GetMHost(mAgent))
+		if mAgent.Enabled && running > 0 {
+			if err = startDraining(ctx, mAgent, mAgent.DrainMigrate, mdb); err != nil {
+				return false, err.Error()
+			}
+
+			agent.Enabled = true
+			message = fmt.Sprintf("Agent '%v' has %v running script runs, it is draining and will be disabled once it is empty",
+				undecided.GetMHost(mAgent), running)
+			logger.Warnf(ctx, message)
+		} else if mAgent.Draining {
+			// выключенный агент не выводится на обслуживание, иначе после включения он не получит скрипты
+			mAgent.Draining = false
+			mAgent.DrainMigrate = false
+			mAgent.DrainStartedAt = null.Time{}
+
+			if err = mdb.UpdateMAgentDrain(ctx, mAgent); err != nil {
+				return false, err.Error()
+			}
+		}
+	}
 	agent, err := db.UpdatePbAgent(ctx, agent)
 	if err != nil {
 		message = err.Error()
@@ -58,6 +96,154 @@ func DeleteAgent(ctx context.Context, agentID int32, db *data.Store) (status boo
 	return true, message
 }
 
+const maxAgentTags = 16
+
+// agentTagPattern тег саморегистрируемого агента: латиница, цифры, '-', '_', '.'
+var agentTagPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{0,64}$`)
+
+// checkRegistrationSecret без AGENT_REGISTRATION_SECRET проверка не выполняется
+func checkRegistrationSecret(ctx context.Context, secret string) string {
+	expected := config.Get(ctx).AgentRegistrationSecret
+	if expected == "" {
+		return ""
+	}
+
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(expected)) != 1 {
+		return "Invalid registration secret"
+	}
+
+	return ""
+}
+
+func checkAgentTags(tags []string) string {
+	if len(tags) > maxAgentTags {
+		return fmt.Sprintf("Too many tags: %v, maximum %v", len(tags), maxAgentTags)
+	}
+
+	for _, tag := range tags {
+		if !agentTagPattern.MatchString(tag) {
+			return fmt.Sprintf("Invalid tag '%v': only latin letters, digits, '-', '_', '.' up to 64 characters", tag)
+		}
+	}
+
+	return ""
+}
+
+// RegisterAgent регистрация агента по адресу: новый агент создается включенным, если агент прислал секрет регистрации,
+// иначе - выключенным до включения пользователем. У известного агента обновляются теги, версия и лимит скриптов
+// (включенность задает пользователь)
+func RegisterAgent(ctx context.Context, request *pb.RegisterAgentRequest, db *data.Store, am *agent.Manager) (
+	agentID int32, message string) {
+	if request.GetHostName() == "" || request.GetPort() == "" {
+		return 0, "Host name and port are required"
+	}
+
+	if message = checkRegistrationSecret(ctx, request.GetRegistrationSecret()); message != "" {
+		logger.Warnf(ctx, "RegisterAgent '%v:%v': %v", request.GetHostName(), request.GetPort(), message)
+
+		return 0, message
+	}
+
+	if message = checkAgentTags(request.GetTags()); message != "" {
+		return 0, message
+	}
+
+	tags := request.GetTags()
+	if len(tags) == 0 {
+		tags = []string{""}
+	}
+
+	mAgent, err := db.GetMAgentByHost(ctx, request.GetHostName(), request.GetPort())
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	now := null.TimeFrom(time.Now())
+	if mAgent == nil {
+		mAgent = &models.Agent{
+			HostName:        request.GetHostName(),
+			Port:            request.GetPort(),
+			Enabled:         config.Get(ctx).AgentRegistrationSecret != "",
+			Tags:            tags,
+			Version:         request.GetVersion(),
+			MaxScripts:      request.GetMaxScripts(),
+			SelfRegistered:  true,
+			LastHeartbeatAt: now,
+		}
+
+		agentID, err = db.SetMAgent(ctx, mAgent)
+		if err != nil {
+			return 0, err.Error()
+		}
+
+		logger.Infof(ctx, "Agent '%v:%v' is registered: %v", mAgent.HostName, mAgent.Port, agentID)
+		if !mAgent.Enabled {
+			logger.Warnf(ctx, "Agent '%v:%v' is registered disabled, it must be enabled by a user",
+				mAgent.HostName, mAgent.Port)
+		}
+	} else {
+		mAgent.Tags = tags
+		mAgent.Version = request.GetVersion()
+		mAgent.MaxScripts = request.GetMaxScripts()
+		mAgent.SelfRegistered = true
+		mAgent.LastHeartbeatAt = now
+
+		if err = db.UpdateMAgentRegistration(ctx, mAgent); err != nil {
+			return 0, err.Error()
+		}
+
+		agentID = mAgent.AgentID
+		logger.Infof(ctx, "Agent '%v:%v' is re-registered: %v", mAgent.HostName, mAgent.Port, agentID)
+	}
+
+	// агент мог перезапуститься с тем же адресом, старое соединение не переиспользуется
+	if err = am.RefreshClient(ctx, mAgent); err != nil {
+		return agentID, err.Error()
+	}
+
+	// перезапущенный агент не помнит токен, ему выдается новый
+	if agent.TokensEnabled(ctx) {
+		if err = am.RotateToken(ctx, mAgent); err != nil {
+			logger.Warnf(ctx, "Agent '%v:%v' token: '%v'", mAgent.HostName, mAgent.Port, err)
+
+			return agentID, err.Error()
+		}
+	}
+
+	return agentID, message
+}
+
+// Heartbeat продление регистрации агента, registered=false - агент неизвестен и должен зарегистрироваться
+func Heartbeat(ctx context.Context, request *pb.HeartbeatRequest, db *data.Store) (registered bool, message string) {
+	if message = checkRegistrationSecret(ctx, request.GetRegistrationSecret()); message != "" {
+		logger.Warnf(ctx, "Heartbeat '%v:%v': %v", request.GetHostName(), request.GetPort(), message)
+
+		return false, message
+	}
+
+	mAgent, err := db.GetMAgentByHost(ctx, request.GetHostName(), request.GetPort())
+	if err != nil {
+		return false, err.Error()
+	}
+
+	if mAgent == nil {
+		logger.Warnf(ctx, "Heartbeat from unknown agent '%v:%v'", request.GetHostName(), request.GetPort())
+
+		return false, message
+	}
+
+	mAgent.Version = request.GetVersion()
+	mAgent.MaxScripts = request.GetMaxScripts()
+	mAgent.SelfRegistered = true
+	mAgent.LastHeartbeatAt = null.TimeFrom(time.Now())
+
+	if err = db.UpdateMAgentRegistration(ctx, mAgent); err != nil {
+		return true, err.Error()
+	}
+
+	return true, message
+}
+
 func RemoveLogs(
 	ctx context.Context,
 	moreThanDays int64,
@@ -0,0 +1,80 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// DrainAgent вывод агента на обслуживание: новые скрипты на агент не размещаются,
+// запущенные дорабатывают или перезапускаются на другом агенте(migrate). Пустой агент выключает AgentsTracker
+func DrainAgent(ctx context.Context, request *pb.DrainAgentRequest, db *data.Store, am *agent.Manager) (
+	pbAgent *pb.Agent, runningScriptRuns int32, message string) {
+	mAgent, err := db.GetMAgent(ctx, request.GetAgentId())
+	if err != nil {
+		return nil, 0, fmt.Sprintf("Select agent error: '%v'", err)
+	}
+
+	if !mAgent.Enabled {
+		return nil, 0, fmt.Sprintf("Agent '%v' is disabled", undecided.GetMHost(mAgent))
+	}
+
+	if err = startDraining(ctx, mAgent, request.GetMigrate(), db); err != nil {
+		return nil, 0, err.Error()
+	}
+
+	runningScriptRuns = int32(am.CountRunningScriptRuns(ctx, undecided.GetMHost(mAgent))) //nolint:gosec
+	logger.Infof(ctx, "Agent '%v' is draining(migrate: %v), running script runs: %v",
+		undecided.GetMHost(mAgent), mAgent.DrainMigrate, runningScriptRuns)
+
+	pbAgent, err = conv.ModelsToPbAgent(ctx, mAgent)
+	if err != nil {
+		return nil, runningScriptRuns, err.Error()
+	}
+
+	return pbAgent, runningScriptRuns, message
+}
+
+// CancelDrainAgent отмена вывода агента на обслуживание, уже перезапущенные на других агентах скрипты не возвращаются
+func CancelDrainAgent(ctx context.Context, agentID int32, db *data.Store) (message string) {
+	mAgent, err := db.GetMAgent(ctx, agentID)
+	if err != nil {
+		return fmt.Sprintf("Select agent error: '%v'", err)
+	}
+
+	if !mAgent.Draining {
+		return fmt.Sprintf("Agent '%v' is not draining", undecided.GetMHost(mAgent))
+	}
+
+	mAgent.Draining = false
+	mAgent.DrainMigrate = false
+	mAgent.DrainStartedAt = null.Time{}
+
+	if err = db.UpdateMAgentDrain(ctx, mAgent); err != nil {
+		return err.Error()
+	}
+
+	logger.Infof(ctx, "Agent '%v' draining is canceled", undecided.GetMHost(mAgent))
+
+	return message
+}
+
+func startDraining(ctx context.Context, mAgent *models.Agent, migrate bool, db *data.Store) error {
+	if !mAgent.Draining {
+		mAgent.DrainStartedAt = null.TimeFrom(time.Now())
+	}
+
+	mAgent.Draining = true
+	mAgent.DrainMigrate = migrate
+
+	return db.UpdateMAgentDrain(ctx, mAgent)
+}
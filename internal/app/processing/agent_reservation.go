@@ -0,0 +1,353 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/util"
+	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+	"github.com/pkg/errors"
+)
+
+// GetPoolUtilization емкость пула агентов по тегам(пустой тег - все теги).
+// Загрузка берется из метрик агентов(Metrics) на момент запроса, не ответившие агенты не считаются здоровыми
+func GetPoolUtilization(ctx context.Context, tag string, db *data.Store, am *agent.Manager) (
+	capacities []*pb.TagCapacity, message string) {
+	var (
+		mAgents []*models.Agent
+		err     error
+	)
+
+	if tag == "" {
+		mAgents, err = db.GetAllEnabledMAgents(ctx)
+	} else {
+		mAgents, err = db.GetAllEnabledMAgentsByTag(ctx, tag)
+	}
+
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	now := time.Now().UTC()
+
+	mReservations, err := db.GetOverlappingMAgentReservations(ctx, tag, now, now)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	utilizations := getAgentsUtilization(ctx, mAgents, am)
+	runningScripts := am.GetRunningScriptRunsByAgent(ctx)
+
+	byTag := make(map[string]*pb.TagCapacity)
+	responded := make(map[string]int)
+
+	for _, mAgent := range mAgents {
+		host := undecided.GetMHost(mAgent)
+
+		for _, agentTag := range mAgent.Tags {
+			if tag != "" && agentTag != tag {
+				continue
+			}
+
+			capacity, ok := byTag[agentTag]
+			if !ok {
+				capacity = &pb.TagCapacity{Tag: agentTag}
+				byTag[agentTag] = capacity
+			}
+
+			capacity.TotalAgents++
+			capacity.RunningScriptRuns += int32(runningScripts[host]) //nolint:gosec
+
+			if mAgent.Quarantined {
+				capacity.QuarantinedAgents++
+			}
+
+			if mAgent.Draining {
+				capacity.DrainingAgents++
+			}
+
+			utilization, ok := utilizations[host]
+			if !ok {
+				continue
+			}
+
+			responded[agentTag]++
+			capacity.CpuUsed += utilization.GetCpu()
+			capacity.MemUsed += utilization.GetMem()
+			capacity.PortsUsed += float32(100 - utilization.GetPercentAvailablePorts())
+
+			if agent.UnavailableReason(ctx, mAgent) == "" {
+				capacity.HealthyAgents++
+			}
+		}
+	}
+
+	for _, mReservation := range mReservations {
+		if capacity, ok := byTag[mReservation.Tag]; ok {
+			capacity.ReservedAgents += mReservation.AgentsCount
+		}
+	}
+
+	for agentTag, capacity := range byTag {
+		if count := responded[agentTag]; count > 0 {
+			capacity.CpuUsed /= float32(count)
+			capacity.MemUsed /= float32(count)
+			capacity.PortsUsed /= float32(count)
+		}
+
+		capacities = append(capacities, capacity)
+	}
+
+	sort.Slice(capacities, func(i, j int) bool {
+		return capacities[i].GetTag() < capacities[j].GetTag()
+	})
+
+	return capacities, message
+}
+
+// getAgentsUtilization метрики агентов(host:port), агенты, не ответившие за AGENT_PROBE_TIMEOUT, отсутствуют
+func getAgentsUtilization(ctx context.Context, mAgents []*models.Agent, am *agent.Manager) (
+	utilizations map[string]*agentapi.MetricsResponse_AgentUtilization) {
+	utilizations = make(map[string]*agentapi.MetricsResponse_AgentUtilization, len(mAgents))
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for _, mAgent := range mAgents {
+		wg.Add(1)
+
+		go func(host string) {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, config.Get(ctx).AgentProbeTimeout)
+			defer cancel()
+
+			rs, err := am.Metrics(probeCtx, host)
+			if err != nil || rs.GetAgentUtilization() == nil {
+				logger.Warnf(ctx, "Pool utilization: agent '%v' metrics: '%v'", host, err)
+
+				return
+			}
+
+			mu.Lock()
+			utilizations[host] = rs.GetAgentUtilization()
+			mu.Unlock()
+		}(undecided.GetMHost(mAgent))
+	}
+
+	wg.Wait()
+
+	return utilizations
+}
+
+// ReserveAgents бронирование агентов тега на интервал пользователем запроса. Брони, пересекающиеся с интервалом,
+// вместе с новой не должны превышать число доступных агентов тега
+func ReserveAgents(ctx context.Context, request *pb.ReserveAgentsRequest, db *data.Store) (
+	reservation *pb.AgentReservation, message string) {
+	userName := undecided.UserFromContext(ctx)
+
+	startsAt, endsAt := request.GetStartsAt().AsTime().UTC(), request.GetEndsAt().AsTime().UTC()
+
+	switch {
+	case request.GetTag() == "":
+		return nil, "Error reserve agents: tag is empty"
+	case userName == "":
+		return nil, "Error reserve agents: user name is empty"
+	case request.GetAgentsCount() < 1:
+		return nil, fmt.Sprintf("Error reserve agents: agents count '%v' must be positive", request.GetAgentsCount())
+	case request.GetStartsAt() == nil || request.GetEndsAt() == nil || !endsAt.After(startsAt):
+		return nil, "Error reserve agents: the reservation must end after it starts"
+	case !endsAt.After(time.Now().UTC()):
+		return nil, "Error reserve agents: the reservation ends in the past"
+	}
+
+	available, err := countAvailableAgents(ctx, request.GetTag(), db)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	mReservation, err := db.CreateMAgentReservation(ctx, conv.PBToModelAgentReservation(&pb.AgentReservation{
+		Tag:         request.GetTag(),
+		AgentsCount: request.GetAgentsCount(),
+		UserName:    userName,
+		ScenarioId:  request.GetScenarioId(),
+		Comment:     request.GetComment(),
+		StartsAt:    request.GetStartsAt(),
+		EndsAt:      request.GetEndsAt(),
+	}), func(mReservations models.AgentReservationSlice) error {
+		var reserved int32
+		for _, mReservation := range mReservations {
+			reserved += mReservation.AgentsCount
+		}
+
+		if reserved+request.GetAgentsCount() > available {
+			return errors.Errorf("Error reserve agents: %v of %v agents of tag '%v' are free from '%v' to '%v'; %v",
+				max(available-reserved, 0), available, request.GetTag(), startsAt, endsAt,
+				reservationsToString(mReservations))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	logger.Infof(ctx, "Agents reserved: %v agents of tag '%v' by '%v' from '%v' to '%v'",
+		mReservation.AgentsCount, mReservation.Tag, mReservation.UserName, mReservation.StartsAt, mReservation.EndsAt)
+
+	return conv.ModelToPBAgentReservation(mReservation), message
+}
+
+// CancelAgentReservation отмена брони. Отменить можно только свою бронь, пользователь запроса должен быть известен
+func CancelAgentReservation(ctx context.Context, reservationID int32, db *data.Store) (message string) {
+	mReservation, err := db.GetMAgentReservation(ctx, reservationID)
+	if err != nil {
+		return err.Error()
+	} else if mReservation == nil {
+		return fmt.Sprintf("Reservation '%v' not found", reservationID)
+	}
+
+	switch userName := undecided.UserFromContext(ctx); {
+	case userName == "":
+		return fmt.Sprintf("Error cancel reservation '%v': user name is empty", reservationID)
+	case userName != mReservation.UserName:
+		return fmt.Sprintf("Reservation '%v' belongs to '%v'", reservationID, mReservation.UserName)
+	}
+
+	if err = db.DeleteMAgentReservation(ctx, mReservation); err != nil {
+		return err.Error()
+	}
+
+	logger.Infof(ctx, "Reservation '%v' of tag '%v' is canceled", reservationID, mReservation.Tag)
+
+	return message
+}
+
+func GetAgentReservations(ctx context.Context, request *pb.GetAgentReservationsRequest, db *data.Store) (
+	reservations []*pb.AgentReservation, message string) {
+	mReservations, err := db.GetMAgentReservations(ctx, request.GetTag(), request.GetActiveOnly())
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	return conv.ModelToPBAgentReservations(mReservations), message
+}
+
+// checkAgentReservations проверка, что агентов тегов сценария, не забронированных другими пользователями,
+// хватает для запуска. scriptsByTag - количество скриптов сценария по тегам
+func checkAgentReservations(ctx context.Context, scenarioID int32, scriptsByTag map[string]int, db *data.Store) (
+	message string) {
+	userName := undecided.UserFromContext(ctx)
+	now := time.Now().UTC()
+	maxScripts := config.Get(ctx).AgentMaxScripts
+
+	for tag, scripts := range scriptsByTag {
+		mReservations, err := db.GetOverlappingMAgentReservations(ctx, tag, now, now)
+		if err != nil {
+			return err.Error()
+		}
+
+		var (
+			reserved int32
+			others   models.AgentReservationSlice
+		)
+
+		for _, mReservation := range mReservations {
+			if mReservation.UserName == userName || mReservation.ScenarioID.Int32 == scenarioID {
+				continue
+			}
+
+			reserved += mReservation.AgentsCount
+			others = append(others, mReservation)
+		}
+
+		if reserved == 0 {
+			continue
+		}
+
+		available, err := countAvailableAgents(ctx, tag, db)
+		if err != nil {
+			return err.Error()
+		}
+
+		// без лимита скриптов на агент все скрипты тега могут выполняться на одном агенте
+		needed := int32(1)
+		if maxScripts > 0 {
+			needed = (int32(scripts) + maxScripts - 1) / maxScripts //nolint:gosec
+		}
+
+		if available-reserved < needed {
+			return fmt.Sprintf("The run needs %v agents of tag '%v', but %v of %v agents are reserved by other users: %v",
+				needed, tag, reserved, available, reservationsToString(others))
+		}
+	}
+
+	return message
+}
+
+// countAvailableAgents включенные агенты тега, доступные для размещения
+func countAvailableAgents(ctx context.Context, tag string, db *data.Store) (available int32, err error) {
+	mAgents, err := db.GetAllEnabledMAgentsByTag(ctx, tag)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, mAgent := range mAgents {
+		if agent.UnavailableReason(ctx, mAgent) == "" {
+			available++
+		}
+	}
+
+	return available, nil
+}
+
+// scriptsByTag количество скриптов по тегам, с которыми они будут размещаться на агентах
+func scriptsByTag(ctx context.Context, scripts []*pb.Script, simpleScripts []*pb.SimpleScript, db *data.Store) (
+	map[string]int, error) {
+	tags := make([]string, 0, len(scripts)+len(simpleScripts))
+	for _, script := range scripts {
+		tags = append(tags, script.GetTag())
+	}
+
+	for _, simpleScript := range simpleScripts {
+		tags = append(tags, simpleScript.GetTag())
+	}
+
+	byTag := make(map[string]int)
+
+	for _, tag := range tags {
+		tag, err := util.CheckingTagForPresenceInDB(ctx, tag, db)
+		if err != nil {
+			return nil, err
+		}
+
+		byTag[tag]++
+	}
+
+	return byTag, nil
+}
+
+func reservationsToString(mReservations models.AgentReservationSlice) string {
+	descriptions := make([]string, 0, len(mReservations))
+	for _, mReservation := range mReservations {
+		descriptions = append(descriptions, fmt.Sprintf("%v agents by '%v' from '%v' to '%v'",
+			mReservation.AgentsCount, mReservation.UserName, mReservation.StartsAt, mReservation.EndsAt))
+	}
+
+	return strings.Join(descriptions, "; ")
+}
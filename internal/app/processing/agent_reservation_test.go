@@ -0,0 +1,68 @@
+package processing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestReserveAgents_validation(t *testing.T) {
+	now := time.Now().UTC()
+	request := &pb.ReserveAgentsRequest{
+		Tag:         "default",
+		AgentsCount: 1,
+		StartsAt:    timestamppb.New(now.Add(time.Hour)),
+		EndsAt:      timestamppb.New(now.Add(2 * time.Hour)),
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		request     *pb.ReserveAgentsRequest
+		wantMessage string
+	}{
+		{
+			name:        "no user",
+			ctx:         context.Background(),
+			request:     request,
+			wantMessage: "Error reserve agents: user name is empty",
+		},
+		{
+			name:        "empty user",
+			ctx:         undecided.ContextWithUser(context.Background(), ""),
+			request:     request,
+			wantMessage: "Error reserve agents: user name is empty",
+		},
+		{
+			name: "ends before starts",
+			ctx:  undecided.ContextWithUser(context.Background(), "user-1"),
+			request: &pb.ReserveAgentsRequest{
+				Tag: "default", AgentsCount: 1, StartsAt: request.GetEndsAt(), EndsAt: request.GetStartsAt(),
+			},
+			wantMessage: "Error reserve agents: the reservation must end after it starts",
+		},
+		{
+			name: "ended",
+			ctx:  undecided.ContextWithUser(context.Background(), "user-1"),
+			request: &pb.ReserveAgentsRequest{
+				Tag:         "default",
+				AgentsCount: 1,
+				StartsAt:    timestamppb.New(now.Add(-2 * time.Hour)),
+				EndsAt:      timestamppb.New(now.Add(-time.Hour)),
+			},
+			wantMessage: "Error reserve agents: the reservation ends in the past",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reservation, message := ReserveAgents(tt.ctx, tt.request, nil)
+			if reservation != nil || message != tt.wantMessage {
+				t.Errorf("ReserveAgents() = %v, '%v', want '%v'", reservation, message, tt.wantMessage)
+			}
+		})
+	}
+}
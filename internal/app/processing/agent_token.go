@@ -0,0 +1,81 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// RotateAgentTokens перевыпуск токенов агентов(пустой список - все включенные агенты).
+// Агенты обрабатываются параллельно, недоступный агент сохраняет старый токен и не мешает остальным
+func RotateAgentTokens(ctx context.Context, agentIDs []int32, db *data.Store, am *agent.Manager) (
+	rotations []*pb.AgentTokenRotation, message string) {
+	if !agent.TokensEnabled(ctx) {
+		return nil, "Agent tokens are disabled: AGENT_TOKEN_KEY is not set or AGENT_TLS_ENABLED is off"
+	}
+
+	var mAgents []*models.Agent
+
+	if len(agentIDs) == 0 {
+		var err error
+		if mAgents, err = db.GetAllEnabledMAgents(ctx); err != nil {
+			return nil, err.Error()
+		}
+	} else {
+		for _, agentID := range agentIDs {
+			mAgent, err := db.GetMAgent(ctx, agentID)
+			if err != nil {
+				return nil, fmt.Sprintf("Select agent '%v' error: '%v'", agentID, err)
+			}
+
+			mAgents = append(mAgents, mAgent)
+		}
+	}
+
+	rotations = make([]*pb.AgentTokenRotation, len(mAgents))
+	wg := sync.WaitGroup{}
+
+	for i, mAgent := range mAgents {
+		rotations[i] = &pb.AgentTokenRotation{AgentId: mAgent.AgentID, Host: undecided.GetMHost(mAgent)}
+
+		wg.Add(1)
+
+		go func(rotation *pb.AgentTokenRotation) {
+			defer wg.Done()
+
+			if err := am.RotateToken(ctx, mAgent); err != nil {
+				logger.Warnf(ctx, "Rotate agent '%v' token: '%v'", rotation.GetHost(), err)
+				rotation.Error = err.Error()
+
+				return
+			}
+
+			rotation.Rotated = true
+		}(rotations[i])
+	}
+
+	wg.Wait()
+
+	failed := 0
+
+	for _, rotation := range rotations {
+		if !rotation.GetRotated() {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		message = fmt.Sprintf("Tokens of %v of %v agents are not rotated", failed, len(rotations))
+	}
+
+	logger.Infof(ctx, "Agent tokens are rotated: %v of %v", len(rotations)-failed, len(rotations))
+
+	return rotations, message
+}
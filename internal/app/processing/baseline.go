@@ -0,0 +1,258 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+// defaultBaselineTolerances допуски, если у базового Run`а они не заданы
+var defaultBaselineTolerances = []*pb.BaselineTolerance{
+	{Metric: pb.StopCondition_METRIC_RPS, TolerancePercent: 10},
+	{Metric: pb.StopCondition_METRIC_RT_95_P_UNSPECIFIED, TolerancePercent: 10},
+	{Metric: pb.StopCondition_METRIC_FAILED_RATE, TolerancePercent: 1},
+}
+
+func GetScenarioBaseline(ctx context.Context, scenarioID int32, dataStore *data.Store) (
+	baseline *pb.ScenarioBaseline, message string) {
+	mBaseline, err := dataStore.GetMScenarioBaseline(ctx, scenarioID)
+	if err != nil {
+		message = fmt.Sprintf("Error get scenario baseline: '%v'", err.Error())
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	if mBaseline == nil {
+		return nil, ""
+	}
+
+	return conv.ModelToPBScenarioBaseline(ctx, mBaseline)
+}
+
+// SetScenarioBaseline базовым может быть только остановленный Run этого же сценария
+func SetScenarioBaseline(ctx context.Context, request *pb.SetScenarioBaselineRequest, dataStore *data.Store) (
+	baseline *pb.ScenarioBaseline, message string) {
+	mRun, err := dataStore.GetMRunning(ctx, request.GetRunId())
+	if err != nil {
+		message = fmt.Sprintf("Error set scenario baseline, run '%v' not found: '%v'", request.GetRunId(), err.Error())
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	switch {
+	case mRun.ScenarioID != request.GetScenarioId():
+		return nil, fmt.Sprintf("Error set scenario baseline: run '%v' does not belong to scenario '%v'",
+			mRun.RunID, request.GetScenarioId())
+	case mRun.Status != models.EstatusSTATUS_STOPPED_UNSPECIFIED:
+		return nil, fmt.Sprintf("Error set scenario baseline: run '%v' is not finished", mRun.RunID)
+	}
+
+	if message = checkBaselineTolerances(request.GetTolerances()); message != "" {
+		return nil, fmt.Sprintf("Error set scenario baseline: %v", message)
+	}
+
+	mBaseline, message := conv.PBToModelScenarioBaseline(ctx, &pb.ScenarioBaseline{
+		ScenarioId: request.GetScenarioId(),
+		RunId:      request.GetRunId(),
+		Tolerances: request.GetTolerances(),
+	})
+	if message != "" {
+		return nil, fmt.Sprintf("Error set scenario baseline: '%v'", message)
+	}
+
+	mBaseline, err = dataStore.SetMScenarioBaseline(ctx, mBaseline)
+	if err != nil {
+		message = fmt.Sprintf("Error set scenario baseline: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return conv.ModelToPBScenarioBaseline(ctx, mBaseline)
+}
+
+func DeleteScenarioBaseline(ctx context.Context, scenarioID int32, dataStore *data.Store) (message string) {
+	if err := dataStore.DeleteMScenarioBaseline(ctx, scenarioID); err != nil {
+		message = fmt.Sprintf("Error delete scenario baseline: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+	}
+
+	return message
+}
+
+func GetRunVerdict(ctx context.Context, runID int32, dataStore *data.Store) (
+	runVerdict *pb.RunVerdict, message string) {
+	mRunVerdict, err := dataStore.GetMRunVerdict(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error get run verdict: '%v'", err.Error())
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	if mRunVerdict == nil {
+		return nil, ""
+	}
+
+	return conv.ModelToPBRunVerdict(ctx, mRunVerdict)
+}
+
+// EvaluateRunVerdict сравнение завершенного Run`а с базовым Run`ом сценария и сохранение вердикта PASS/FAIL.
+// Run без статистики(как и базовый Run без статистики) получает FAIL
+func EvaluateRunVerdict(ctx context.Context, mRun *models.Run, pbStore *datapb.Store) (
+	runVerdict *pb.RunVerdict, message string) {
+	mBaseline, err := pbStore.GetDataStore().GetMScenarioBaseline(ctx, mRun.ScenarioID)
+	if err != nil || mBaseline == nil {
+		return nil, fmt.Sprintf("Error evaluate run verdict, scenario '%v' baseline: '%v'", mRun.ScenarioID, err)
+	}
+
+	baseline, message := conv.ModelToPBScenarioBaseline(ctx, mBaseline)
+	if message != "" {
+		return nil, message
+	}
+
+	tolerances := baseline.GetTolerances()
+	if len(tolerances) == 0 {
+		tolerances = defaultBaselineTolerances
+	}
+
+	// базовый Run с самим собой не сравнивается, он проходит по определению
+	var breaches, skipped []string
+	if baseline.GetRunId() != mRun.RunID {
+		totals, _, _, mes := CompareRuns(ctx, []int32{baseline.GetRunId(), mRun.RunID}, pbStore)
+		if len(totals) != 2 {
+			return nil, fmt.Sprintf("Error evaluate run verdict: '%v'", mes)
+		}
+
+		breaches, skipped = baselineBreaches(totals[0].GetSummary(), totals[1].GetSummary(), tolerances)
+	}
+
+	mRunVerdict := &models.RunVerdict{
+		RunID:         mRun.RunID,
+		BaselineRunID: baseline.GetRunId(),
+		Verdict:       models.RunVerdictVERDICT_PASS,
+		Info:          fmt.Sprintf("PASS against the baseline run '%v'", baseline.GetRunId()),
+	}
+	if len(breaches) > 0 {
+		mRunVerdict.Verdict = models.RunVerdictVERDICT_FAIL
+		mRunVerdict.Info = fmt.Sprintf("FAIL against the baseline run '%v': %v",
+			baseline.GetRunId(), strings.Join(breaches, "; "))
+	}
+
+	if len(skipped) > 0 {
+		mRunVerdict.Info += fmt.Sprintf("; not compared: %v", strings.Join(skipped, "; "))
+	}
+
+	if err = pbStore.GetDataStore().CreateMRunVerdict(ctx, mRunVerdict); err != nil {
+		message = fmt.Sprintf("Error save run verdict: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return conv.ModelToPBRunVerdict(ctx, mRunVerdict)
+}
+
+// baselineBreaches нарушенные допуски и допуски, которые не с чем сравнить: доля ошибок считается по счетчику
+// запросов, которого нет в статистике, сохраненной до его добавления
+func baselineBreaches(baseSummary *pb.StatisticSummary, summary *pb.StatisticSummary,
+	tolerances []*pb.BaselineTolerance) (breaches []string, skipped []string) {
+	switch {
+	case baseSummary.GetSamples() == 0:
+		breaches = append(breaches, "the baseline run has no statistics")
+	case summary.GetSamples() == 0:
+		breaches = append(breaches, "the run has no statistics")
+	default:
+		for _, tolerance := range tolerances {
+			if tolerance.GetMetric() == pb.StopCondition_METRIC_FAILED_RATE &&
+				(baseSummary.GetRequests() == 0 || summary.GetRequests() == 0) {
+				skipped = append(skipped, fmt.Sprintf("%v without request counters", tolerance.GetMetric()))
+
+				continue
+			}
+
+			base := summaryMetricValue(baseSummary, tolerance.GetMetric())
+			value := summaryMetricValue(summary, tolerance.GetMetric())
+
+			degradation := baselineDegradation(tolerance.GetMetric(), base, value)
+			if degradation > tolerance.GetTolerancePercent() {
+				breaches = append(breaches, fmt.Sprintf("%v %.2f against %.2f, degradation %.2f > %v",
+					tolerance.GetMetric(), value, base, degradation, tolerance.GetTolerancePercent()))
+			}
+		}
+	}
+
+	return breaches, skipped
+}
+
+func checkBaselineTolerances(tolerances []*pb.BaselineTolerance) string {
+	metrics := make(map[pb.StopCondition_Metric]bool, len(tolerances))
+	for i, tolerance := range tolerances {
+		if _, ok := pb.StopCondition_Metric_name[int32(tolerance.GetMetric())]; !ok {
+			return fmt.Sprintf("tolerance %v: unknown metric '%v'", i, tolerance.GetMetric())
+		}
+
+		if tolerance.GetTolerancePercent() < 0 {
+			return fmt.Sprintf("tolerance %v: tolerance_percent must not be negative", i)
+		}
+
+		if metrics[tolerance.GetMetric()] {
+			return fmt.Sprintf("tolerance %v: duplicate metric '%v'", i, tolerance.GetMetric())
+		}
+		metrics[tolerance.GetMetric()] = true
+	}
+
+	return ""
+}
+
+func summaryMetricValue(summary *pb.StatisticSummary, metric pb.StopCondition_Metric) float64 {
+	switch metric {
+	case pb.StopCondition_METRIC_RT_90_P:
+		return summary.GetRt_90P()
+	case pb.StopCondition_METRIC_RT_99_P:
+		return summary.GetRt_99P()
+	case pb.StopCondition_METRIC_RT_MAX:
+		return summary.GetRtMax()
+	case pb.StopCondition_METRIC_RPS:
+		return summary.GetRps()
+	case pb.StopCondition_METRIC_FAILED:
+		return summary.GetFailed()
+	case pb.StopCondition_METRIC_FAILED_RATE:
+		return summary.GetFailedRate()
+	default:
+		return summary.GetRt_95P()
+	}
+}
+
+// baselineDegradation ухудшение метрики относительно базового Run`а:
+// для RPS - снижение в процентах, для failed rate - рост в процентных пунктах, для остальных - рост в процентах
+func baselineDegradation(metric pb.StopCondition_Metric, base float64, value float64) float64 {
+	switch {
+	case metric == pb.StopCondition_METRIC_FAILED_RATE:
+		return value - base
+	case metric == pb.StopCondition_METRIC_RPS:
+		if base == 0 {
+			return 0
+		}
+
+		return (base - value) * 100 / base
+	case base == 0:
+		if value > 0 {
+			return math.Inf(1)
+		}
+
+		return 0
+	default:
+		return (value - base) * 100 / base
+	}
+}
@@ -0,0 +1,93 @@
+package processing
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+)
+
+// runSummary сводка Run`а длиной dumps дампов: rps запросов в секунду, доля ошибок failedPercent,
+// счетчики накапливаются с начала скрипт-рана(дамп - 10 секунд)
+func runSummary(dumps int, rps int32, rt95P int32, failedPercent int32, withRequests bool) *pb.StatisticSummary {
+	accumulator := newStatisticAccumulator()
+
+	for i := 1; i <= dumps; i++ {
+		//nolint:gosec
+		requests := rps * 10 * int32(i)
+
+		statistic := compareStatistic(int32(i), "agent-1", rps, rt95P, requests*failedPercent/100, requests)
+		if !withRequests {
+			statistic.Requests = 0
+		}
+
+		accumulator.add(statistic, 1)
+	}
+
+	return accumulator.summary()
+}
+
+func TestBaselineBreaches(t *testing.T) {
+	base := runSummary(6, 100, 200, 1, true)
+
+	tests := []struct {
+		name         string
+		baseSummary  *pb.StatisticSummary
+		summary      *pb.StatisticSummary
+		wantBreaches int
+		wantSkipped  []string
+	}{
+		{name: "same run", baseSummary: base, summary: runSummary(6, 100, 200, 1, true)},
+		// накопленный счетчик failed растет с длиной Run`а, доля ошибок - нет
+		{name: "longer run", baseSummary: base, summary: runSummary(60, 100, 200, 1, true)},
+		{name: "failed rate", baseSummary: base, summary: runSummary(60, 100, 200, 3, true), wantBreaches: 1},
+		{
+			name:         "rps and rt95p",
+			baseSummary:  base,
+			summary:      runSummary(6, 80, 300, 1, true),
+			wantBreaches: 2,
+		},
+		{
+			name:        "baseline without request counters",
+			baseSummary: runSummary(6, 100, 200, 1, false),
+			summary:     runSummary(60, 100, 200, 3, true),
+			wantSkipped: []string{"METRIC_FAILED_RATE without request counters"},
+		},
+		{name: "no statistics", baseSummary: base, summary: &pb.StatisticSummary{}, wantBreaches: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breaches, skipped := baselineBreaches(tt.baseSummary, tt.summary, defaultBaselineTolerances)
+			if len(breaches) != tt.wantBreaches || !reflect.DeepEqual(skipped, tt.wantSkipped) {
+				t.Errorf("baselineBreaches() = %v, %v, want %v breaches, %v",
+					breaches, skipped, tt.wantBreaches, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestBaselineDegradation(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric pb.StopCondition_Metric
+		base   float64
+		value  float64
+		want   float64
+	}{
+		{name: "rt95p growth", metric: pb.StopCondition_METRIC_RT_95_P_UNSPECIFIED, base: 200, value: 250, want: 25},
+		{
+			name: "rt95p improvement", metric: pb.StopCondition_METRIC_RT_95_P_UNSPECIFIED, base: 200, value: 100,
+			want: -50,
+		},
+		{name: "rps drop", metric: pb.StopCondition_METRIC_RPS, base: 100, value: 80, want: 20},
+		{name: "failed rate in points", metric: pb.StopCondition_METRIC_FAILED_RATE, base: 1, value: 2.5, want: 1.5},
+		{name: "zero base", metric: pb.StopCondition_METRIC_FAILED, base: 0, value: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := baselineDegradation(tt.metric, tt.base, tt.value); got != tt.want {
+				t.Errorf("baselineDegradation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
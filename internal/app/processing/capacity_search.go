@@ -0,0 +1,114 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+const (
+	defaultCapacityStartPercentage  = 10
+	defaultCapacityStepPercentage   = 10
+	defaultCapacityMaxPercentage    = 300
+	defaultCapacityStepDurationSec  = 300
+	defaultCapacityBreachSustainSec = 30
+)
+
+// StartCapacitySearch запуск сценария в режиме поиска предельной нагрузки.
+// Сценарий стартует с start_percentage, дальше шаги выполняет job по собранной статистике
+func StartCapacitySearch(ctx context.Context, request *pb.StartCapacitySearchRequest, pbStore *datapb.Store) (
+	run *pb.Run, capacitySearch *pb.CapacitySearch, message string) {
+	mCapacitySearch := &models.CapacitySearch{
+		ScenarioID:       request.GetScenarioId(),
+		StartPercentage:  valueOrDefault(request.GetStartPercentage(), defaultCapacityStartPercentage),
+		StepPercentage:   valueOrDefault(request.GetStepPercentage(), defaultCapacityStepPercentage),
+		MaxPercentage:    valueOrDefault(request.GetMaxPercentage(), defaultCapacityMaxPercentage),
+		StepDurationSec:  valueOrDefault(request.GetStepDurationSec(), defaultCapacityStepDurationSec),
+		MaxRT95P:         request.GetMaxRt_95P(),
+		MaxFailedRate:    request.GetMaxFailedRate(),
+		Status:           models.CapacitySearchStatusSTATUS_SEARCHING_UNSPECIFIED,
+		BreachSustainSec: valueOrDefault(request.GetBreachSustainSec(), defaultCapacityBreachSustainSec),
+	}
+
+	if message = checkCapacitySearch(mCapacitySearch); message != "" {
+		message = fmt.Sprintf("Error start capacity search: %v", message)
+		logger.Warnf(ctx, message)
+
+		return nil, nil, message
+	}
+
+	run, message = ScenarioToRunning(ctx,
+		request.GetScenarioId(),
+		mCapacitySearch.StartPercentage,
+		request.GetUserName(),
+		request.GetPreferredUserName(),
+		pbStore,
+	)
+	if run == nil || message != "" {
+		return run, nil, message
+	}
+
+	mCapacitySearch.RunID = run.GetRunId()
+	mCapacitySearch.CurrentPercentage = mCapacitySearch.StartPercentage
+	mCapacitySearch.StepStartedAt = time.Now().UTC()
+
+	mCapacitySearch, err := pbStore.GetDataStore().CreateMCapacitySearch(ctx, mCapacitySearch)
+	if err != nil {
+		message = fmt.Sprintf("Error start capacity search, the run '%v' is started without search: '%v'",
+			run.GetRunId(), err.Error())
+		logger.Errorf(ctx, message)
+
+		return run, nil, message
+	}
+
+	capacitySearch, message = conv.ModelToPBCapacitySearch(ctx, mCapacitySearch)
+
+	return run, capacitySearch, message
+}
+
+func GetCapacitySearch(ctx context.Context, runID int32, dataStore *data.Store) (
+	capacitySearch *pb.CapacitySearch, message string) {
+	mCapacitySearch, err := dataStore.GetMCapacitySearchByRun(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error get capacity search: '%v'", err.Error())
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	return conv.ModelToPBCapacitySearch(ctx, mCapacitySearch)
+}
+
+func checkCapacitySearch(mCapacitySearch *models.CapacitySearch) string {
+	switch {
+	case mCapacitySearch.StartPercentage < 0 || mCapacitySearch.StepPercentage < 0 ||
+		mCapacitySearch.MaxPercentage < 0 || mCapacitySearch.StepDurationSec < 0 ||
+		mCapacitySearch.BreachSustainSec < 0:
+		return "percentages, step duration and breach_sustain_sec must not be negative"
+	case mCapacitySearch.StartPercentage > mCapacitySearch.MaxPercentage:
+		return "start_percentage must not exceed max_percentage"
+	case mCapacitySearch.MaxPercentage > maxPercentageOfTarget:
+		return fmt.Sprintf("max_percentage must not exceed %v", maxPercentageOfTarget)
+	case mCapacitySearch.MaxRT95P < 0 || mCapacitySearch.MaxFailedRate < 0 || mCapacitySearch.MaxFailedRate > 100:
+		return "max_rt_95_p must not be negative, max_failed_rate must be in 0..100"
+	case mCapacitySearch.MaxRT95P == 0 && mCapacitySearch.MaxFailedRate == 0:
+		return "at least one limit(max_rt_95_p or max_failed_rate) is required"
+	}
+
+	return ""
+}
+
+func valueOrDefault(value int32, defaultValue int32) int32 {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	dataPb "github.com/aliexpressru/alilo-backend/internal/app/datapb"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
@@ -70,3 +72,48 @@ func CommandNew(ctx context.Context,
 
 	return cmd, err
 }
+
+// ListCommands история команд с фильтрами по Run`у, типу, статусу и времени создания
+func ListCommands(ctx context.Context, request *pb.ListCommandsRequest, db *dataPb.Store) (
+	commands []*pb.Command, totalPages int64, message string) {
+	filter := data.CommandsFilter{RunID: request.GetRunId()}
+	for _, cmdType := range request.GetTypes() {
+		filter.Types = append(filter.Types, cmdType.String())
+	}
+
+	for _, status := range request.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, status.String())
+	}
+
+	if request.GetCreatedFrom() != nil {
+		filter.CreatedFrom = request.GetCreatedFrom().AsTime()
+	}
+
+	if request.GetCreatedTo() != nil {
+		filter.CreatedTo = request.GetCreatedTo().AsTime()
+	}
+
+	offset, limit := data.OffsetCalculation(request.GetLimit(), request.GetPageNumber())
+
+	mCommands, totalPages, err := db.GetDataStore().GetMCommandsPaging(ctx, filter, limit, offset)
+	if err != nil {
+		message = fmt.Sprintf("Error list commands: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, 0, message
+	}
+
+	commands = make([]*pb.Command, 0, len(mCommands))
+	for _, mCommand := range mCommands {
+		command, er := conv.ModelToPBCmd(ctx, mCommand)
+		if er != nil {
+			message = fmt.Sprint(message, er.Error())
+
+			continue
+		}
+
+		commands = append(commands, command)
+	}
+
+	return commands, totalPages, message
+}
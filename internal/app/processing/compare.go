@@ -0,0 +1,290 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+const maxRunsToCompare = 10
+
+// statisticAccumulator накопление статистики одного ключа(скрипт/URL) Run`а по дампам
+type statisticAccumulator struct {
+	dumps map[int32]*pb.StatisticSummary
+	rtMax float64
+	// последние счетчики скрипт-ранов, ключ - script_run_id и агент
+	counters map[string]scriptRunCounters
+	failed   float64
+	requests float64
+}
+
+// scriptRunCounters счетчики k6 с начала работы скрипт-рана: неуспешные и все запросы(итерации)
+type scriptRunCounters struct {
+	failed   int32
+	requests int32
+}
+
+func newStatisticAccumulator() *statisticAccumulator {
+	return &statisticAccumulator{
+		dumps:    make(map[int32]*pb.StatisticSummary),
+		counters: make(map[string]scriptRunCounters),
+	}
+}
+
+// add статистика дампа. Дампы должны добавляться по порядку: failed и requests накапливаются,
+// поэтому суммируется их прирост между дампами
+func (a *statisticAccumulator) add(statistic *models.Statistic, scriptRunID int64) {
+	dump, ok := a.dumps[statistic.StatisticDumpID]
+	if !ok {
+		dump = &pb.StatisticSummary{}
+		a.dumps[statistic.StatisticDumpID] = dump
+	}
+
+	// в одном дампе экземпляры скрипта суммируются, для времени ответа берется худшее значение
+	dump.Rps += float64(statistic.RPS)
+	dump.DataSent += float64(statistic.DataSent)
+	dump.DataReceived += float64(statistic.DataReceived)
+	dump.Rt_90P = max(dump.GetRt_90P(), float64(statistic.RT90P))
+	dump.Rt_95P = max(dump.GetRt_95P(), float64(statistic.RT95P))
+	dump.Rt_99P = max(dump.GetRt_99P(), float64(statistic.RT99P))
+	a.rtMax = max(a.rtMax, float64(statistic.RTMax))
+
+	a.addCounters(fmt.Sprintf("%v:%v", scriptRunID, strings.Join(statistic.Agents, ",")),
+		scriptRunCounters{failed: statistic.Failed, requests: statistic.Requests})
+}
+
+// addCounters прирост счетчиков скрипт-рана с прошлого дампа. Для первого дампа и после сброса счетчиков
+// (скрипт-ран перезапущен) приростом считаются текущие значения
+func (a *statisticAccumulator) addCounters(key string, current scriptRunCounters) {
+	previous, ok := a.counters[key]
+	a.counters[key] = current
+
+	if !ok || current.failed < previous.failed || current.requests < previous.requests {
+		previous = scriptRunCounters{}
+	}
+
+	a.failed += float64(current.failed - previous.failed)
+	a.requests += float64(current.requests - previous.requests)
+}
+
+func (a *statisticAccumulator) summary() *pb.StatisticSummary {
+	summary := &pb.StatisticSummary{RtMax: a.rtMax, Failed: a.failed, Requests: a.requests}
+	if a.requests > 0 {
+		summary.FailedRate = a.failed * 100 / a.requests
+	}
+
+	if len(a.dumps) == 0 {
+		return summary
+	}
+
+	for _, dump := range a.dumps {
+		summary.Rps += dump.GetRps()
+		summary.DataSent += dump.GetDataSent()
+		summary.DataReceived += dump.GetDataReceived()
+		summary.Rt_90P += dump.GetRt_90P()
+		summary.Rt_95P += dump.GetRt_95P()
+		summary.Rt_99P += dump.GetRt_99P()
+	}
+
+	samples := float64(len(a.dumps))
+	summary.Rps /= samples
+	summary.DataSent /= samples
+	summary.DataReceived /= samples
+	summary.Rt_90P /= samples
+	summary.Rt_95P /= samples
+	summary.Rt_99P /= samples
+	//nolint:gosec
+	summary.Samples = int32(len(a.dumps))
+
+	return summary
+}
+
+// runStatistic статистика одного Run`а по ключам
+type runStatistic map[string]*statisticAccumulator
+
+func (r runStatistic) add(key string, statistic *models.Statistic, scriptRunID int64) {
+	accumulator, ok := r[key]
+	if !ok {
+		accumulator = newStatisticAccumulator()
+		r[key] = accumulator
+	}
+
+	accumulator.add(statistic, scriptRunID)
+}
+
+const totalComparisonKey = "total"
+
+// CompareRuns сравнение статистики Run`ов по скриптам(по имени скрипта) и по URL.
+// Статистика берется из statistic по run_ids/script_run_ids, первый Run - базовый
+func CompareRuns(ctx context.Context, runIDs []int32, pbStore *datapb.Store) (
+	totals []*pb.RunComparisonValue, scripts []*pb.RunComparisonItem, urls []*pb.RunComparisonItem, message string) {
+	if len(runIDs) < 2 || len(runIDs) > maxRunsToCompare {
+		return nil, nil, nil, fmt.Sprintf("Error compare runs: from 2 to %v run_ids are required", maxRunsToCompare)
+	}
+
+	uniqueRunIDs := make(map[int32]bool, len(runIDs))
+	for _, runID := range runIDs {
+		if uniqueRunIDs[runID] {
+			return nil, nil, nil, fmt.Sprintf("Error compare runs: run '%v' is specified more than once", runID)
+		}
+		uniqueRunIDs[runID] = true
+	}
+
+	// имя скрипта по script_run_id, т.к. в статистике хранится только идентификатор
+	scriptNames := make(map[int64]string)
+	for _, runID := range runIDs {
+		run, mes := pbStore.GetRunning(ctx, runID)
+		if mes != "" {
+			message = fmt.Sprintf("Error compare runs, run '%v': '%v'", runID, mes)
+			logger.Warnf(ctx, message)
+
+			return nil, nil, nil, message
+		}
+
+		for _, scriptRun := range run.GetScriptRuns() {
+			name := scriptRun.GetScript().GetName()
+			if scriptRun.GetTypeScriptRun() == pb.ScriptRun_TYPE_SCRIPT_RUN_SIMPLE {
+				name = scriptRun.GetSimpleScript().GetName()
+			}
+			scriptNames[int64(scriptRun.GetRunScriptId())] = name
+		}
+	}
+
+	mStatistics, err := pbStore.GetDataStore().GetMStatisticsByRuns(ctx, runIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Sprintf("Error compare runs: '%v'", err.Error())
+	}
+
+	scriptStatistics := make(map[int32]runStatistic, len(runIDs))
+	urlStatistics := make(map[int32]runStatistic, len(runIDs))
+	for _, runID := range runIDs {
+		scriptStatistics[runID] = make(runStatistic)
+		urlStatistics[runID] = make(runStatistic)
+	}
+
+	for _, mStatistic := range mStatistics {
+		for i, runID := range mStatistic.RunIds {
+			//nolint:gosec
+			scriptStatistic, ok := scriptStatistics[int32(runID)]
+			if !ok {
+				continue
+			}
+
+			var scriptRunID int64
+			if i < len(mStatistic.ScriptRunIds) {
+				scriptRunID = mStatistic.ScriptRunIds[i]
+			}
+
+			scriptStatistic.add(totalComparisonKey, mStatistic, scriptRunID)
+
+			if name, ok := scriptNames[scriptRunID]; ok {
+				scriptStatistic.add(name, mStatistic, scriptRunID)
+			}
+
+			if mStatistic.URLPath != "" {
+				//nolint:gosec
+				urlStatistics[int32(runID)].add(strings.TrimSpace(
+					fmt.Sprintf("%v %v", mStatistic.URLMethod, mStatistic.URLPath)), mStatistic, scriptRunID)
+			}
+		}
+	}
+
+	totals = compareRunStatistics(runIDs, scriptStatistics, totalComparisonKey)
+	scripts = compareByKeys(runIDs, scriptStatistics)
+	urls = compareByKeys(runIDs, urlStatistics)
+
+	if len(mStatistics) == 0 {
+		message = "There are no statistics for the specified runs"
+	} else if withoutStatistics := runsWithoutStatistics(runIDs, scriptStatistics); len(withoutStatistics) > 0 {
+		// статистика до привязки к Run`ам(run_ids) не сохранялась, такие Run`ы сравнивать не с чем
+		message = fmt.Sprintf("There are no statistics for runs: %v", withoutStatistics)
+	}
+
+	return totals, scripts, urls, message
+}
+
+func runsWithoutStatistics(runIDs []int32, statistics map[int32]runStatistic) (withoutStatistics []int32) {
+	for _, runID := range runIDs {
+		if _, ok := statistics[runID][totalComparisonKey]; !ok {
+			withoutStatistics = append(withoutStatistics, runID)
+		}
+	}
+
+	return withoutStatistics
+}
+
+func compareByKeys(runIDs []int32, statistics map[int32]runStatistic) (items []*pb.RunComparisonItem) {
+	keys := make(map[string]bool)
+	for _, statistic := range statistics {
+		for key := range statistic {
+			if key != totalComparisonKey {
+				keys[key] = true
+			}
+		}
+	}
+
+	for key := range keys {
+		items = append(items, &pb.RunComparisonItem{
+			Key:    key,
+			Values: compareRunStatistics(runIDs, statistics, key),
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].GetKey() < items[j].GetKey()
+	})
+
+	return items
+}
+
+func compareRunStatistics(runIDs []int32, statistics map[int32]runStatistic, key string) (
+	values []*pb.RunComparisonValue) {
+	var baseline *pb.StatisticSummary
+	for _, runID := range runIDs {
+		summary := &pb.StatisticSummary{}
+		if accumulator, ok := statistics[runID][key]; ok {
+			summary = accumulator.summary()
+		}
+
+		if baseline == nil {
+			baseline = summary
+		}
+
+		values = append(values, &pb.RunComparisonValue{
+			RunId:        runID,
+			Summary:      summary,
+			DeltaPercent: deltaPercent(baseline, summary),
+		})
+	}
+
+	return values
+}
+
+func deltaPercent(baseline *pb.StatisticSummary, summary *pb.StatisticSummary) *pb.StatisticSummary {
+	delta := func(base float64, value float64) float64 {
+		if base == 0 {
+			return 0
+		}
+
+		return (value - base) * 100 / base
+	}
+
+	return &pb.StatisticSummary{
+		Rps:          delta(baseline.GetRps(), summary.GetRps()),
+		Rt_90P:       delta(baseline.GetRt_90P(), summary.GetRt_90P()),
+		Rt_95P:       delta(baseline.GetRt_95P(), summary.GetRt_95P()),
+		Rt_99P:       delta(baseline.GetRt_99P(), summary.GetRt_99P()),
+		RtMax:        delta(baseline.GetRtMax(), summary.GetRtMax()),
+		Failed:       delta(baseline.GetFailed(), summary.GetFailed()),
+		Requests:     delta(baseline.GetRequests(), summary.GetRequests()),
+		FailedRate:   delta(baseline.GetFailedRate(), summary.GetFailedRate()),
+		DataSent:     delta(baseline.GetDataSent(), summary.GetDataSent()),
+		DataReceived: delta(baseline.GetDataReceived(), summary.GetDataReceived()),
+	}
+}
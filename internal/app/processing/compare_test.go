@@ -0,0 +1,143 @@
+package processing
+
+import (
+	"testing"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// compareStatistic строка статистики дампа скрипт-рана, failed и requests - накопленные счетчики k6
+func compareStatistic(dumpID int32, agent string, rps int32, rt95P int32, failed int32,
+	requests int32) *models.Statistic {
+	return &models.Statistic{
+		StatisticDumpID: dumpID,
+		Agents:          []string{agent},
+		RPS:             rps,
+		RT95P:           rt95P,
+		RTMax:           rt95P * 2,
+		Failed:          failed,
+		Requests:        requests,
+	}
+}
+
+func TestStatisticAccumulator_summary(t *testing.T) {
+	type row struct {
+		scriptRunID int64
+		statistic   *models.Statistic
+	}
+
+	tests := []struct {
+		name string
+		rows []row
+		want *pb.StatisticSummary
+	}{
+		{name: "no statistics", want: &pb.StatisticSummary{}},
+		{
+			name: "cumulative counters",
+			rows: []row{
+				{1, compareStatistic(1, "agent-1", 100, 200, 5, 1000)},
+				{1, compareStatistic(2, "agent-1", 100, 400, 10, 2000)},
+				{1, compareStatistic(3, "agent-1", 100, 300, 12, 3000)},
+			},
+			// failed - прирост счетчика, а не среднее накопленных значений(9)
+			want: &pb.StatisticSummary{
+				Rps: 100, Rt_95P: 300, RtMax: 800, Failed: 12, Requests: 3000, FailedRate: 0.4, Samples: 3,
+			},
+		},
+		{
+			name: "script runs are summed",
+			rows: []row{
+				{1, compareStatistic(1, "agent-1", 100, 200, 5, 1000)},
+				{2, compareStatistic(1, "agent-2", 50, 500, 0, 500)},
+				{1, compareStatistic(2, "agent-1", 100, 200, 10, 2000)},
+				{2, compareStatistic(2, "agent-2", 50, 300, 40, 1000)},
+			},
+			want: &pb.StatisticSummary{
+				Rps: 150, Rt_95P: 400, RtMax: 1000, Failed: 50, Requests: 3000, FailedRate: 50.0 / 30, Samples: 2,
+			},
+		},
+		{
+			name: "restarted script run",
+			rows: []row{
+				{1, compareStatistic(1, "agent-1", 100, 200, 8, 1000)},
+				{1, compareStatistic(2, "agent-1", 100, 200, 10, 2000)},
+				// счетчики сброшены: приростом считаются текущие значения
+				{1, compareStatistic(3, "agent-1", 100, 200, 2, 500)},
+				{1, compareStatistic(4, "agent-1", 100, 200, 6, 1500)},
+			},
+			want: &pb.StatisticSummary{
+				Rps: 100, Rt_95P: 200, RtMax: 400, Failed: 16, Requests: 3500, FailedRate: 1600.0 / 3500, Samples: 4,
+			},
+		},
+		{
+			name: "migrated script run",
+			rows: []row{
+				{1, compareStatistic(1, "agent-1", 100, 200, 10, 1000)},
+				{1, compareStatistic(2, "agent-1", 50, 200, 12, 1500)},
+				{1, compareStatistic(2, "agent-2", 50, 200, 1, 500)},
+				{1, compareStatistic(3, "agent-2", 100, 200, 3, 1500)},
+			},
+			want: &pb.StatisticSummary{
+				Rps: 100, Rt_95P: 200, RtMax: 400, Failed: 15, Requests: 3000, FailedRate: 0.5, Samples: 3,
+			},
+		},
+		{
+			name: "statistics without requests",
+			rows: []row{
+				{1, compareStatistic(1, "agent-1", 100, 200, 5, 0)},
+				{1, compareStatistic(2, "agent-1", 100, 200, 7, 0)},
+			},
+			want: &pb.StatisticSummary{Rps: 100, Rt_95P: 200, RtMax: 400, Failed: 7, Samples: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accumulator := newStatisticAccumulator()
+			for _, r := range tt.rows {
+				accumulator.add(r.statistic, r.scriptRunID)
+			}
+
+			if got := accumulator.summary(); !proto.Equal(got, tt.want) {
+				t.Errorf("summary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareRunStatistics(t *testing.T) {
+	statistics := map[int32]runStatistic{1: {}, 2: {}, 3: {}}
+
+	// базовый Run: 20 неуспешных запросов из 2000 за 2 дампа
+	statistics[1].add(totalComparisonKey, compareStatistic(1, "agent-1", 100, 200, 10, 1000), 11)
+	statistics[1].add(totalComparisonKey, compareStatistic(2, "agent-1", 100, 200, 20, 2000), 11)
+
+	// вдвое дольше при той же доле ошибок
+	for i, counter := range []int32{1000, 2000, 3000, 4000} {
+		//nolint:gosec
+		statistics[2].add(totalComparisonKey,
+			compareStatistic(int32(i+1), "agent-1", 100, 300, counter/100, counter), 21)
+	}
+
+	values := compareRunStatistics([]int32{1, 2, 3}, statistics, totalComparisonKey)
+	if len(values) != 3 {
+		t.Fatalf("compareRunStatistics() = %v values, want 3", len(values))
+	}
+
+	want := []*pb.StatisticSummary{
+		{},
+		{Rt_95P: 50, RtMax: 50, Failed: 100, Requests: 100},
+		// Run без статистики
+		{Rps: -100, Rt_95P: -100, RtMax: -100, Failed: -100, Requests: -100, FailedRate: -100},
+	}
+	for i, value := range values {
+		if !proto.Equal(value.GetDeltaPercent(), want[i]) {
+			t.Errorf("compareRunStatistics()[%v] delta = %v, want %v", i, value.GetDeltaPercent(), want[i])
+		}
+	}
+
+	if got := values[1].GetSummary().GetFailedRate(); got != 1 {
+		t.Errorf("compareRunStatistics()[1] failed_rate = %v, want 1", got)
+	}
+}
@@ -0,0 +1,214 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/ssh"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+const emergencyStopReason = "emergency stop"
+
+// EmergencyStop аварийная остановка всех нагрузок: команды остановки для всех активных и приостановленных Run`ов,
+// Stop всех задач на всех агентах параллельно с таймаутом EMERGENCY_STOP_TIMEOUT, Run`ы помечаются
+// остановленными с причиной. SSH(killall) - только для агентов, не ответивших на вызовы, и только по запросу
+func EmergencyStop(ctx context.Context, request *pb.EmergencyStopRequest, dataStore *data.Store,
+	pbStore *datapb.Store, am *agent.Manager) (
+	stoppedRunIDs []int32, results []*pb.AgentStopResult, message string) {
+	reason := emergencyStopReason
+	if request.GetReason() != "" {
+		reason = fmt.Sprintf("%v: %v", reason, request.GetReason())
+	}
+
+	if userName := undecided.UserFromContext(ctx); userName != "" {
+		reason = fmt.Sprintf("%v(by '%v')", reason, userName)
+	}
+
+	logger.Warnf(ctx, "Emergency stop is started: '%v'", reason)
+
+	// остановка не прерывается, если клиент отключился, не дождавшись ответа
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), config.Get(ctx).EmergencyStopTimeout)
+	defer cancel()
+
+	mRuns, err := dataStore.GetActiveMRuns(stopCtx)
+	if err != nil {
+		return nil, nil, err.Error()
+	}
+
+	// Run на паузе иначе можно было бы продолжить после аварийной остановки
+	mPausedRuns, err := dataStore.GetPausedMRuns(stopCtx)
+	if err != nil {
+		return nil, nil, err.Error()
+	}
+
+	mRuns = append(mRuns, mPausedRuns...)
+
+	var messages []string
+
+	// команды остановки завершают Run`ы штатно: маркеры аннотаций, удаление команд обновления
+	for _, mRun := range mRuns {
+		if _, mess := ScenarioToStop(stopCtx, mRun.RunID, dataStore, pbStore); mess != "" {
+			messages = append(messages, fmt.Sprintf("run '%v': %v", mRun.RunID, mess))
+		}
+	}
+
+	results, err = stopAllAgentTasks(stopCtx, request.GetSshFallback(), dataStore, am)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+
+	for _, result := range results {
+		if result.GetError() != "" && (!result.GetSshFallback() || result.GetSshError() != "") {
+			messages = append(messages, fmt.Sprintf("agent '%v' is not stopped", result.GetHost()))
+		}
+	}
+
+	for _, mRun := range mRuns {
+		if mess := markRunStopped(context.WithoutCancel(ctx), mRun.RunID, reason, pbStore); mess != "" {
+			messages = append(messages, fmt.Sprintf("run '%v': %v", mRun.RunID, mess))
+
+			continue
+		}
+
+		if err = dataStore.CloseMActiveRunPause(context.WithoutCancel(ctx), mRun.RunID); err != nil {
+			messages = append(messages, fmt.Sprintf("run '%v': %v", mRun.RunID, err))
+		}
+
+		stoppedRunIDs = append(stoppedRunIDs, mRun.RunID)
+	}
+
+	message = strings.Join(messages, "; ")
+	logger.Warnf(ctx, "Emergency stop is finished: runs %v, agents %v; '%v'", stoppedRunIDs, len(results), message)
+
+	return stoppedRunIDs, results, message
+}
+
+// stopAllAgentTasks остановка всех задач на всех агентах(и выключенных: задачи на них могли остаться)
+func stopAllAgentTasks(ctx context.Context, sshFallback bool, dataStore *data.Store, am *agent.Manager) (
+	results []*pb.AgentStopResult, err error) {
+	mAgents, err := dataStore.GetAllMAgents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results = make([]*pb.AgentStopResult, len(mAgents))
+	wg := sync.WaitGroup{}
+
+	for i, mAgent := range mAgents {
+		wg.Add(1)
+
+		go func(i int, mAgent *models.Agent) {
+			defer wg.Done()
+
+			results[i] = stopAgentTasks(ctx, mAgent, sshFallback, am)
+		}(i, mAgent)
+	}
+
+	wg.Wait()
+
+	return results, nil
+}
+
+func stopAgentTasks(ctx context.Context, mAgent *models.Agent, sshFallback bool, am *agent.Manager) (
+	result *pb.AgentStopResult) {
+	host := undecided.GetMHost(mAgent)
+	result = &pb.AgentStopResult{AgentId: mAgent.AgentID, Host: host}
+
+	rs, err := am.GetAllTasks(ctx, host)
+	if err != nil {
+		result.Error = fmt.Sprintf("get all tasks: %v", err)
+	} else {
+		result.TasksFound = int32(len(rs.GetTasks())) //nolint:gosec
+
+		var (
+			mu         sync.Mutex
+			wg         sync.WaitGroup
+			stopErrors []string
+		)
+
+		for pid := range rs.GetTasks() {
+			wg.Add(1)
+
+			go func(pid int64) {
+				defer wg.Done()
+
+				errStop := am.Stop(ctx, host, pid)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				// задача успела завершиться сама
+				if errStop == nil || strings.Contains(errStop.Error(), "no such test run") {
+					result.TasksStopped++
+
+					return
+				}
+
+				stopErrors = append(stopErrors, fmt.Sprintf("stop pid %v: %v", pid, errStop))
+			}(pid)
+		}
+
+		wg.Wait()
+
+		result.Error = strings.Join(stopErrors, "; ")
+	}
+
+	if result.GetError() == "" {
+		logger.Infof(ctx, "Emergency stop: agent '%v' tasks stopped %v of %v",
+			host, result.GetTasksStopped(), result.GetTasksFound())
+
+		return result
+	}
+
+	logger.Errorf(ctx, "Emergency stop: agent '%v': '%v'", host, result.GetError())
+
+	if sshFallback {
+		result.SshFallback = true
+		// у SSH свой таймаут подключения, он выполняется и после истечения таймаута агента
+		if err = ssh.SendCommand(context.WithoutCancel(ctx), "kill", mAgent.HostName); err != nil {
+			result.SshError = err.Error()
+		}
+	}
+
+	return result
+}
+
+// markRunStopped Run и его незавершенные ScriptRun`ы помечаются остановленными с причиной.
+// Команда остановки, созданная ScenarioToStop, для остановленного Run`а только завершается
+func markRunStopped(ctx context.Context, runID int32, reason string, pbStore *datapb.Store) (message string) {
+	pbRun, message := pbStore.GetRunning(ctx, runID)
+	if message != "" {
+		return message
+	}
+
+	for _, scriptRun := range pbRun.GetScriptRuns() {
+		if scriptRun.GetStatus() == pb.ScriptRun_STATUS_STOPPED_UNSPECIFIED ||
+			scriptRun.GetStatus() == pb.ScriptRun_STATUS_FAILED {
+			continue
+		}
+
+		scriptRun.Status = pb.ScriptRun_STATUS_STOPPED_UNSPECIFIED
+		scriptRun.Info = fmt.Sprintf("%s %s; ", scriptRun.GetInfo(), reason)
+
+		if scriptRun.GetMetrics() != nil {
+			scriptRun.Metrics.ExecutionStatus = "Interrupted"
+		}
+	}
+
+	pbRun.Status = pb.Run_STATUS_STOPPED_UNSPECIFIED
+	pbRun.Info = fmt.Sprintf("%s %s; ", pbRun.GetInfo(), reason)
+
+	_, message = pbStore.UpdatePbRunningInTheDB(ctx, pbRun)
+
+	return message
+}
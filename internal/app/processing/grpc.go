@@ -0,0 +1,200 @@
+package processing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing/upload"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/protoset"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// UploadGrpcDescriptor сохранение .proto(компилируется) или protoset в minio для gRPC простых скриптов
+func UploadGrpcDescriptor(ctx context.Context, request *pb.UploadGrpcDescriptorRequest, store *datapb.Store) (
+	descriptorURL string, services []*pb.GrpcService, message string) {
+	if len(request.GetFile()) == 0 {
+		return "", nil, "Error upload gRPC descriptor: file is empty"
+	}
+
+	project, err := store.GetDataStore().GetMProject(ctx, request.GetProjectId())
+	if err != nil {
+		message = fmt.Sprintf("Error upload gRPC descriptor, project '%v': %v", request.GetProjectId(), err)
+		logger.Errorf(ctx, message)
+
+		return "", nil, message
+	}
+
+	scenario, err := store.GetDataStore().GetMScenario(ctx, request.GetScenarioId())
+	if err != nil {
+		message = fmt.Sprintf("Error upload gRPC descriptor, scenario '%v': %v", request.GetScenarioId(), err)
+		logger.Errorf(ctx, message)
+
+		return "", nil, message
+	}
+
+	set, err := protoset.Compile(ctx, request.GetFileName(), request.GetFile())
+	if err != nil {
+		message = fmt.Sprintf("Error upload gRPC descriptor: %v", err)
+		logger.Warnf(ctx, message)
+
+		return "", nil, message
+	}
+
+	files, err := protoset.Files(set)
+	if err != nil {
+		message = fmt.Sprintf("Error upload gRPC descriptor: %v", err)
+		logger.Warnf(ctx, message)
+
+		return "", nil, message
+	}
+
+	services = grpcServicesToPb(protoset.Services(files))
+	if len(services) == 0 {
+		return "", nil, "Error upload gRPC descriptor: the file contains no services"
+	}
+
+	data, err := protoset.Marshal(set)
+	if err != nil {
+		return "", nil, err.Error()
+	}
+
+	descriptor, err := upload.DescriptorToUpload(ctx, request.GetFileName(), &data,
+		config.Get(ctx).MinioBucket, project.Title, scenario.Title)
+	if err != nil {
+		message = fmt.Sprintf("Error upload gRPC descriptor: %v", err)
+		logger.Errorf(ctx, message)
+
+		return "", nil, message
+	}
+
+	return descriptor.GetS3Url(), services, ""
+}
+
+// GetGrpcServices сервисы и методы загруженного набора дескрипторов
+func GetGrpcServices(ctx context.Context, descriptorURL string) (services []*pb.GrpcService, message string) {
+	files, err := loadGrpcDescriptor(ctx, descriptorURL)
+	if err != nil {
+		message = fmt.Sprintf("Error get gRPC services: %v", err)
+		logger.Warnf(ctx, message)
+
+		return nil, message
+	}
+
+	return grpcServicesToPb(protoset.Services(files)), ""
+}
+
+// checkGrpcSimpleScript проверка gRPC простого скрипта. При заданном наборе дескрипторов метод должен быть
+// unary, а статичный патрон - сообщением запроса метода
+func checkGrpcSimpleScript(ctx context.Context, simpleScript *pb.SimpleScript) error {
+	if simpleScript.GetProtocol() != pb.SimpleScript_PROTOCOL_GRPC {
+		return nil
+	}
+
+	if simpleScript.GetPath() == "" || simpleScript.GetGrpcService() == "" || simpleScript.GetGrpcMethod() == "" {
+		return errors.New("gRPC simple script requires path(host:port), grpc_service and grpc_method")
+	}
+
+	// http_method в БД - перечисление, для gRPC не используется
+	simpleScript.HttpMethod = "post"
+
+	// без дескрипторов используется server reflection, метод проверяется при запуске
+	if simpleScript.GetDescriptorUrl() == "" {
+		return nil
+	}
+
+	files, err := loadGrpcDescriptor(ctx, simpleScript.GetDescriptorUrl())
+	if err != nil {
+		return err
+	}
+
+	md, err := protoset.FindMethod(files, simpleScript.GetGrpcService(), simpleScript.GetGrpcMethod())
+	if err != nil {
+		return err
+	}
+
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return errors.Errorf("streaming method '%v' is not supported", md.FullName())
+	}
+
+	if simpleScript.GetIsStaticAmmo() && simpleScript.GetStaticAmmo() != "" {
+		if err = protoset.ValidateMessage(md.Input(), simpleScript.GetStaticAmmo()); err != nil {
+			return errors.Wrap(err, "invalid static ammo")
+		}
+	}
+
+	return nil
+}
+
+// grpcScriptAmmo патроны, встраиваемые в gRPC скрипт с набором дескрипторов: агент загружает protoset
+// вместо файла патронов
+func grpcScriptAmmo(ctx context.Context, simpleScript *pb.SimpleScript) (string, error) {
+	if simpleScript.GetProtocol() != pb.SimpleScript_PROTOCOL_GRPC || simpleScript.GetDescriptorUrl() == "" ||
+		simpleScript.GetIsStaticAmmo() {
+		return "", nil
+	}
+
+	if simpleScript.GetAmmoUrl() == "" {
+		return "", errors.New("gRPC simple script requires static ammo or ammo_url")
+	}
+
+	data, err := downloadBucketFile(ctx, simpleScript.GetAmmoUrl())
+	if err != nil {
+		return "", errors.Wrapf(err, "ammo '%v' is not loaded", simpleScript.GetAmmoUrl())
+	}
+
+	var ammo []json.RawMessage
+	if err = json.Unmarshal(data, &ammo); err != nil || len(ammo) == 0 {
+		return "", errors.Errorf("ammo '%v' is not a JSON array of messages", simpleScript.GetAmmoUrl())
+	}
+
+	var b bytes.Buffer
+	if err = json.Compact(&b, data); err != nil {
+		return "", errors.Wrapf(err, "ammo '%v' is not compacted", simpleScript.GetAmmoUrl())
+	}
+
+	return b.String(), nil
+}
+
+// loadGrpcDescriptor набор дескрипторов читается только из бакета MINIO_BUCKET: descriptor_url может прийти
+// в запросе, бэкенд не должен загружать по нему ресурсы внутренней сети
+func loadGrpcDescriptor(ctx context.Context, descriptorURL string) (*protoregistry.Files, error) {
+	if descriptorURL == "" {
+		return nil, errors.New("descriptor_url is empty")
+	}
+
+	data, err := downloadBucketFile(ctx, descriptorURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "descriptor '%v' is not loaded", descriptorURL)
+	}
+
+	return protoset.Unmarshal(data)
+}
+
+func grpcServicesToPb(services []protoset.Service) []*pb.GrpcService {
+	pbServices := make([]*pb.GrpcService, 0, len(services))
+
+	for _, service := range services {
+		pbService := &pb.GrpcService{Name: service.Name}
+
+		for _, method := range service.Methods {
+			pbService.Methods = append(pbService.Methods, &pb.GrpcMethod{
+				Name:            method.Name,
+				InputType:       method.InputType,
+				OutputType:      method.OutputType,
+				ClientStreaming: method.ClientStreaming,
+				ServerStreaming: method.ServerStreaming,
+			})
+		}
+
+		pbServices = append(pbServices, pbService)
+	}
+
+	return pbServices
+}
@@ -0,0 +1,394 @@
+package processing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/internal/app/processing/upload"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/aliexpressru/alilo-backend/pkg/util/httputil"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/minio"
+	strUtils "github.com/aliexpressru/alilo-backend/pkg/util/string"
+)
+
+const (
+	defaultImportDuration = "5m"
+	defaultImportSteps    = "1"
+	defaultImportMaxVUs   = "100"
+	maxImportNameLength   = 64
+)
+
+var (
+	// importSkippedHeaders заголовки, которые выставляет k6, и заголовки соединения браузера
+	importSkippedHeaders = []string{"host", "content-length", "connection", "accept-encoding", "transfer-encoding"}
+	// importCredentialHeaders заголовки с учетными данными пользователя, переносятся только с copy_credentials
+	importCredentialHeaders = []string{"cookie", "authorization", "proxy-authorization"}
+	// importMethods методы, поддерживаемые шаблоном простого скрипта(templateSimpleScript)
+	importMethods      = []string{"get", "post", "put", "patch"}
+	importNameReplacer = regexp.MustCompile(`_+`)
+)
+
+// importEndpoint эндпоинт(метод + URL без параметров), из которого при импорте создается простой скрипт
+type importEndpoint struct {
+	// Пустое - имя из метода и URL
+	name    string
+	request *curl.Request
+	// Уникальные тела запросов
+	bodies []string
+	// Вызовы эндпоинта в источнике, задают долю суммарного RPS
+	calls int
+}
+
+func (e *importEndpoint) key() string {
+	return strings.ToUpper(e.request.Method) + " " + e.request.URL
+}
+
+// groupImportRequests группировка запросов по методу, хосту и пути. Одинаковые тела не дублируются,
+// параметры и заголовки эндпоинта берутся из первого запроса
+func groupImportRequests(requests []*curl.Request) []*importEndpoint {
+	byKey := make(map[string]*importEndpoint)
+
+	var endpoints []*importEndpoint
+
+	for _, request := range requests {
+		endpoint, ok := byKey[(&importEndpoint{request: request}).key()]
+		if !ok {
+			endpoint = &importEndpoint{request: request}
+			byKey[endpoint.key()] = endpoint
+			endpoints = append(endpoints, endpoint)
+		}
+
+		endpoint.calls++
+		endpoint.addBody(request.Body)
+	}
+
+	return endpoints
+}
+
+func (e *importEndpoint) addBody(body string) {
+	if strings.TrimSpace(body) == "" {
+		return
+	}
+
+	// JSON сравнивается без форматирования
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, []byte(body)); err == nil {
+		body = compacted.String()
+	}
+
+	for _, b := range e.bodies {
+		if b == body {
+			return
+		}
+	}
+
+	e.bodies = append(e.bodies, body)
+}
+
+// importSimpleScripts создание простых скриптов из эндпоинтов в сценарии target. Суммарный RPS делится
+// пропорционально вызовам, эндпоинты, уже существующие в сценарии(метод + URL), пропускаются
+func importSimpleScripts(ctx context.Context, source string, target *pb.ImportTarget, endpoints []*importEndpoint,
+	dataStore *data.Store, store *datapb.Store) (
+	scenarioID int32, imported []*pb.ImportedSimpleScript, warnings []string, message string) {
+	if target.GetTotalRps() <= 0 {
+		return 0, nil, nil, fmt.Sprintf("Error import %v: total_rps '%v' must be positive", source, target.GetTotalRps())
+	}
+
+	if len(endpoints) == 0 {
+		return 0, nil, nil, fmt.Sprintf("Error import %v: no endpoints to import", source)
+	}
+
+	mScenario, message := getImportScenario(ctx, target, dataStore)
+	if message != "" {
+		return 0, nil, nil, message
+	}
+
+	mProject, err := dataStore.GetMProject(ctx, mScenario.ProjectID)
+	if err != nil {
+		return mScenario.ScenarioID, nil, nil, fmt.Sprintf("Error import %v: '%v'", source, err)
+	}
+
+	existing, _ := GetAllSimpleScript(ctx, mScenario.ScenarioID, store)
+	existingKeys := make(map[string]bool, len(existing))
+	names := make(map[string]bool, len(existing))
+
+	for _, simpleScript := range existing {
+		existingKeys[strings.ToUpper(simpleScript.GetHttpMethod())+" "+
+			simpleScript.GetScheme()+"://"+simpleScript.GetPath()] = true
+		names[simpleScript.GetName()] = true
+	}
+
+	var (
+		toCreate []*importEndpoint
+		calls    int
+	)
+
+	for _, endpoint := range endpoints {
+		if existingKeys[endpoint.key()] {
+			warnings = append(warnings, fmt.Sprintf("%v already exists in the scenario", endpoint.key()))
+
+			continue
+		}
+
+		toCreate = append(toCreate, endpoint)
+		calls += endpoint.calls
+	}
+
+	for _, endpoint := range toCreate {
+		result := &pb.ImportedSimpleScript{
+			HttpMethod: strings.ToLower(endpoint.request.Method),
+			Url:        endpoint.request.URL,
+			Calls:      int32(endpoint.calls), //nolint:gosec
+			Rps:        importRPS(target.GetTotalRps(), endpoint.calls, calls),
+			AmmoCount:  int32(len(endpoint.bodies)), //nolint:gosec
+		}
+		imported = append(imported, result)
+
+		simpleScript, headerWarnings, er := newImportedSimpleScript(ctx, source, endpoint, target, mScenario,
+			mProject.Title, result.GetRps(), names)
+		warnings = append(warnings, headerWarnings...)
+
+		if er != nil {
+			result.Error = er.Error()
+
+			continue
+		}
+
+		result.Name = simpleScript.GetName()
+
+		mess, simpleScriptID := CreateSimpleScript(ctx, simpleScript, store)
+		if mess != "" {
+			result.Error = mess
+
+			continue
+		}
+
+		result.SimpleScriptId = simpleScriptID
+	}
+
+	failed := 0
+
+	for _, result := range imported {
+		if result.GetError() != "" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		message = fmt.Sprintf("%v of %v endpoints are not imported", failed, len(imported))
+	}
+
+	logger.Infof(ctx, "Import %v to scenario '%v': %v endpoints, %v failed, %v warnings",
+		source, mScenario.ScenarioID, len(imported), failed, len(warnings))
+
+	return mScenario.ScenarioID, imported, warnings, message
+}
+
+// importRPS доля суммарного RPS эндпоинта пропорционально его вызовам, не меньше 1
+func importRPS(totalRps int32, calls int, totalCalls int) string {
+	rps := int(math.Round(float64(totalRps) * float64(calls) / float64(totalCalls)))
+
+	return strconv.Itoa(max(rps, 1))
+}
+
+// getImportScenario существующий сценарий target.scenario_id или новый сценарий target.scenario_title
+func getImportScenario(ctx context.Context, target *pb.ImportTarget, dataStore *data.Store) (
+	mScenario *models.Scenario, message string) {
+	scenarioID := target.GetScenarioId()
+
+	if scenarioID <= 0 {
+		if target.GetScenarioTitle() == "" {
+			return nil, "Error import: scenario_id or scenario_title is required"
+		}
+
+		var status bool
+
+		status, message, scenarioID = CreateScenario(ctx,
+			&pb.Scenario{ProjectId: target.GetProjectId(), Title: target.GetScenarioTitle()}, dataStore)
+		if !status {
+			return nil, message
+		}
+	}
+
+	mScenario, err := dataStore.GetMScenario(ctx, scenarioID)
+	if err != nil {
+		return nil, fmt.Sprintf("Error import: scenario '%v': '%v'", scenarioID, err)
+	}
+
+	return mScenario, message
+}
+
+// newImportedSimpleScript простой скрипт эндпоинта. Несколько тел запроса(или слишком длинное для static_ammo)
+// загружаются файлом патронов
+func newImportedSimpleScript(ctx context.Context, source string, endpoint *importEndpoint, target *pb.ImportTarget,
+	mScenario *models.Scenario, projectTitle string, rps string, names map[string]bool) (
+	simpleScript *pb.SimpleScript, warnings []string, err error) {
+	method := strings.ToLower(endpoint.request.Method)
+	if !slices.Contains(importMethods, method) {
+		return nil, nil, fmt.Errorf("method '%v' is not supported by simple scripts", endpoint.request.Method)
+	}
+
+	u, err := url.Parse(endpoint.request.URL)
+	if err != nil || u.Host == "" {
+		return nil, nil, fmt.Errorf("invalid URL '%v'", endpoint.request.URL)
+	}
+
+	simpleScript = &pb.SimpleScript{
+		Name:         importName(endpoint, u, names),
+		Description:  fmt.Sprintf("Imported from %v: %v", source, endpoint.key()),
+		ProjectId:    mScenario.ProjectID,
+		ScenarioId:   mScenario.ScenarioID,
+		Enabled:      true,
+		Tag:          target.GetTag(),
+		Scheme:       u.Scheme,
+		Path:         quoteSafe(u.Host + u.EscapedPath()),
+		HttpMethod:   method,
+		Headers:      make(map[string]string),
+		IsStaticAmmo: true,
+		Rps:          rps,
+		Duration:     stringOrDefault(target.GetDuration(), defaultImportDuration),
+		Steps:        stringOrDefault(target.GetSteps(), defaultImportSteps),
+		MaxVUs:       stringOrDefault(target.GetMaxVUs(), defaultImportMaxVUs),
+	}
+
+	for _, param := range endpoint.request.QueryParams {
+		simpleScript.QueryParams = append(simpleScript.QueryParams,
+			&pb.QueryParams{Key: quoteSafe(param.Key), Value: quoteSafe(param.Value)})
+	}
+
+	keys := make([]string, 0, len(endpoint.request.Headers))
+	for key := range endpoint.request.Headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := endpoint.request.Headers[key]
+
+		switch {
+		case strings.HasPrefix(key, ":") || slices.Contains(importSkippedHeaders, strings.ToLower(key)):
+			continue
+		case slices.Contains(importCredentialHeaders, strings.ToLower(key)) && !target.GetCopyCredentials():
+			// учетные данные из браузера не должны попадать в скрипты без явного запроса
+			warnings = append(warnings, fmt.Sprintf("%v: header '%v' is skipped: credentials are copied "+
+				"only with copy_credentials", endpoint.key(), key))
+
+			continue
+		case strings.ContainsAny(key+value, "'\\\n\r"):
+			// заголовки подставляются в скрипт в одинарных кавычках
+			warnings = append(warnings, fmt.Sprintf("%v: header '%v' is skipped: unsupported characters",
+				endpoint.key(), key))
+
+			continue
+		}
+
+		simpleScript.Headers[key] = value
+	}
+
+	if method == "get" || len(endpoint.bodies) == 0 {
+		return simpleScript, warnings, nil
+	}
+
+	for _, body := range endpoint.bodies {
+		if !json.Valid([]byte(body)) {
+			return nil, warnings, fmt.Errorf("request body is not JSON, simple scripts send JSON bodies only")
+		}
+	}
+
+	if len(endpoint.bodies) == 1 && len(endpoint.bodies[0]) <= config.Get(ctx).MaxStaticAmmoLength {
+		simpleScript.StaticAmmo = endpoint.bodies[0]
+
+		return simpleScript, warnings, nil
+	}
+
+	ammo := []byte("[" + strings.Join(endpoint.bodies, ",") + "]")
+
+	ammoFile, err := upload.AmmoToUpload(ctx, simpleScript.GetName(), &ammo, config.Get(ctx).MinioBucket,
+		simpleScript.GetDescription(), projectTitle, mScenario.Title, "application/json")
+	if err != nil {
+		return nil, warnings, fmt.Errorf("upload ammo: %w", err)
+	}
+
+	simpleScript.IsStaticAmmo = false
+	simpleScript.AmmoUrl = ammoFile.GetS3Url()
+
+	return simpleScript, warnings, nil
+}
+
+// importName уникальное в сценарии имя скрипта из метода и URL, имя используется как имя сценария k6
+func importName(endpoint *importEndpoint, u *url.URL, names map[string]bool) string {
+	name := endpoint.name
+	if name == "" {
+		name = strings.ToLower(endpoint.request.Method) + "_" + u.Host + u.Path
+	}
+
+	name = strUtils.ReplaceAllUnnecessarySymbols(name)
+	name = strings.Trim(importNameReplacer.ReplaceAllString(name, "_"), "_")
+
+	if len(name) > maxImportNameLength {
+		name = name[:maxImportNameLength]
+	}
+
+	// имя сценария k6 не может начинаться с цифры
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.ToLower(endpoint.request.Method) + "_" + name
+	}
+
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%v_%v", name, i)
+	}
+
+	names[unique] = true
+
+	return unique
+}
+
+// downloadBucketFile файл бакета MINIO_BUCKET по его URL(S3Url). Другие адреса не загружаются:
+// адрес приходит из запроса, бэкенд не должен ходить по нему во внутреннюю сеть
+func downloadBucketFile(ctx context.Context, fileURL string) ([]byte, error) {
+	name, ok := minio.ObjectName(ctx, fileURL)
+	if !ok {
+		return nil, fmt.Errorf("'%v' is not a file of bucket '%v'", fileURL, config.Get(ctx).MinioBucket)
+	}
+
+	return minio.GetBytes(ctx, name)
+}
+
+// downloadImportFile файл импорта из бакета MINIO_BUCKET или с хоста из IMPORT_ALLOWED_HOSTS
+func downloadImportFile(ctx context.Context, fileURL string) ([]byte, error) {
+	if _, ok := minio.ObjectName(ctx, fileURL); ok {
+		return downloadBucketFile(ctx, fileURL)
+	}
+
+	return httputil.GetFromHosts(ctx, fileURL, config.Get(ctx).ImportAllowedHosts)
+}
+
+// quoteSafe URL подставляется в скрипт в одинарных кавычках
+func quoteSafe(s string) string {
+	return strings.ReplaceAll(s, "'", "%27")
+}
+
+func stringOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
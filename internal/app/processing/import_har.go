@@ -0,0 +1,83 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/aliexpressru/alilo-backend/pkg/util/har"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+// ImportHAR импорт HAR браузера: запросы группируются по методу, хосту и пути, на каждый эндпоинт создается
+// простой скрипт. Тела запросов становятся патронами, доля суммарного RPS эндпоинта - доля его вызовов в HAR
+func ImportHAR(ctx context.Context, request *pb.ImportHARRequest, dataStore *data.Store, store *datapb.Store) (
+	scenarioID int32, imported []*pb.ImportedSimpleScript, warnings []string, message string) {
+	h, err := har.Parse([]byte(request.GetHar()))
+	if err != nil {
+		return 0, nil, nil, err.Error()
+	}
+
+	var (
+		requests    []*curl.Request
+		static      int
+		otherHosts  = make(map[string]bool)
+		invalidURLs int
+	)
+
+	for i := range h.Log.Entries {
+		entry := &h.Log.Entries[i]
+
+		u, er := url.Parse(entry.Request.URL)
+		if er != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			invalidURLs++
+
+			continue
+		}
+
+		if len(request.GetHosts()) > 0 && !slices.Contains(request.GetHosts(), u.Host) {
+			otherHosts[u.Host] = true
+
+			continue
+		}
+
+		if !request.GetIncludeStatic() && entry.IsStatic() {
+			static++
+
+			continue
+		}
+
+		requests = append(requests, entry.ToRequest())
+	}
+
+	if static > 0 {
+		warnings = append(warnings, fmt.Sprintf("%v static resource entries are skipped", static))
+	}
+
+	if invalidURLs > 0 {
+		warnings = append(warnings, fmt.Sprintf("%v entries without http(s) URL are skipped", invalidURLs))
+	}
+
+	if len(otherHosts) > 0 {
+		hosts := make([]string, 0, len(otherHosts))
+		for host := range otherHosts {
+			hosts = append(hosts, host)
+		}
+
+		slices.Sort(hosts)
+		warnings = append(warnings, fmt.Sprintf("entries of hosts %v are skipped", strings.Join(hosts, ", ")))
+	}
+
+	logger.Infof(ctx, "ImportHAR: %v of %v entries to import", len(requests), len(h.Log.Entries))
+
+	scenarioID, imported, importWarnings, message := importSimpleScripts(ctx, "HAR", request.GetTarget(),
+		groupImportRequests(requests), dataStore, store)
+
+	return scenarioID, imported, append(warnings, importWarnings...), message
+}
@@ -0,0 +1,140 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/openapi"
+)
+
+// GetOpenAPIOperations операции спецификации для выбора импортируемых(ImportOpenAPI)
+func GetOpenAPIOperations(ctx context.Context, spec string, specURL string) (
+	baseURL string, operations []*pb.OpenAPIOperation, message string) {
+	document, message := loadOpenAPI(ctx, spec, specURL)
+	if message != "" {
+		return "", nil, message
+	}
+
+	baseURL = openAPIBaseURL(document, "", specURL)
+
+	for _, endpoint := range document.Endpoints(baseURL) {
+		operations = append(operations, &pb.OpenAPIOperation{
+			Key:         endpoint.Key,
+			OperationId: endpoint.OperationID,
+			HttpMethod:  endpoint.Request.Method,
+			Path:        endpoint.Path,
+			Summary:     endpoint.Summary,
+			Tags:        endpoint.Tags,
+			Secured:     endpoint.Secured,
+		})
+	}
+
+	return baseURL, operations, message
+}
+
+// ImportOpenAPI импорт выбранных операций спецификации: на каждую операцию создается простой скрипт
+// с примерами параметров и тела из спецификации, суммарный RPS делится между операциями поровну
+func ImportOpenAPI(ctx context.Context, request *pb.ImportOpenAPIRequest, dataStore *data.Store,
+	store *datapb.Store) (scenarioID int32, imported []*pb.ImportedSimpleScript, warnings []string, message string) {
+	document, message := loadOpenAPI(ctx, request.GetSpec(), request.GetSpecUrl())
+	if message != "" {
+		return 0, nil, nil, message
+	}
+
+	baseURL := openAPIBaseURL(document, request.GetBaseUrl(), request.GetSpecUrl())
+	if u, err := url.Parse(baseURL); err != nil || u.Host == "" {
+		return 0, nil, nil, fmt.Sprintf("Error import OpenAPI: base URL '%v' must be absolute, set base_url", baseURL)
+	}
+
+	var (
+		endpoints []*importEndpoint
+		found     = make(map[string]bool)
+	)
+
+	for _, endpoint := range document.Endpoints(baseURL) {
+		if len(request.GetOperations()) > 0 &&
+			!slices.Contains(request.GetOperations(), endpoint.Key) &&
+			(endpoint.OperationID == "" || !slices.Contains(request.GetOperations(), endpoint.OperationID)) {
+			continue
+		}
+
+		found[endpoint.Key], found[endpoint.OperationID] = true, true
+
+		for _, warning := range endpoint.Warnings {
+			warnings = append(warnings, fmt.Sprintf("%v: %v", endpoint.Key, warning))
+		}
+
+		if endpoint.Secured {
+			warnings = append(warnings, fmt.Sprintf("%v: the operation requires authorization, add its headers",
+				endpoint.Key))
+		}
+
+		importEndpoint := &importEndpoint{name: endpoint.OperationID, request: endpoint.Request, calls: 1}
+		importEndpoint.addBody(endpoint.Request.Body)
+		endpoints = append(endpoints, importEndpoint)
+	}
+
+	for _, operation := range request.GetOperations() {
+		if !found[operation] {
+			warnings = append(warnings, fmt.Sprintf("operation '%v' is not found in the specification", operation))
+		}
+	}
+
+	logger.Infof(ctx, "ImportOpenAPI: %v operations to import, base URL '%v'", len(endpoints), baseURL)
+
+	scenarioID, imported, importWarnings, message := importSimpleScripts(ctx, "OpenAPI", request.GetTarget(),
+		endpoints, dataStore, store)
+
+	return scenarioID, imported, append(warnings, importWarnings...), message
+}
+
+// loadOpenAPI спецификация из запроса или загруженная по specURL
+func loadOpenAPI(ctx context.Context, spec string, specURL string) (document *openapi.Document, message string) {
+	data := []byte(spec)
+
+	if spec == "" {
+		if specURL == "" {
+			return nil, "Error import OpenAPI: spec or spec_url is required"
+		}
+
+		var err error
+
+		data, err = downloadImportFile(ctx, specURL)
+		if err != nil {
+			return nil, fmt.Sprintf("Error import OpenAPI: download '%v': '%v'", specURL, err)
+		}
+	}
+
+	document, err := openapi.Parse(data)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	return document, message
+}
+
+// openAPIBaseURL адрес сервиса из запроса или спецификации. Относительный адрес(servers: [{url: /api}])
+// отсчитывается от адреса спецификации
+func openAPIBaseURL(document *openapi.Document, baseURL string, specURL string) string {
+	if baseURL == "" {
+		baseURL = document.BaseURL()
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host != "" || specURL == "" {
+		return baseURL
+	}
+
+	base, err := url.Parse(specURL)
+	if err != nil || base.Host == "" {
+		return baseURL
+	}
+
+	return base.ResolveReference(&url.URL{Path: u.Path}).String()
+}
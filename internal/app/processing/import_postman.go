@@ -0,0 +1,110 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/postman"
+	"google.golang.org/protobuf/proto"
+)
+
+// ImportPostman импорт коллекции Postman: каждая папка с запросами - новый сценарий(или все запросы
+// в сценарий target.scenario_id), каждый запрос - простой скрипт. Переменные коллекции подставляются
+// в запросы, в additional_env скриптов они не передаются: подставленные значения там уже не используются
+func ImportPostman(ctx context.Context, request *pb.ImportPostmanRequest, dataStore *data.Store,
+	store *datapb.Store) (scenarios []*pb.ImportedScenario, warnings []string, message string) {
+	collection, err := postman.Parse([]byte(request.GetCollection()))
+	if err != nil {
+		return nil, nil, err.Error()
+	}
+
+	target := request.GetTarget()
+	if target == nil {
+		target = &pb.ImportTarget{}
+	}
+
+	folders := collection.Folders()
+	if target.GetScenarioId() > 0 {
+		merged := &postman.Folder{}
+		for _, folder := range folders {
+			merged.Requests = append(merged.Requests, folder.Requests...)
+		}
+
+		folders = []*postman.Folder{merged}
+	}
+
+	var messages []string
+
+	for _, folder := range folders {
+		folderTarget, _ := proto.Clone(target).(*pb.ImportTarget)
+		if target.GetScenarioId() <= 0 {
+			folderTarget.ScenarioTitle = postmanScenarioTitle(target.GetScenarioTitle(), collection.Info.Name, folder.Path)
+		}
+
+		endpoints, folderWarnings := postmanEndpoints(folder)
+		warnings = append(warnings, folderWarnings...)
+
+		scenarioID, imported, importWarnings, mess := importSimpleScripts(ctx, "Postman", folderTarget, endpoints,
+			dataStore, store)
+		warnings = append(warnings, importWarnings...)
+
+		scenarios = append(scenarios, &pb.ImportedScenario{
+			ScenarioId:    scenarioID,
+			Title:         folderTarget.GetScenarioTitle(),
+			SimpleScripts: imported,
+			Message:       mess,
+		})
+
+		if mess != "" {
+			messages = append(messages, fmt.Sprintf("scenario '%v': %v", folderTarget.GetScenarioTitle(), mess))
+		}
+	}
+
+	message = strings.Join(messages, "; ")
+	logger.Infof(ctx, "ImportPostman '%v': %v scenarios; '%v'", collection.Info.Name, len(scenarios), message)
+
+	return scenarios, warnings, message
+}
+
+// postmanEndpoints эндпоинты папки с именами запросов Postman, одинаковые метод и URL объединяются
+func postmanEndpoints(folder *postman.Folder) (
+	endpoints []*importEndpoint, warnings []string) {
+	requests := make([]*curl.Request, 0, len(folder.Requests))
+	names := make(map[*curl.Request]string, len(folder.Requests))
+
+	for _, named := range folder.Requests {
+		requests = append(requests, named.Request)
+		names[named.Request] = named.Name
+
+		for _, warning := range named.Warnings {
+			warnings = append(warnings, fmt.Sprintf("%v: %v", named.Name, warning))
+		}
+	}
+
+	endpoints = groupImportRequests(requests)
+	for _, endpoint := range endpoints {
+		endpoint.name = names[endpoint.request]
+	}
+
+	return endpoints, warnings
+}
+
+// postmanScenarioTitle название сценария папки: префикс и путь папки, для корня коллекции - название коллекции
+func postmanScenarioTitle(prefix string, collectionName string, path []string) string {
+	parts := path
+	if len(parts) == 0 {
+		parts = []string{collectionName}
+	}
+
+	if prefix != "" {
+		parts = append([]string{prefix}, parts...)
+	}
+
+	return strings.Join(parts, " / ")
+}
@@ -0,0 +1,129 @@
+package processing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+)
+
+func TestGroupImportRequests(t *testing.T) {
+	requests := []*curl.Request{
+		{Method: "GET", URL: "https://example.com/items", QueryParams: []curl.QueryParams{{Key: "page", Value: "1"}}},
+		{Method: "POST", URL: "https://example.com/items", Body: `{"name": "a"}`},
+		{Method: "GET", URL: "https://example.com/items", QueryParams: []curl.QueryParams{{Key: "page", Value: "2"}}},
+		{Method: "POST", URL: "https://example.com/items", Body: `{"name":"a"}`},
+		{Method: "post", URL: "https://example.com/items", Body: `{"name":"b"}`},
+		{Method: "GET", URL: "https://example.com/users"},
+	}
+
+	endpoints := groupImportRequests(requests)
+
+	type endpointSummary struct {
+		key    string
+		calls  int
+		bodies []string
+		page   string
+	}
+
+	var got []endpointSummary
+	for _, endpoint := range endpoints {
+		summary := endpointSummary{key: endpoint.key(), calls: endpoint.calls, bodies: endpoint.bodies}
+		if len(endpoint.request.QueryParams) > 0 {
+			summary.page = endpoint.request.QueryParams[0].Value
+		}
+
+		got = append(got, summary)
+	}
+
+	want := []endpointSummary{
+		// параметры берутся из первого запроса
+		{key: "GET https://example.com/items", calls: 2, page: "1"},
+		// одинаковый JSON без учета форматирования не дублируется
+		{key: "POST https://example.com/items", calls: 3, bodies: []string{`{"name":"a"}`, `{"name":"b"}`}},
+		{key: "GET https://example.com/users", calls: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groupImportRequests() = %+v, want %+v", got, want)
+	}
+}
+
+func TestImportRPS(t *testing.T) {
+	tests := []struct {
+		name       string
+		totalRps   int32
+		calls      int
+		totalCalls int
+		want       string
+	}{
+		{name: "single endpoint", totalRps: 100, calls: 5, totalCalls: 5, want: "100"},
+		{name: "proportional", totalRps: 100, calls: 3, totalCalls: 4, want: "75"},
+		{name: "rounded", totalRps: 10, calls: 1, totalCalls: 3, want: "3"},
+		{name: "at least one", totalRps: 10, calls: 1, totalCalls: 100, want: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := importRPS(tt.totalRps, tt.calls, tt.totalCalls); got != tt.want {
+				t.Errorf("importRPS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewImportedSimpleScript_credentials(t *testing.T) {
+	endpoint := &importEndpoint{
+		request: &curl.Request{
+			Method: "GET",
+			URL:    "https://example.com/items",
+			Headers: curl.Headers{
+				"Accept":        "application/json",
+				"Authorization": "Bearer secret",
+				"Cookie":        "session=secret",
+				"Host":          "example.com",
+			},
+		},
+		calls: 1,
+	}
+	mScenario := &models.Scenario{ScenarioID: 1, ProjectID: 1}
+
+	tests := []struct {
+		name         string
+		target       *pb.ImportTarget
+		wantHeaders  map[string]string
+		wantWarnings int
+	}{
+		{
+			name:         "credentials are skipped",
+			target:       &pb.ImportTarget{},
+			wantHeaders:  map[string]string{"Accept": "application/json"},
+			wantWarnings: 2,
+		},
+		{
+			name:   "credentials are copied on request",
+			target: &pb.ImportTarget{CopyCredentials: true},
+			wantHeaders: map[string]string{
+				"Accept": "application/json", "Authorization": "Bearer secret", "Cookie": "session=secret",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simpleScript, warnings, err := newImportedSimpleScript(context.Background(), "HAR", endpoint, tt.target,
+				mScenario, "project", "1", map[string]bool{})
+			if err != nil {
+				t.Fatalf("newImportedSimpleScript() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(simpleScript.GetHeaders(), tt.wantHeaders) {
+				t.Errorf("newImportedSimpleScript() headers = %v, want %v", simpleScript.GetHeaders(), tt.wantHeaders)
+			}
+
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("newImportedSimpleScript() warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -2,28 +2,136 @@ package processing
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	curlUtils "github.com/aliexpressru/alilo-backend/pkg/util/curl"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 )
 
-func ParseCUrl(ctx context.Context, curl string) (json, message string) {
-	defer func() {
-		if err := recover(); err != nil {
-			message = fmt.Sprintf("recover. ParseCUrl failed: %+v", err)
-			logger.Errorf(ctx, "ParseCUrl: '%+v'", message)
-		}
-	}()
+func ParseCUrl(ctx context.Context, curl string) (requestJSON string, warnings []*pb.CUrlWarning, message string) {
 	logger.Infof(ctx, "ParseCUrl. Send data: '%+v'", curl)
 
-	parse, result := curlUtils.Parse(ctx, curl)
-	if result {
-		j := parse.ToJSON(true)
-		logger.Infof(ctx, "Parse Curl to json: %+v", j)
+	parse, parseWarnings, err := curlUtils.Parse(curl)
+	if err != nil {
+		return "", nil, err.Error()
+	}
+
+	for _, warning := range parseWarnings {
+		warnings = append(warnings, &pb.CUrlWarning{Flag: warning.Flag, Message: warning.Message})
+	}
+
+	requestJSON = parse.ToJSON(true)
+	logger.Infof(ctx, "Parse Curl to json: %+v; warnings: %+v", requestJSON, parseWarnings)
+
+	return requestJSON, warnings, message
+}
+
+// ImportCUrl импорт команд cURL: одинаковые метод и URL - один простой скрипт, тела запросов - его патроны
+func ImportCUrl(ctx context.Context, request *pb.ImportCUrlRequest, dataStore *data.Store, store *datapb.Store) (
+	scenarioID int32, imported []*pb.ImportedSimpleScript, warnings []string, message string) {
+	requests := make([]*curlUtils.Request, 0, len(request.GetCurls()))
+
+	for i, curl := range request.GetCurls() {
+		parse, parseWarnings, err := curlUtils.Parse(curl)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("cURL #%v is skipped: %v", i+1, err))
+
+			continue
+		}
+
+		for _, warning := range parseWarnings {
+			warnings = append(warnings, fmt.Sprintf("cURL #%v: %v: %v", i+1, warning.Flag, warning.Message))
+		}
+
+		requests = append(requests, parse)
+	}
+
+	scenarioID, imported, importWarnings, message := importSimpleScripts(ctx, "cURL", request.GetTarget(),
+		groupImportRequests(requests), dataStore, store)
+
+	return scenarioID, imported, append(warnings, importWarnings...), message
+}
+
+// ExportCUrl команда cURL одного запроса простого скрипта. Тело - static_ammo или первый патрон файла ammo_url
+func ExportCUrl(ctx context.Context, request *pb.ExportCUrlRequest, store *datapb.Store) (
+	curl string, warnings []string, message string) {
+	simpleScript := request.GetSimpleScript()
+
+	if request.GetSimpleScriptId() > 0 {
+		simpleScript, message = GetSimpleScript(ctx, request.GetSimpleScriptId(), store)
+		if message != "" {
+			return "", nil, message
+		}
+	}
+
+	if simpleScript == nil || simpleScript.GetPath() == "" {
+		return "", nil, "Error export cURL: simple_script_id or simple_script with path is required"
+	}
+
+	if simpleScript.GetProtocol() == pb.SimpleScript_PROTOCOL_GRPC {
+		return "", nil, "Error export cURL: gRPC simple script cannot be exported"
+	}
+
+	scheme := simpleScript.GetScheme()
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	curlRequest := &curlUtils.Request{
+		Method:  strings.ToUpper(simpleScript.GetHttpMethod()),
+		URL:     scheme + "://" + simpleScript.GetPath(),
+		Headers: curlUtils.Headers(simpleScript.GetHeaders()),
+	}
+
+	for _, param := range simpleScript.GetQueryParams() {
+		curlRequest.QueryParams = append(curlRequest.QueryParams,
+			curlUtils.QueryParams{Key: param.GetKey(), Value: param.GetValue()})
+	}
+
+	if _, err := url.Parse(curlRequest.FullURL()); err != nil {
+		warnings = append(warnings, fmt.Sprintf("URL '%v' is invalid: %v", curlRequest.FullURL(), err))
+	}
+
+	// шаблон простого скрипта отправляет тело во всех методах, кроме get
+	if curlRequest.Method != "GET" {
+		body, warning := exportCUrlBody(ctx, simpleScript)
+		curlRequest.Body = body
+
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return curlRequest.ToCUrl(), warnings, message
+}
+
+// exportCUrlBody тело запроса. Файл патронов читается только из бакета MINIO_BUCKET: ammo_url может прийти
+// в запросе, бэкенд не должен загружать по нему ресурсы внутренней сети
+func exportCUrlBody(ctx context.Context, simpleScript *pb.SimpleScript) (body string, warning string) {
+	if simpleScript.GetIsStaticAmmo() {
+		return simpleScript.GetStaticAmmo(), ""
+	}
+
+	if simpleScript.GetAmmoUrl() == "" {
+		return "", "the script has no ammo, the body is empty"
+	}
+
+	data, err := downloadBucketFile(ctx, simpleScript.GetAmmoUrl())
+	if err != nil {
+		return "", fmt.Sprintf("ammo '%v' is not loaded: %v", simpleScript.GetAmmoUrl(), err)
+	}
 
-		return j, message
+	var ammo []json.RawMessage
+	if err = json.Unmarshal(data, &ammo); err != nil || len(ammo) == 0 {
+		return "", fmt.Sprintf("ammo '%v' is not a JSON array of bodies", simpleScript.GetAmmoUrl())
 	}
 
-	return "", "Error parse"
+	// шаблон отправляет патрон через JSON.stringify
+	return string(ammo[0]), ""
 }
@@ -68,6 +68,10 @@ func ScenarioToRunning(ctx context.Context,
 		percentageOfTarget = 100
 	}
 
+	if undecided.UserFromContext(ctx) == "" {
+		ctx = undecided.ContextWithUser(ctx, userName)
+	}
+
 	scenarioToRun, scenarioMessage := pbStore.GetScenario(ctx, scenarioID)
 
 	scriptsToRun, er := GetAllEnabledScripts(ctx, scenarioID, pbStore)
@@ -97,6 +101,18 @@ func ScenarioToRunning(ctx context.Context,
 		return returnRun, conditionMessage
 	}
 
+	// агенты, забронированные другими пользователями под крупные запуски, недоступны для этого запуска
+	tagScripts, err := scriptsByTag(ctx, scriptsToRun, simpleScriptsToRun, pbStore.GetDataStore())
+	if err != nil {
+		return returnRun, err.Error()
+	}
+
+	if message = checkAgentReservations(ctx, scenarioID, tagScripts, pbStore.GetDataStore()); message != "" {
+		logger.Warnf(ctx, "Agent reservations: %v", message)
+
+		return returnRun, message
+	}
+
 	mRun, err := pbStore.GetDataStore().CreateNewMRun(
 		ctx,
 		scenarioToRun.GetProjectId(),
@@ -960,7 +976,7 @@ func IsChangeLoadLevel(ctx context.Context, runID int32, store *datapb.Store) (*
 	case pb.Run_STATUS_PREPARED:
 		resp.CanChange = false
 		resp.NextLevel = pbRun.PercentageOfTarget
-	case pb.Run_STATUS_STOPPING, pb.Run_STATUS_STOPPED_UNSPECIFIED:
+	case pb.Run_STATUS_STOPPING, pb.Run_STATUS_STOPPED_UNSPECIFIED, pb.Run_STATUS_PAUSED:
 		resp.CanChange = false
 	case pb.Run_STATUS_RUNNING:
 		if command == nil {
@@ -0,0 +1,182 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
+
+	dataPb "github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/minio"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+	"google.golang.org/protobuf/proto"
+)
+
+const logContentType = "text/plain"
+
+// GetScriptRunLogs лог ScriptRun`а с агента, если агент его еще отдает, иначе - из архива в MinIO
+func GetScriptRunLogs(ctx context.Context, request *pb.GetScriptRunLogsRequest, store *dataPb.Store,
+	am *agent.Manager) (rs *pb.GetScriptRunLogsResponse, message string) {
+	rs = &pb.GetScriptRunLogsResponse{}
+
+	scriptRun, message := store.GetScriptRunning(ctx, request.GetRunId(), request.GetRunScriptId())
+	if message != "" {
+		return rs, message
+	} else if scriptRun == nil {
+		return rs, fmt.Sprintf("ScriptRun '%v' not found in Run '%v'", request.GetRunScriptId(), request.GetRunId())
+	}
+
+	rs.LogArchiveKey = scriptRun.GetLogArchiveKey()
+
+	// у запущенного ScriptRun`а лог растет, архив - только после остановки
+	if scriptRun.GetStatus() == pb.ScriptRun_STATUS_RUNNING || scriptRun.GetLogArchiveKey() == "" {
+		rsLogs, err := getAgentTaskLogs(ctx, scriptRun, request.GetHead(), request.GetLen(), am)
+		if err == nil {
+			rs.Log = rsLogs
+			rs.Source = pb.GetScriptRunLogsResponse_SOURCE_AGENT_UNSPECIFIED
+
+			return rs, ""
+		}
+
+		if scriptRun.GetLogArchiveKey() == "" {
+			return rs, fmt.Sprintf("Getting the log from agent '%v': '%v'", scriptRun.GetAgent().GetHostName(), err)
+		}
+
+		logger.Warnf(ctx, "Getting the log from agent '%v', reading the archive: '%v'",
+			scriptRun.GetAgent().GetHostName(), err)
+	}
+
+	data, err := minio.GetBytes(ctx, scriptRun.GetLogArchiveKey())
+	if err != nil {
+		// архив загружается в фоне после остановки и может быть еще не готов
+		rsLogs, er := getAgentTaskLogs(ctx, scriptRun, request.GetHead(), request.GetLen(), am)
+		if er != nil {
+			return rs, fmt.Sprintf("Getting the archived log: '%v'", err)
+		}
+
+		rs.Log = rsLogs
+		rs.Source = pb.GetScriptRunLogsResponse_SOURCE_AGENT_UNSPECIFIED
+
+		return rs, ""
+	}
+
+	rs.Log = cutLogLines(string(data), request.GetHead(), request.GetLen())
+	rs.Source = pb.GetScriptRunLogsResponse_SOURCE_ARCHIVE
+
+	return rs, ""
+}
+
+// ArchiveScriptRunLog сохранение лога остановленного ScriptRun`а в MinIO,
+// чтобы лог оставался доступен после очистки логов на агенте(RemoveLogs).
+// Ключ архива записывается в ScriptRun сразу, загрузка выполняется в фоне, чтобы не задерживать остановку.
+// Если загрузить лог не удалось, ключ удаляется из ScriptRun`а в БД
+func ArchiveScriptRunLog(ctx context.Context, scriptRun *pb.ScriptRun, am *agent.Manager, store *dataPb.Store) {
+	if scriptRun.GetLogArchiveKey() != "" || scriptRun.GetAgent() == nil ||
+		(scriptRun.GetPid() == 0 && scriptRun.GetLogFileName() == "") {
+		return
+	}
+
+	scriptRun.LogArchiveKey = logArchiveKey(scriptRun)
+
+	archived := proto.Clone(scriptRun).(*pb.ScriptRun)
+	ctx = context.WithoutCancel(ctx)
+
+	exePool.Go(func() {
+		defer func() {
+			if err := recover(); err != nil {
+				logger.Errorf(ctx, "ArchiveScriptRunLog failed: '%+v'", err)
+			}
+		}()
+
+		if err := uploadScriptRunLog(ctx, archived, am); err != nil {
+			logger.Warnf(ctx, "Archiving the log of ScriptRun %v/%v: '%v'",
+				archived.GetRunId(), archived.GetRunScriptId(), err)
+			resetLogArchiveKey(ctx, archived, store)
+
+			return
+		}
+
+		logger.Infof(ctx, "Log of ScriptRun %v/%v archived: '%v'",
+			archived.GetRunId(), archived.GetRunScriptId(), archived.GetLogArchiveKey())
+	})
+}
+
+// logArchiveKey ключ архива лога одного запуска(pid) ScriptRun`а: после паузы или переноса у ScriptRun`а новый лог
+func logArchiveKey(scriptRun *pb.ScriptRun) string {
+	logName := path.Base(scriptRun.GetLogFileName())
+	if logName == "." || logName == "/" {
+		logName = "k6.log"
+	}
+
+	return fmt.Sprintf("logs/run_%v/script_run_%v_%v_%v",
+		scriptRun.GetRunId(), scriptRun.GetRunScriptId(), scriptRun.GetPid(), logName)
+}
+
+func uploadScriptRunLog(ctx context.Context, scriptRun *pb.ScriptRun, am *agent.Manager) error {
+	log, err := getAgentTaskLogs(ctx, scriptRun, false, 0, am)
+	if err != nil {
+		return err
+	}
+
+	return minio.PutBytes(ctx, scriptRun.GetLogArchiveKey(), []byte(log), logContentType)
+}
+
+// resetLogArchiveKey удаление ключа не загруженного архива, если ScriptRun не перезапущен и ключ не заменен
+func resetLogArchiveKey(ctx context.Context, archived *pb.ScriptRun, store *dataPb.Store) {
+	actual, message := store.GetScriptRunning(ctx, archived.GetRunId(), archived.GetRunScriptId())
+	if message != "" || actual == nil || actual.GetLogArchiveKey() != archived.GetLogArchiveKey() {
+		return
+	}
+
+	actual.LogArchiveKey = ""
+	if message = store.UpdatePbScriptRunInDB(ctx, actual.GetRunId(), actual); message != "" {
+		logger.Warnf(ctx, "Resetting the log archive key of ScriptRun %v/%v: '%v'",
+			actual.GetRunId(), actual.GetRunScriptId(), message)
+	}
+}
+
+func getAgentTaskLogs(ctx context.Context, scriptRun *pb.ScriptRun, head bool, length int64, am *agent.Manager) (
+	string, error) {
+	if scriptRun.GetAgent() == nil {
+		return "", fmt.Errorf("script run has no agent")
+	}
+
+	rs, err := am.GetTaskLogs(ctx, undecided.GetPBHost(scriptRun.GetAgent()), &agentapi.GetTaskLogsRequest{
+		Pid:  scriptRun.GetPid(),
+		Head: head,
+		Len:  length,
+		Name: scriptRun.GetLogFileName(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rs.GetLog(), nil
+}
+
+// cutLogLines первые(head) или последние length строк, 0 - лог целиком
+func cutLogLines(log string, head bool, length int64) string {
+	if length <= 0 {
+		return log
+	}
+
+	lines := strings.SplitAfter(log, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if int64(len(lines)) <= length {
+		return log
+	}
+
+	if head {
+		return strings.Join(lines[:length], "")
+	}
+
+	return strings.Join(lines[int64(len(lines))-length:], "")
+}
@@ -0,0 +1,110 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+// ScenarioToPause создание команды на приостановку запущенного Run`а.
+// Скрипты останавливает КомандПроцессор, запоминая их RPS, Run получает статус STATUS_PAUSED
+func ScenarioToPause(ctx context.Context, runID int32, dataStore *data.Store) (returnRun *pb.Run, message string) {
+	mRun, err := dataStore.GetMRunning(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error getting Running '%v' -> %v", runID, err.Error())
+		logger.Warn(ctx, message)
+
+		return nil, message
+	}
+
+	if mRun.Status != models.EstatusSTATUS_RUNNING {
+		message = fmt.Sprintf("Only a running Run can be paused, Run '%v' status '%v'", runID, mRun.Status)
+		logger.Warn(ctx, message)
+
+		return nil, message
+	}
+
+	cmd, err := dataStore.NewMCmdPauseScenario(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error created new command pause scenario: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	logger.Infof(ctx, "Created new pause command:'%v'", cmd.CommandID)
+
+	returnRun, err = conv.ModelToPBRun(ctx, mRun)
+	if err != nil {
+		message = fmt.Sprintf("ScenarioToPause -> ModelToPBRun error: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return returnRun, message
+}
+
+// ScenarioToResume создание команды на продолжение приостановленного Run`а с нагрузкой на момент паузы
+func ScenarioToResume(ctx context.Context, runID int32, dataStore *data.Store) (
+	returnRun *pb.Run, runPause *pb.RunPause, message string) {
+	mRun, err := dataStore.GetMRunning(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error getting Running '%v' -> %v", runID, err.Error())
+		logger.Warn(ctx, message)
+
+		return nil, nil, message
+	}
+
+	if mRun.Status != models.EstatusSTATUS_PAUSED {
+		message = fmt.Sprintf("Only a paused Run can be resumed, Run '%v' status '%v'", runID, mRun.Status)
+		logger.Warn(ctx, message)
+
+		return nil, nil, message
+	}
+
+	mRunPause, err := dataStore.GetMActiveRunPause(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error getting pause of Run '%v': '%v'", runID, err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, nil, message
+	}
+
+	if mRunPause == nil {
+		message = fmt.Sprintf("Run '%v' has no active pause", runID)
+		logger.Warn(ctx, message)
+
+		return nil, nil, message
+	}
+
+	runPause, message = conv.ModelToPBRunPause(ctx, mRunPause)
+	if message != "" {
+		return nil, nil, message
+	}
+
+	cmd, err := dataStore.NewMCmdResumeScenario(ctx, runID)
+	if err != nil {
+		message = fmt.Sprintf("Error created new command resume scenario: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, nil, message
+	}
+
+	logger.Infof(ctx, "Created new resume command:'%v'", cmd.CommandID)
+
+	returnRun, err = conv.ModelToPBRun(ctx, mRun)
+	if err != nil {
+		message = fmt.Sprintf("ScenarioToResume -> ModelToPBRun error: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, nil, message
+	}
+
+	return returnRun, runPause, message
+}
@@ -52,6 +52,20 @@ func CreateScenario(ctx context.Context, scenario *pb.Scenario, dataStore *data.
 		return false, message, scenarioID
 	}
 
+	if scenario.GetMaxFailovers() < 0 {
+		message = fmt.Sprintf("Error create scenario: max_failovers '%v' cannot be negative", scenario.GetMaxFailovers())
+		logger.Error(ctx, message)
+
+		return false, message, scenarioID
+	}
+
+	if err := CheckLoadProfile(scenario.GetLoadProfile()); err != nil {
+		message = fmt.Sprintf("Error create scenario: '%v'", err.Error())
+		logger.Error(ctx, message)
+
+		return false, message, scenarioID
+	}
+
 	scenario.Title = strUtils.ReplaceAllUnnecessarySymbols(scenario.GetTitle())
 
 	mScenario, message := conv.PBToModelScenario(ctx, scenario)
@@ -82,6 +96,20 @@ func UpdateScenario(ctx context.Context, scenario *pb.Scenario, pbStore *datapb.
 		return false, invalidNameMessage
 	}
 
+	if scenario.GetMaxFailovers() < 0 {
+		message = fmt.Sprintf("Error update scenario: max_failovers '%v' cannot be negative", scenario.GetMaxFailovers())
+		logger.Error(ctx, message)
+
+		return false, message
+	}
+
+	if err := CheckLoadProfile(scenario.GetLoadProfile()); err != nil {
+		message = fmt.Sprintf("Error update scenario: '%v'", err.Error())
+		logger.Error(ctx, message)
+
+		return false, message
+	}
+
 	scenario.Title = strUtils.ReplaceAllUnnecessarySymbols(scenario.GetTitle())
 
 	err := pbStore.UpdateScenario(ctx, scenario)
@@ -0,0 +1,202 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	"github.com/aliexpressru/alilo-backend/internal/app/datapb"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+const maxPercentageOfTarget = 10000
+
+func GetAllSchedules(ctx context.Context, scenarioID int32, limit int32, number int32, store *datapb.Store) (
+	schedules []*pb.Schedule, pages int64, message string) {
+	if limit < 1 {
+		countSchedules, _ := store.GetDataStore().GetCountSchedules(ctx, scenarioID)
+		//nolint:gosec
+		limit = int32(countSchedules)
+	}
+
+	return store.GetAllSchedules(ctx, scenarioID, limit, number)
+}
+
+// CreateSchedule создание расписания. Новое расписание всегда включено,
+// время ближайшего запуска рассчитывается по cron или берется из run_at
+func CreateSchedule(ctx context.Context, schedule *pb.Schedule, dataStore *data.Store) (
+	status bool, message string, scheduleID int32) {
+	mSchedule, message := conv.PBScheduleToModel(ctx, schedule)
+	if message != "" {
+		message = fmt.Sprintf("Error create schedule: '%v'", message)
+		logger.Errorf(ctx, message)
+
+		return false, message, 0
+	}
+
+	mSchedule.Enabled = true
+	mSchedule.LastRunAt = null.Time{}
+	mSchedule.LastRunID = 0
+	mSchedule.LastMessage = ""
+
+	if err := prepareMSchedule(ctx, mSchedule, dataStore, time.Now().UTC()); err != nil {
+		message = fmt.Sprintf("Error create schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return false, message, 0
+	}
+
+	mSchedule, err := dataStore.CreateMSchedule(ctx, mSchedule)
+	if err != nil {
+		message = fmt.Sprintf("Error create schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return false, message, 0
+	}
+
+	logger.Infof(ctx, "Created schedule '%v', next run at '%v'", mSchedule.ScheduleID, mSchedule.NextRunAt.Time)
+
+	return true, message, mSchedule.ScheduleID
+}
+
+// UpdateSchedule обновление редактируемых полей расписания с пересчетом времени ближайшего запуска
+func UpdateSchedule(ctx context.Context, schedule *pb.Schedule, dataStore *data.Store) (status bool, message string) {
+	if schedule == nil {
+		return false, "Error update schedule: pb schedule is nil"
+	}
+
+	mSchedule, err := dataStore.GetMSchedule(ctx, schedule.GetScheduleId())
+	if err != nil {
+		message = fmt.Sprintf("Error update schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return false, message
+	}
+
+	updated, message := conv.PBScheduleToModel(ctx, schedule)
+	if message != "" {
+		message = fmt.Sprintf("Error update schedule: '%v'", message)
+		logger.Errorf(ctx, message)
+
+		return false, message
+	}
+
+	mSchedule.ScenarioID = updated.ScenarioID
+	mSchedule.Title = updated.Title
+	mSchedule.Cron = updated.Cron
+	mSchedule.RunAt = updated.RunAt
+	mSchedule.PercentageOfTarget = updated.PercentageOfTarget
+	mSchedule.UserName = updated.UserName
+	mSchedule.PreferredUserName = updated.PreferredUserName
+	mSchedule.Enabled = updated.Enabled
+
+	if err = prepareMSchedule(ctx, mSchedule, dataStore, time.Now().UTC()); err != nil {
+		message = fmt.Sprintf("Error update schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return false, message
+	}
+
+	if err = dataStore.UpdateMSchedule(ctx, mSchedule); err != nil {
+		message = fmt.Sprintf("Error update schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return false, message
+	}
+
+	return true, message
+}
+
+func DeleteSchedule(ctx context.Context, scheduleID int32, dataStore *data.Store) (status bool, message string) {
+	status, err := dataStore.DeleteMSchedule(ctx, scheduleID)
+	if err != nil {
+		message = fmt.Sprintf("Error delete schedule: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+	}
+
+	return status, message
+}
+
+// prepareMSchedule валидация расписания и расчет времени ближайшего запуска
+func prepareMSchedule(ctx context.Context, mSchedule *models.Schedule, dataStore *data.Store, now time.Time) error {
+	mScenario, err := dataStore.GetMScenario(ctx, mSchedule.ScenarioID)
+	if err != nil {
+		return errors.Wrapf(err, "scenario '%v' not found", mSchedule.ScenarioID)
+	}
+
+	mSchedule.ProjectID = mScenario.ProjectID
+	if mSchedule.Title == "" {
+		mSchedule.Title = mScenario.Title
+	}
+
+	return checkMSchedule(mSchedule, now)
+}
+
+// checkMSchedule значения по умолчанию, проверка расписания и время ближайшего запуска.
+// Включенный разовый запуск должен быть в будущем, иначе планировщик запустит сценарий сразу
+func checkMSchedule(mSchedule *models.Schedule, now time.Time) error {
+	if mSchedule.PercentageOfTarget == 0 {
+		mSchedule.PercentageOfTarget = 100
+	} else if mSchedule.PercentageOfTarget < 0 || mSchedule.PercentageOfTarget > maxPercentageOfTarget {
+		return errors.Errorf("percentage_of_target must be between 1 and %v", maxPercentageOfTarget)
+	}
+
+	if mSchedule.UserName == "" {
+		mSchedule.UserName = "-"
+	}
+	if mSchedule.PreferredUserName == "" {
+		mSchedule.PreferredUserName = "-"
+	}
+
+	mSchedule.Cron = strings.TrimSpace(mSchedule.Cron)
+	if mSchedule.RunAt.Valid {
+		mSchedule.RunAt.Time = mSchedule.RunAt.Time.UTC()
+	}
+
+	nextRunAt, err := NextScheduleRun(mSchedule.Cron, mSchedule.RunAt, now)
+	if err != nil {
+		return err
+	}
+
+	if !mSchedule.Enabled {
+		mSchedule.NextRunAt = null.Time{}
+
+		return nil
+	}
+
+	if mSchedule.Cron == "" && !nextRunAt.Time.After(now) {
+		return errors.Errorf("run_at '%v' is in the past", nextRunAt.Time.Format(time.RFC3339))
+	}
+
+	mSchedule.NextRunAt = nextRunAt
+
+	return nil
+}
+
+// NextScheduleRun время следующего запуска после from.
+// Для cron-выражения(5 полей или дескрипторы вида @daily) рассчитывается следующее срабатывание: по UTC
+// или в часовом поясе из префикса CRON_TZ=<IANA zone>. Для разового запуска возвращается runAt
+func NextScheduleRun(cronExpr string, runAt null.Time, from time.Time) (null.Time, error) {
+	if cronExpr != "" {
+		schedule, err := cron.ParseStandard(cronExpr)
+		if err != nil {
+			return null.Time{}, errors.Wrapf(err, "invalid cron expression '%v'", cronExpr)
+		}
+
+		return null.TimeFrom(schedule.Next(from.UTC()).UTC()), nil
+	}
+
+	if !runAt.Valid || runAt.Time.IsZero() {
+		return null.Time{}, errors.New("either cron or run_at must be specified")
+	}
+
+	return null.TimeFrom(runAt.Time.UTC()), nil
+}
@@ -0,0 +1,112 @@
+package processing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+)
+
+func TestNextScheduleRun(t *testing.T) {
+	from := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+	runAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	tests := []struct {
+		name    string
+		cron    string
+		runAt   null.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "cron in utc", cron: "0 3 * * *", want: time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)},
+		{name: "cron later today", cron: "0 13 * * *", want: time.Date(2026, 10, 18, 13, 0, 0, 0, time.UTC)},
+		{
+			name: "cron with timezone",
+			cron: "CRON_TZ=Europe/Moscow 0 9 * * *",
+			want: time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC),
+		},
+		{name: "descriptor", cron: "@daily", want: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		// cron приоритетнее run_at
+		{name: "cron and run_at", cron: "@hourly", runAt: null.TimeFrom(runAt), want: from.Add(30 * time.Minute)},
+		{name: "one-shot", runAt: null.TimeFrom(runAt), want: time.Date(2026, 10, 20, 6, 0, 0, 0, time.UTC)},
+		{name: "invalid cron", cron: "0 3 * *", wantErr: true},
+		{name: "unknown timezone", cron: "CRON_TZ=Mars/Olympus 0 3 * * *", wantErr: true},
+		{name: "no cron and run_at", wantErr: true},
+		{name: "zero run_at", runAt: null.TimeFrom(time.Time{}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextScheduleRun(tt.cron, tt.runAt, from)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextScheduleRun() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !got.Valid || !got.Time.Equal(tt.want) || got.Time.Location() != time.UTC {
+				t.Errorf("NextScheduleRun() = %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckMSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		mSchedule     *models.Schedule
+		wantNextRunAt null.Time
+		wantErr       bool
+	}{
+		{
+			name:          "cron",
+			mSchedule:     &models.Schedule{Cron: " 0 3 * * * ", Enabled: true},
+			wantNextRunAt: null.TimeFrom(time.Date(2026, 10, 19, 3, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "one-shot",
+			mSchedule:     &models.Schedule{RunAt: null.TimeFrom(now.Add(time.Hour)), Enabled: true},
+			wantNextRunAt: null.TimeFrom(now.Add(time.Hour)),
+		},
+		{
+			name:      "past run_at",
+			mSchedule: &models.Schedule{RunAt: null.TimeFrom(now.Add(-time.Hour)), Enabled: true},
+			wantErr:   true,
+		},
+		// выполненное разовое расписание можно редактировать
+		{name: "past run_at disabled", mSchedule: &models.Schedule{RunAt: null.TimeFrom(now.Add(-time.Hour))}},
+		{name: "cron disabled", mSchedule: &models.Schedule{Cron: "0 3 * * *"}},
+		{name: "invalid cron", mSchedule: &models.Schedule{Cron: "every day", Enabled: true}, wantErr: true},
+		{name: "no cron and run_at", mSchedule: &models.Schedule{Enabled: true}, wantErr: true},
+		{
+			name:      "percentage of target",
+			mSchedule: &models.Schedule{Cron: "0 3 * * *", PercentageOfTarget: maxPercentageOfTarget + 1},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMSchedule(tt.mSchedule, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkMSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if tt.mSchedule.NextRunAt.Valid != tt.wantNextRunAt.Valid ||
+				!tt.mSchedule.NextRunAt.Time.Equal(tt.wantNextRunAt.Time) {
+				t.Errorf("checkMSchedule() next_run_at = %v, want %v", tt.mSchedule.NextRunAt, tt.wantNextRunAt)
+			}
+
+			if tt.mSchedule.PercentageOfTarget != 100 || tt.mSchedule.UserName != "-" {
+				t.Errorf("checkMSchedule() defaults = %v, %v", tt.mSchedule.PercentageOfTarget, tt.mSchedule.UserName)
+			}
+		})
+	}
+}
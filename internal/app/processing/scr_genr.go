@@ -13,6 +13,7 @@ import (
 	"text/template"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
 	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	"github.com/aliexpressru/alilo-backend/internal/app/processing/upload"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
@@ -39,6 +40,82 @@ func getStages(ctx context.Context, goalTarget int, steps int, duration string)
 	return myStages
 }
 
+// stageDurationRe длительность стадии в формате k6: 30s, 5m, 1h30m, 500ms
+var stageDurationRe = regexp.MustCompile(`^(\d+(ms|s|m|h))+$`)
+
+// CheckLoadProfile проверка стадий профиля нагрузки
+func CheckLoadProfile(loadProfile []*pb.LoadStage) error {
+	for i, stage := range loadProfile {
+		if stage == nil {
+			return errors.Errorf("load stage %v is empty", i)
+		}
+
+		if !stageDurationRe.MatchString(stage.GetDuration()) {
+			return errors.Errorf("load stage %v: duration must be in k6 format(30s, 5m, 1h): '%v'",
+				i, stage.GetDuration())
+		}
+
+		if stage.GetRps() < 0 || stage.GetPercentageOfTarget() < 0 {
+			return errors.Errorf("load stage %v: rps and percentage_of_target must not be negative", i)
+		}
+
+		if stage.GetPercentageOfTarget() > maxPercentageOfTarget {
+			return errors.Errorf("load stage %v: percentage_of_target must not exceed %v",
+				i, maxPercentageOfTarget)
+		}
+	}
+
+	return nil
+}
+
+// getLoadProfileStages стадии k6 ramping-arrival-rate из профиля нагрузки.
+// Проценты считаются от rps скрипта(уже с учетом процента запуска), абсолютный rps берется как есть
+func getLoadProfileStages(loadProfile []*pb.LoadStage, rps int) []map[string]interface{} {
+	myStages := make([]map[string]interface{}, 0, len(loadProfile))
+
+	for _, stage := range loadProfile {
+		target := int(stage.GetRps())
+		if target == 0 {
+			target = int(math.Ceil(float64(rps) * float64(stage.GetPercentageOfTarget()) / 100))
+		}
+
+		myStages = append(myStages, map[string]interface{}{"target": target, "duration": stage.GetDuration()})
+	}
+
+	return myStages
+}
+
+// simpleScriptStages стадии простого скрипта: профиль скрипта приоритетнее профиля сценария,
+// без профиля - линейный рост по steps
+func simpleScriptStages(ctx context.Context, simpleScript *pb.SimpleScript, scenarioLoadProfile []*pb.LoadStage,
+	rps int, steps int) []map[string]interface{} {
+	loadProfile := simpleScript.GetLoadProfile()
+	if len(loadProfile) == 0 {
+		loadProfile = scenarioLoadProfile
+	}
+
+	if len(loadProfile) > 0 {
+		return getLoadProfileStages(loadProfile, rps)
+	}
+
+	return getStages(ctx, rps, steps, simpleScript.GetDuration())
+}
+
+// LoadProfileStagesToEnv стадии профиля нагрузки в json для передачи в расширенный скрипт(env STAGES)
+func LoadProfileStagesToEnv(loadProfile []*pb.LoadStage, rps string) (string, error) {
+	intRps, err := strconv.Atoi(rps)
+	if err != nil {
+		return "", errors.Wrapf(err, "Cannot convert RPS param to int(%v)", rps)
+	}
+
+	jsonData, err := json.Marshal(getLoadProfileStages(loadProfile, intRps))
+	if err != nil {
+		return "", errors.Wrap(err, "load profile JSON marshal error")
+	}
+
+	return string(jsonData), nil
+}
+
 func divideTimeUnit(ctx context.Context, timeUnit string, divider int) string {
 	re := regexp.MustCompile(`(\d+)([s,mhd])`)
 	myArray := re.FindStringSubmatch(timeUnit)
@@ -77,6 +154,10 @@ func divideTimeUnit(ctx context.Context, timeUnit string, divider int) string {
 // SimpleScriptGenerate Генерация скрипта.js для исполнения на агенте. Скрипт сохраняется в minio(s3)
 func SimpleScriptGenerate(ctx context.Context, simpleScript *pb.SimpleScript, db *data.Store) (er error) {
 	templatePath := "./templateSimpleScript"
+	if simpleScript.GetProtocol() == pb.SimpleScript_PROTOCOL_GRPC {
+		templatePath = "./templateGrpcSimpleScript"
+	}
+
 	if file2.IsExist(templatePath) {
 		simpleScriptTemplate, err := template.ParseFiles(templatePath)
 		if err != nil {
@@ -115,7 +196,24 @@ func SimpleScriptGenerate(ctx context.Context, simpleScript *pb.SimpleScript, db
 			return err
 		}
 
-		stageArray := getStages(ctx, intRps, intSteps, simpleScript.Duration)
+		project, err := db.GetMProject(ctx, simpleScript.ProjectId)
+		if err != nil {
+			err = errors.Wrapf(err, "Error getting mProject")
+			logger.Errorf(ctx, "SimpleScriptGenerate: '%v'", err)
+
+			return err
+		}
+
+		scenario, err := db.GetMScenario(ctx, simpleScript.ScenarioId)
+		if err != nil {
+			err = errors.Wrapf(err, "Error getting mScenario")
+			logger.Errorf(ctx, "SimpleScriptGenerate: '%v'", err)
+
+			return err
+		}
+
+		pbScenario, _ := conv.ModelToPBScenario(ctx, scenario)
+		stageArray := simpleScriptStages(ctx, simpleScript, pbScenario.GetLoadProfile(), intRps, intSteps)
 
 		jsonData, err := json.Marshal(stageArray)
 		if err != nil {
@@ -125,42 +223,38 @@ func SimpleScriptGenerate(ctx context.Context, simpleScript *pb.SimpleScript, db
 			return err
 		}
 
-		checkAndAppendDefaultScriptParams(ctx, simpleScript)
+		// стандартные заголовки и параметры HTTP не передаются в metadata gRPC
+		if simpleScript.GetProtocol() != pb.SimpleScript_PROTOCOL_GRPC {
+			checkAndAppendDefaultScriptParams(ctx, simpleScript)
+		}
+
+		ammo, err := grpcScriptAmmo(ctx, simpleScript)
+		if err != nil {
+			logger.Errorf(ctx, "SimpleScriptGenerate error: '%v'", err)
+
+			return err
+		}
 
 		templateWithStages := struct {
 			*pb.SimpleScript
 			Stages string
+			Ammo   string
 		}{
 			simpleScript,
 			string(jsonData),
+			ammo,
 		}
 
 		var b bytes.Buffer
 
 		err = simpleScriptTemplate.Execute(&b, &templateWithStages)
 		if err != nil {
-			err = errors.Wrapf(err, "Execute templateSimpleScript error")
+			err = errors.Wrapf(err, "Execute template '%v' error", templatePath)
 			logger.Errorf(ctx, "SimpleScriptGenerate error: '%v'", err)
 
 			return err
 		}
 
-		project, err := db.GetMProject(ctx, simpleScript.ProjectId)
-		if err != nil {
-			err = errors.Wrapf(err, "Error getting mProject")
-			logger.Errorf(ctx, "SimpleScriptGenerate: '%v'", err)
-
-			return err
-		}
-
-		scenario, err := db.GetMScenario(ctx, simpleScript.ScenarioId)
-		if err != nil {
-			err = errors.Wrapf(err, "Error getting mScenario")
-			logger.Errorf(ctx, "SimpleScriptGenerate: '%v'", err)
-
-			return err
-		}
-
 		logger.Infof(ctx, "Unloading the created script(%v)", b.Len())
 		byteSlice := b.Bytes()
 
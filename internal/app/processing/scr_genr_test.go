@@ -0,0 +1,133 @@
+package processing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+)
+
+func TestCheckLoadProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		loadProfile []*pb.LoadStage
+		wantErr     bool
+	}{
+		{name: "no stages"},
+		{
+			name: "multi-stage",
+			loadProfile: []*pb.LoadStage{
+				{PercentageOfTarget: 50, Duration: "30s"},
+				{PercentageOfTarget: 100, Duration: "1h30m"},
+				{Rps: 500, Duration: "500ms"},
+				{Duration: "5m"},
+			},
+		},
+		{name: "empty stage", loadProfile: []*pb.LoadStage{{Duration: "1m"}, nil}, wantErr: true},
+		{name: "no duration", loadProfile: []*pb.LoadStage{{Rps: 10}}, wantErr: true},
+		{name: "duration without unit", loadProfile: []*pb.LoadStage{{Rps: 10, Duration: "30"}}, wantErr: true},
+		{name: "go duration", loadProfile: []*pb.LoadStage{{Rps: 10, Duration: "1.5m"}}, wantErr: true},
+		{name: "days", loadProfile: []*pb.LoadStage{{Rps: 10, Duration: "1d"}}, wantErr: true},
+		{name: "negative rps", loadProfile: []*pb.LoadStage{{Rps: -1, Duration: "1m"}}, wantErr: true},
+		{
+			name:        "percentage of target",
+			loadProfile: []*pb.LoadStage{{PercentageOfTarget: maxPercentageOfTarget + 1, Duration: "1m"}},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckLoadProfile(tt.loadProfile); (err != nil) != tt.wantErr {
+				t.Errorf("CheckLoadProfile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadProfileStagesToEnv(t *testing.T) {
+	loadProfile := []*pb.LoadStage{
+		{PercentageOfTarget: 50, Duration: "1m"},
+		// абсолютный rps не зависит от rps скрипта
+		{Rps: 300, Duration: "30s"},
+		{PercentageOfTarget: 33, Duration: "10s"},
+		{Duration: "10s"},
+	}
+
+	tests := []struct {
+		name    string
+		rps     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "multi-stage",
+			rps:  "100",
+			want: `[{"duration":"1m","target":50},{"duration":"30s","target":300},` +
+				`{"duration":"10s","target":33},{"duration":"10s","target":0}]`,
+		},
+		{
+			name: "percentage is rounded up",
+			rps:  "10",
+			want: `[{"duration":"1m","target":5},{"duration":"30s","target":300},` +
+				`{"duration":"10s","target":4},{"duration":"10s","target":0}]`,
+		},
+		{name: "invalid rps", rps: "fast", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadProfileStagesToEnv(loadProfile, tt.rps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadProfileStagesToEnv() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("LoadProfileStagesToEnv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimpleScriptStages(t *testing.T) {
+	scenarioLoadProfile := []*pb.LoadStage{{PercentageOfTarget: 100, Duration: "5m"}}
+
+	tests := []struct {
+		name                string
+		simpleScript        *pb.SimpleScript
+		scenarioLoadProfile []*pb.LoadStage
+		want                []map[string]interface{}
+	}{
+		{
+			name: "script profile over scenario profile",
+			simpleScript: &pb.SimpleScript{
+				Duration: "2m", LoadProfile: []*pb.LoadStage{{Rps: 20, Duration: "1m"}},
+			},
+			scenarioLoadProfile: scenarioLoadProfile,
+			want:                []map[string]interface{}{{"target": 20, "duration": "1m"}},
+		},
+		{
+			name:                "scenario profile",
+			simpleScript:        &pb.SimpleScript{Duration: "2m"},
+			scenarioLoadProfile: scenarioLoadProfile,
+			want:                []map[string]interface{}{{"target": 100, "duration": "5m"}},
+		},
+		{
+			name:         "linear growth by steps",
+			simpleScript: &pb.SimpleScript{Duration: "2m"},
+			want: []map[string]interface{}{
+				{"target": 50, "duration": "0s"},
+				{"target": 50, "duration": "60s"},
+				{"target": 100, "duration": "0s"},
+				{"target": 100, "duration": "60s"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := simpleScriptStages(context.Background(), tt.simpleScript, tt.scenarioLoadProfile, 100, 2)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("simpleScriptStages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
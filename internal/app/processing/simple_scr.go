@@ -61,6 +61,12 @@ func CreateSimpleScript(ctx context.Context, sScript *pb.SimpleScript, db *datap
 	if !util.IsTimeUnit(string2.GetLastRune(sScript.Duration, 1)) {
 		return fmt.Sprintf("%v: %+v", "duration must contain a time unit", sScript.Duration), -1
 	}
+	if err := CheckLoadProfile(sScript.GetLoadProfile()); err != nil {
+		return err.Error(), -1
+	}
+	if err := checkGrpcSimpleScript(ctx, sScript); err != nil {
+		return err.Error(), -1
+	}
 	if sScript.AdditionalEnv == nil {
 		sScript.AdditionalEnv = make(map[string]string)
 	}
@@ -119,6 +125,12 @@ func UpdateSimpleScript(ctx context.Context, simpleScript *pb.SimpleScript, db *
 			simpleScript.Duration,
 		)
 	}
+	if err = CheckLoadProfile(simpleScript.GetLoadProfile()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err.Error())
+	}
+	if err = checkGrpcSimpleScript(ctx, simpleScript); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err.Error())
+	}
 	if !simpleScript.IsStaticAmmo {
 		simpleScript.StaticAmmo = ""
 	}
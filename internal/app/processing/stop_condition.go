@@ -0,0 +1,77 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/data"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+)
+
+func GetStopConditions(ctx context.Context, scenarioID int32, dataStore *data.Store) (
+	stopConditions []*pb.StopCondition, message string) {
+	mStopConditions, err := dataStore.GetMStopConditions(ctx, scenarioID)
+	if err != nil {
+		return nil, fmt.Sprintf("Error get stop conditions: '%v'", err.Error())
+	}
+
+	stopConditions = make([]*pb.StopCondition, 0, len(mStopConditions))
+	for _, mStopCondition := range mStopConditions {
+		stopCondition, mes := conv.ModelToPBStopCondition(ctx, mStopCondition)
+		if mes != "" {
+			message = fmt.Sprint(message, mes)
+
+			continue
+		}
+
+		stopConditions = append(stopConditions, stopCondition)
+	}
+
+	return stopConditions, message
+}
+
+// SetStopConditions замена условий автоматической остановки сценария
+func SetStopConditions(ctx context.Context,
+	scenarioID int32, stopConditions []*pb.StopCondition, dataStore *data.Store) (
+	returnStopConditions []*pb.StopCondition, message string) {
+	if _, err := dataStore.GetMScenario(ctx, scenarioID); err != nil {
+		message = fmt.Sprintf("Error set stop conditions, scenario '%v' not found: '%v'", scenarioID, err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	mStopConditions := make([]*models.StopCondition, 0, len(stopConditions))
+	for i, stopCondition := range stopConditions {
+		if stopCondition.GetThreshold() < 0 || stopCondition.GetDurationSec() < 0 {
+			return nil, fmt.Sprintf("Error set stop conditions, condition %v: "+
+				"threshold and duration_sec must not be negative", i)
+		}
+
+		if stopCondition.GetMetric() == pb.StopCondition_METRIC_FAILED_RATE && stopCondition.GetThreshold() > 100 {
+			return nil, fmt.Sprintf("Error set stop conditions, condition %v: failed rate is a percentage", i)
+		}
+
+		mStopCondition, mes := conv.PBStopConditionToModel(ctx, stopCondition)
+		if mes != "" {
+			message = fmt.Sprintf("Error set stop conditions: '%v'", mes)
+			logger.Errorf(ctx, message)
+
+			return nil, message
+		}
+
+		mStopConditions = append(mStopConditions, mStopCondition)
+	}
+
+	if err := dataStore.ReplaceMStopConditions(ctx, scenarioID, mStopConditions); err != nil {
+		message = fmt.Sprintf("Error set stop conditions: '%v'", err.Error())
+		logger.Errorf(ctx, message)
+
+		return nil, message
+	}
+
+	return GetStopConditions(ctx, scenarioID, dataStore)
+}
@@ -64,6 +64,17 @@ func ScriptToUpload(ctx context.Context,
 		name, data, bucketName, description, projectTitle, scenarioTitle, "application/javascript", finishChan)
 }
 
+// DescriptorToUpload набор дескрипторов gRPC(protoset) для простых скриптов
+func DescriptorToUpload(ctx context.Context,
+	name string, data *[]byte, bucketName string, projectTitle string, scenarioTitle string) (
+	descriptorPoint *pb.AmmoFile, err error) {
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".proto"), ".protoset")
+
+	return FileToUpload(ctx,
+		fmt.Sprint(undecided.AppendDateTime(name), ".protoset"), data, bucketName, "gRPC descriptor set",
+		projectTitle, scenarioTitle, "application/octet-stream", nil)
+}
+
 func FileToUpload(ctx context.Context,
 	name string, data *[]byte,
 	bucketName string, description string, projectTitle string, scenarioTitle string,
@@ -4,6 +4,9 @@ package service
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	"github.com/aliexpressru/alilo-backend/internal/app/processing"
 	"github.com/aliexpressru/alilo-backend/internal/app/util"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
@@ -66,7 +69,7 @@ func (s *Service) UpdateAgent(ctx context.Context, request *pb.UpdateAgentReques
 	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "update_agent")
 
 	logger.Infof(ctx, "Successful request UpdateAgent: '%v'", request.String())
-	status, message := processing.UpdateAgent(ctx, request.GetAgent(), s.store)
+	status, message := processing.UpdateAgent(ctx, request.GetAgent(), s.store, s.data, s.agentManager)
 	if message != "" {
 		_ = util.SetModalHeader(ctx)
 	}
@@ -152,3 +155,158 @@ func (s *Service) RemoveLogs(ctx context.Context, request *pb.RemoveLogsRequest)
 		Message: message,
 	}, nil
 }
+
+func (s *Service) RegisterAgent(ctx context.Context, request *pb.RegisterAgentRequest) (
+	*pb.RegisterAgentResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "register_agent")
+
+	logger.Infof(ctx, "Successful request RegisterAgent: '%v'", request.String())
+
+	agentID, message := processing.RegisterAgent(ctx, request, s.data, s.agentManager)
+
+	return &pb.RegisterAgentResponse{
+		Status:            message == "",
+		Message:           message,
+		AgentId:           agentID,
+		HeartbeatInterval: durationpb.New(config.Get(ctx).AgentHeartbeatInterval),
+	}, nil
+}
+
+func (s *Service) Heartbeat(ctx context.Context, request *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "heartbeat")
+
+	logger.Debugf(ctx, "Successful request Heartbeat: '%v'", request.String())
+
+	registered, message := processing.Heartbeat(ctx, request, s.data)
+
+	return &pb.HeartbeatResponse{
+		Status:            message == "",
+		Message:           message,
+		Registered:        registered,
+		HeartbeatInterval: durationpb.New(config.Get(ctx).AgentHeartbeatInterval),
+	}, nil
+}
+
+func (s *Service) DrainAgent(ctx context.Context, request *pb.DrainAgentRequest) (*pb.DrainAgentResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "drain_agent")
+
+	logger.Infof(ctx, "Successful request DrainAgent: '%v'", request.String())
+
+	agent, runningScriptRuns, message := processing.DrainAgent(ctx, request, s.data, s.agentManager)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.DrainAgentResponse{
+		Status:            message == "",
+		Message:           message,
+		Agent:             agent,
+		RunningScriptRuns: runningScriptRuns,
+	}, nil
+}
+
+func (s *Service) CancelDrainAgent(ctx context.Context, request *pb.CancelDrainAgentRequest) (
+	*pb.CancelDrainAgentResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "cancel_drain_agent")
+
+	logger.Infof(ctx, "Successful request CancelDrainAgent: '%v'", request.String())
+
+	message := processing.CancelDrainAgent(ctx, request.GetAgentId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.CancelDrainAgentResponse{
+		Status:  message == "",
+		Message: message,
+	}, nil
+}
+
+func (s *Service) GetPoolUtilization(ctx context.Context, request *pb.GetPoolUtilizationRequest) (
+	*pb.GetPoolUtilizationResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_pool_utilization")
+
+	logger.Infof(ctx, "Successful request GetPoolUtilization: '%v'", request.String())
+
+	tags, message := processing.GetPoolUtilization(ctx, request.GetTag(), s.data, s.agentManager)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetPoolUtilizationResponse{
+		Status:  message == "",
+		Message: message,
+		Tags:    tags,
+	}, nil
+}
+
+func (s *Service) ReserveAgents(ctx context.Context, request *pb.ReserveAgentsRequest) (
+	*pb.ReserveAgentsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "reserve_agents")
+
+	logger.Infof(ctx, "Successful request ReserveAgents: '%v'", request.String())
+
+	reservation, message := processing.ReserveAgents(ctx, request, s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ReserveAgentsResponse{
+		Status:      message == "",
+		Message:     message,
+		Reservation: reservation,
+	}, nil
+}
+
+func (s *Service) CancelAgentReservation(ctx context.Context, request *pb.CancelAgentReservationRequest) (
+	*pb.CancelAgentReservationResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "cancel_agent_reservation")
+
+	logger.Infof(ctx, "Successful request CancelAgentReservation: '%v'", request.String())
+
+	message := processing.CancelAgentReservation(ctx, request.GetReservationId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.CancelAgentReservationResponse{
+		Status:  message == "",
+		Message: message,
+	}, nil
+}
+
+func (s *Service) GetAgentReservations(ctx context.Context, request *pb.GetAgentReservationsRequest) (
+	*pb.GetAgentReservationsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_agent_reservations")
+
+	logger.Infof(ctx, "Successful request GetAgentReservations: '%v'", request.String())
+
+	reservations, message := processing.GetAgentReservations(ctx, request, s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetAgentReservationsResponse{
+		Status:       message == "",
+		Message:      message,
+		Reservations: reservations,
+	}, nil
+}
+
+func (s *Service) RotateAgentTokens(ctx context.Context, request *pb.RotateAgentTokensRequest) (
+	*pb.RotateAgentTokensResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "rotate_agent_tokens")
+
+	logger.Infof(ctx, "Successful request RotateAgentTokens: '%v'", request.String())
+
+	rotations, message := processing.RotateAgentTokens(ctx, request.GetAgentIds(), s.data, s.agentManager)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.RotateAgentTokensResponse{
+		Status:    message == "",
+		Message:   message,
+		Rotations: rotations,
+	}, nil
+}
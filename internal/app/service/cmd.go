@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/internal/app/util"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
@@ -38,3 +39,21 @@ func (s *Service) CommandNew(ctx context.Context, request *pb.CommandNewRequest)
 
 	return rs, nil
 }
+
+func (s *Service) ListCommands(ctx context.Context, request *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "list_commands")
+
+	logger.Infof(ctx, "Successful request ListCommands: '%v'", request.String())
+
+	commands, totalPages, message := processing.ListCommands(ctx, request, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ListCommandsResponse{
+		Status:     message == "",
+		Message:    message,
+		Commands:   commands,
+		TotalPages: &totalPages,
+	}, nil
+}
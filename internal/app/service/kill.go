@@ -3,23 +3,40 @@ package service
 import (
 	"context"
 
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/internal/app/util"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
-	"github.com/aliexpressru/alilo-backend/pkg/util/ssh"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// KillProcess устаревшая аварийная остановка, выполняется как EmergencyStop с остановкой по SSH
 func (s *Service) KillProcess(ctx context.Context, request *emptypb.Empty) (*emptypb.Empty, error) {
 	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "kill_process")
 
-	if md, b := metadata.FromIncomingContext(ctx); b {
-		user := md.Get("x-identification")
-		logger.Warnf(ctx, "X-user: %v", len(user))
-	}
-
 	logger.Infof(ctx, "Successful request KillProcessRequest: '%v'", request.String())
-	ssh.SendCommandForAll(ctx, "kill")
+	processing.EmergencyStop(ctx, &pb.EmergencyStopRequest{Reason: "KillProcess", SshFallback: true},
+		s.data, s.store, s.agentManager)
 
 	return new(emptypb.Empty), nil
 }
+
+func (s *Service) EmergencyStop(ctx context.Context, request *pb.EmergencyStopRequest) (
+	*pb.EmergencyStopResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "emergency_stop")
+
+	logger.Warnf(ctx, "Successful request EmergencyStop: '%v'", request.String())
+
+	stoppedRunIDs, agents, message := processing.EmergencyStop(ctx, request, s.data, s.store, s.agentManager)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.EmergencyStopResponse{
+		Status:        message == "",
+		Message:       message,
+		StoppedRunIds: stoppedRunIDs,
+		Agents:        agents,
+	}, nil
+}
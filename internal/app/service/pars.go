@@ -14,14 +14,128 @@ func (s *Service) ParseCUrl(ctx context.Context, request *pb.ParseCUrlRequest) (
 	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "parse_curl")
 
 	logger.Infof(ctx, "Successful request ParseCUrl: '%v'", request.String())
-	json, message := processing.ParseCUrl(ctx, request.GetCurl())
+	json, warnings, message := processing.ParseCUrl(ctx, request.GetCurl())
 	if message != "" {
 		_ = util.SetModalHeader(ctx)
 	}
 
 	return &pb.ParseCUrlResponse{
-		Status:  message == "",
-		Message: message,
-		Json:    json,
+		Status:   message == "",
+		Message:  message,
+		Json:     json,
+		Warnings: warnings,
+	}, nil
+}
+
+func (s *Service) ExportCUrl(ctx context.Context, request *pb.ExportCUrlRequest) (*pb.ExportCUrlResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "export_curl")
+
+	logger.Infof(ctx, "Successful request ExportCUrl: '%v'", request.String())
+	curl, warnings, message := processing.ExportCUrl(ctx, request, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ExportCUrlResponse{
+		Status:   message == "",
+		Message:  message,
+		Curl:     curl,
+		Warnings: warnings,
+	}, nil
+}
+
+func (s *Service) ImportHAR(ctx context.Context, request *pb.ImportHARRequest) (*pb.ImportHARResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "import_har")
+
+	logger.Infof(ctx, "Successful request ImportHAR: scenario '%v', title '%v', HAR length %v",
+		request.GetTarget().GetScenarioId(), request.GetTarget().GetScenarioTitle(), len(request.GetHar()))
+	scenarioID, simpleScripts, warnings, message := processing.ImportHAR(ctx, request, s.data, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ImportHARResponse{
+		Status:        message == "",
+		Message:       message,
+		ScenarioId:    scenarioID,
+		SimpleScripts: simpleScripts,
+		Warnings:      warnings,
+	}, nil
+}
+
+func (s *Service) GetOpenAPIOperations(ctx context.Context, request *pb.GetOpenAPIOperationsRequest) (
+	*pb.GetOpenAPIOperationsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_openapi_operations")
+
+	logger.Infof(ctx, "Successful request GetOpenAPIOperations: spec url '%v', spec length %v",
+		request.GetSpecUrl(), len(request.GetSpec()))
+	baseURL, operations, message := processing.GetOpenAPIOperations(ctx, request.GetSpec(), request.GetSpecUrl())
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetOpenAPIOperationsResponse{
+		Status:     message == "",
+		Message:    message,
+		BaseUrl:    baseURL,
+		Operations: operations,
+	}, nil
+}
+
+func (s *Service) ImportOpenAPI(ctx context.Context, request *pb.ImportOpenAPIRequest) (
+	*pb.ImportOpenAPIResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "import_openapi")
+
+	logger.Infof(ctx, "Successful request ImportOpenAPI: scenario '%v', title '%v', operations %v, spec url '%v'",
+		request.GetTarget().GetScenarioId(), request.GetTarget().GetScenarioTitle(), request.GetOperations(),
+		request.GetSpecUrl())
+	scenarioID, simpleScripts, warnings, message := processing.ImportOpenAPI(ctx, request, s.data, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ImportOpenAPIResponse{
+		Status:        message == "",
+		Message:       message,
+		ScenarioId:    scenarioID,
+		SimpleScripts: simpleScripts,
+		Warnings:      warnings,
+	}, nil
+}
+
+func (s *Service) ImportPostman(ctx context.Context, request *pb.ImportPostmanRequest) (
+	*pb.ImportPostmanResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "import_postman")
+
+	logger.Infof(ctx, "Successful request ImportPostman: scenario '%v', project '%v', collection length %v",
+		request.GetTarget().GetScenarioId(), request.GetTarget().GetProjectId(), len(request.GetCollection()))
+	scenarios, warnings, message := processing.ImportPostman(ctx, request, s.data, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ImportPostmanResponse{
+		Status:    message == "",
+		Message:   message,
+		Scenarios: scenarios,
+		Warnings:  warnings,
+	}, nil
+}
+
+func (s *Service) ImportCUrl(ctx context.Context, request *pb.ImportCUrlRequest) (*pb.ImportCUrlResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "import_curl")
+
+	logger.Infof(ctx, "Successful request ImportCUrl: '%v'", request.String())
+	scenarioID, simpleScripts, warnings, message := processing.ImportCUrl(ctx, request, s.data, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ImportCUrlResponse{
+		Status:        message == "",
+		Message:       message,
+		ScenarioId:    scenarioID,
+		SimpleScripts: simpleScripts,
+		Warnings:      warnings,
 	}, nil
 }
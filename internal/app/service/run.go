@@ -344,3 +344,117 @@ func (s *Service) IsChangeLoadLevel(
 	}
 	return isChangeLoadLevel, err
 }
+
+func (s *Service) StartCapacitySearch(
+	ctx context.Context,
+	request *pb.StartCapacitySearchRequest,
+) (*pb.StartCapacitySearchResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "start_capacity_search")
+
+	logger.Infof(ctx, "Successful request StartCapacitySearch: '%v'", request.String())
+
+	running, capacitySearch, message := processing.StartCapacitySearch(ctx, request, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.StartCapacitySearchResponse{
+		Status:         message == "",
+		Message:        message,
+		Run:            running,
+		CapacitySearch: capacitySearch,
+	}, nil
+}
+
+func (s *Service) GetCapacitySearch(
+	ctx context.Context,
+	request *pb.GetCapacitySearchRequest,
+) (*pb.GetCapacitySearchResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_capacity_search")
+
+	logger.Infof(ctx, "Successful request GetCapacitySearch: '%v'", request.String())
+
+	capacitySearch, message := processing.GetCapacitySearch(ctx, request.GetRunId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetCapacitySearchResponse{
+		Status:         message == "",
+		Message:        message,
+		CapacitySearch: capacitySearch,
+	}, nil
+}
+
+func (s *Service) CompareRuns(ctx context.Context, request *pb.CompareRunsRequest) (*pb.CompareRunsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "compare_runs")
+
+	logger.Infof(ctx, "Successful request CompareRuns: '%v'", request.String())
+
+	totals, scripts, urls, message := processing.CompareRuns(ctx, request.GetRunIds(), s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.CompareRunsResponse{
+		Status:  message == "",
+		Message: message,
+		Totals:  totals,
+		Scripts: scripts,
+		Urls:    urls,
+	}, nil
+}
+
+func (s *Service) PauseScenario(ctx context.Context, request *pb.PauseScenarioRequest) (
+	*pb.PauseScenarioResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "pause_scenario")
+
+	logger.Infof(ctx, "Successful request PauseScenario: '%v'", request.String())
+
+	run, message := processing.ScenarioToPause(ctx, request.GetRunId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.PauseScenarioResponse{
+		Status:  message == "",
+		Message: message,
+		Run:     run,
+	}, nil
+}
+
+func (s *Service) ResumeScenario(ctx context.Context, request *pb.ResumeScenarioRequest) (
+	*pb.ResumeScenarioResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "resume_scenario")
+
+	logger.Infof(ctx, "Successful request ResumeScenario: '%v'", request.String())
+
+	run, runPause, message := processing.ScenarioToResume(ctx, request.GetRunId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.ResumeScenarioResponse{
+		Status:   message == "",
+		Message:  message,
+		Run:      run,
+		RunPause: runPause,
+	}, nil
+}
+
+func (s *Service) GetScriptRunLogs(ctx context.Context, request *pb.GetScriptRunLogsRequest) (
+	*pb.GetScriptRunLogsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_script_run_logs")
+
+	logger.Infof(ctx, "Successful request GetScriptRunLogs: '%v'", request.String())
+
+	rs, message := processing.GetScriptRunLogs(ctx, request, s.store, s.agentManager)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	rs.Status = message == ""
+	rs.Message = message
+
+	return rs, nil
+}
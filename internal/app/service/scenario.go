@@ -138,11 +138,19 @@ func (s *Service) GetScenarioLastRunStatus(
 	logger.Infof(ctx, "Successful request GetScenarioLastRunStatus: '%v'", request.String())
 	lastRunStatus, lastRunID, message := s.store.GetScenarioLastRunStatus(ctx, request.GetScenarioId())
 
+	var lastRunVerdict *pb.RunVerdict
+	var verdictWarning string
+	if message == "" && lastRunID != 0 {
+		lastRunVerdict, verdictWarning = processing.GetRunVerdict(ctx, lastRunID, s.data)
+	}
+
 	return &pb.GetScenarioLastRunStatusResponse{
-		Status:        message == "",
-		Message:       message,
-		LastRunStatus: lastRunStatus,
-		LastRunId:     lastRunID,
+		Status:         message == "",
+		Message:        message,
+		LastRunStatus:  lastRunStatus,
+		LastRunId:      lastRunID,
+		LastRunVerdict: lastRunVerdict,
+		VerdictWarning: verdictWarning,
 	}, nil
 }
 
@@ -292,3 +300,107 @@ func (s *Service) GetGrafanaStructureByIds(
 		Message: message,
 	}, nil
 }
+
+func (s *Service) GetStopConditions(
+	ctx context.Context,
+	request *pb.GetStopConditionsRequest,
+) (*pb.GetStopConditionsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_stop_conditions")
+
+	logger.Infof(ctx, "Successful request GetStopConditions: '%v'", request.String())
+
+	stopConditions, message := processing.GetStopConditions(ctx, request.GetScenarioId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetStopConditionsResponse{
+		Status:         message == "",
+		Message:        message,
+		StopConditions: stopConditions,
+	}, nil
+}
+
+func (s *Service) SetStopConditions(
+	ctx context.Context,
+	request *pb.SetStopConditionsRequest,
+) (*pb.SetStopConditionsResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "set_stop_conditions")
+
+	logger.Infof(ctx, "Successful request SetStopConditions: '%v'", request.String())
+
+	stopConditions, message := processing.SetStopConditions(
+		ctx,
+		request.GetScenarioId(),
+		request.GetStopConditions(),
+		s.data,
+	)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.SetStopConditionsResponse{
+		Status:         message == "",
+		Message:        message,
+		StopConditions: stopConditions,
+	}, nil
+}
+
+func (s *Service) SetScenarioBaseline(
+	ctx context.Context,
+	request *pb.SetScenarioBaselineRequest,
+) (*pb.SetScenarioBaselineResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "set_scenario_baseline")
+
+	logger.Infof(ctx, "Successful request SetScenarioBaseline: '%v'", request.String())
+
+	baseline, message := processing.SetScenarioBaseline(ctx, request, s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.SetScenarioBaselineResponse{
+		Status:   message == "",
+		Message:  message,
+		Baseline: baseline,
+	}, nil
+}
+
+func (s *Service) GetScenarioBaseline(
+	ctx context.Context,
+	request *pb.GetScenarioBaselineRequest,
+) (*pb.GetScenarioBaselineResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_scenario_baseline")
+
+	logger.Infof(ctx, "Successful request GetScenarioBaseline: '%v'", request.String())
+
+	baseline, message := processing.GetScenarioBaseline(ctx, request.GetScenarioId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetScenarioBaselineResponse{
+		Status:   message == "",
+		Message:  message,
+		Baseline: baseline,
+	}, nil
+}
+
+func (s *Service) DeleteScenarioBaseline(
+	ctx context.Context,
+	request *pb.DeleteScenarioBaselineRequest,
+) (*pb.DeleteScenarioBaselineResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "delete_scenario_baseline")
+
+	logger.Infof(ctx, "Successful request DeleteScenarioBaseline: '%v'", request.String())
+
+	message := processing.DeleteScenarioBaseline(ctx, request.GetScenarioId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.DeleteScenarioBaselineResponse{
+		Status:  message == "",
+		Message: message,
+	}, nil
+}
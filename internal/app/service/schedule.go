@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/processing"
+	"github.com/aliexpressru/alilo-backend/internal/app/util"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+func (s *Service) GetAllSchedules(
+	ctx context.Context,
+	request *pb.GetAllSchedulesRequest,
+) (*pb.GetAllSchedulesResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_all_schedules")
+
+	logger.Infof(ctx, "Successful request GetAllSchedules: '%v'", request.String())
+
+	schedules, totalPages, message := processing.GetAllSchedules(
+		ctx,
+		request.GetScenarioId(),
+		request.GetLimit(),
+		request.GetPageNumber(),
+		s.store,
+	)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetAllSchedulesResponse{
+		Status:     message == "",
+		Message:    message,
+		Schedules:  schedules,
+		TotalPages: &totalPages,
+	}, nil
+}
+
+func (s *Service) GetSchedule(ctx context.Context, request *pb.GetScheduleRequest) (*pb.GetScheduleResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_schedule")
+
+	logger.Infof(ctx, "Successful request GetSchedule: '%v'", request.String())
+
+	schedule, message := s.store.GetSchedule(ctx, request.GetScheduleId())
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetScheduleResponse{
+		Status:   message == "",
+		Message:  message,
+		Schedule: schedule,
+	}, nil
+}
+
+func (s *Service) CreateSchedule(
+	ctx context.Context,
+	request *pb.CreateScheduleRequest,
+) (*pb.CreateScheduleResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "create_schedule")
+
+	logger.Infof(ctx, "Successful request CreateSchedule: '%v'", request.String())
+
+	status, message, id := processing.CreateSchedule(ctx, request.GetSchedule(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.CreateScheduleResponse{
+		Status:     status,
+		Message:    message,
+		ScheduleId: id,
+	}, nil
+}
+
+func (s *Service) UpdateSchedule(
+	ctx context.Context,
+	request *pb.UpdateScheduleRequest,
+) (*pb.UpdateScheduleResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "update_schedule")
+
+	logger.Infof(ctx, "Successful request UpdateSchedule: '%v'", request.String())
+
+	status, message := processing.UpdateSchedule(ctx, request.GetSchedule(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.UpdateScheduleResponse{
+		Status:  status,
+		Message: message,
+	}, nil
+}
+
+func (s *Service) DeleteSchedule(
+	ctx context.Context,
+	request *pb.DeleteScheduleRequest,
+) (*pb.DeleteScheduleResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "delete_schedule")
+
+	logger.Infof(ctx, "Successful request DeleteSchedule: '%v'", request.String())
+
+	status, message := processing.DeleteSchedule(ctx, request.GetScheduleId(), s.data)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.DeleteScheduleResponse{
+		Status:  status,
+		Message: message,
+	}, nil
+}
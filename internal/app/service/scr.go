@@ -264,3 +264,43 @@ func (s *Service) CopySimpleScript(
 		SimpleScriptId: SimpleScriptID,
 	}, nil
 }
+
+func (s *Service) UploadGrpcDescriptor(
+	ctx context.Context,
+	request *pb.UploadGrpcDescriptorRequest,
+) (*pb.UploadGrpcDescriptorResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "upload_grpc_descriptor")
+
+	logger.Infof(ctx, "Successful request UploadGrpcDescriptor FileName:'%v' FileLen:'%v'",
+		request.GetFileName(), len(request.GetFile()))
+	descriptorURL, services, message := processing.UploadGrpcDescriptor(ctx, request, s.store)
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.UploadGrpcDescriptorResponse{
+		Status:        message == "",
+		Message:       message,
+		DescriptorUrl: descriptorURL,
+		Services:      services,
+	}, nil
+}
+
+func (s *Service) GetGrpcServices(
+	ctx context.Context,
+	request *pb.GetGrpcServicesRequest,
+) (*pb.GetGrpcServicesResponse, error) {
+	ctx = undecided.NewContextWithMarker(ctx, LoggerContextKey, "get_grpc_services")
+
+	logger.Infof(ctx, "Successful request GetGrpcServices: '%v'", request.String())
+	services, message := processing.GetGrpcServices(ctx, request.GetDescriptorUrl())
+	if message != "" {
+		_ = util.SetModalHeader(ctx)
+	}
+
+	return &pb.GetGrpcServicesResponse{
+		Status:   message == "",
+		Message:  message,
+		Services: services,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+// WatchRunSSEPath маршрут WatchRun в виде server-sent events, in-process gateway стримы не поддерживает
+const WatchRunSSEPath = "/v1/run/watch"
+
+var sseMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+func (s *Service) WatchRun(request *pb.WatchRunRequest, stream pb.RunService_WatchRunServer) error {
+	ctx := undecided.NewContextWithMarker(stream.Context(), LoggerContextKey, "watch_run")
+
+	logger.Infof(ctx, "Successful request WatchRun: '%v'", request.String())
+
+	return s.watchRun(ctx, request.GetRunId(), stream.Send)
+}
+
+// WatchRunSSE WatchRun по HTTP: GET /v1/run/watch?run_id=
+func (s *Service) WatchRunSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := undecided.NewContextWithMarker(r.Context(), LoggerContextKey, "watch_run_sse")
+
+	logger.Infof(ctx, "Successful request WatchRunSSE: '%v'", r.URL.RawQuery)
+
+	runID, err := strconv.ParseInt(r.URL.Query().Get("run_id"), 10, 32)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid run_id: '%v'", err.Error()), http.StatusBadRequest)
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err = s.watchRun(ctx, int32(runID), func(event *pb.WatchRunResponse) error {
+		body, err := sseMarshaler.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		eventName := strings.ToLower(strings.TrimPrefix(event.GetEvent().String(), "EVENT_"))
+		if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventName, body); err != nil {
+			return err
+		}
+		flusher.Flush()
+
+		return nil
+	})
+	if err != nil {
+		body, _ := sseMarshaler.Marshal(status.Convert(err).Proto())
+		_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", body)
+		flusher.Flush()
+	}
+}
+
+// watchRun первым сообщением отправляется текущее состояние Run`а, далее - изменения до остановки Run`а
+func (s *Service) watchRun(ctx context.Context, runID int32, send func(*pb.WatchRunResponse) error) error {
+	// подписываемся до получения состояния, чтобы не потерять изменения между ними
+	subscription := s.commandProcessor.RunWatcher().Subscribe(ctx, runID)
+	defer subscription.Close()
+
+	run, message := s.store.GetRunning(ctx, runID)
+	if message != "" {
+		return status.Errorf(codes.NotFound, "%v", message)
+	}
+
+	event := pb.WatchRunResponse_EVENT_SNAPSHOT_UNSPECIFIED
+	if run.GetStatus() == pb.Run_STATUS_STOPPED_UNSPECIFIED {
+		event = pb.WatchRunResponse_EVENT_FINISHED
+	}
+
+	err := send(&pb.WatchRunResponse{
+		Event:      event,
+		RunId:      run.GetRunId(),
+		RunStatus:  run.GetStatus(),
+		ScriptRuns: run.GetScriptRuns(),
+		Timestamp:  timestamppb.Now(),
+	})
+	if err != nil || event == pb.WatchRunResponse_EVENT_FINISHED {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Infof(ctx, "WatchRun '%v' closed by client", runID)
+
+			return nil
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return nil
+			}
+
+			if err = send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package watch рассылка изменений Run`ов подписчикам WatchRun в пределах одной реплики
+package watch
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// subscriptionBuffer если подписчик не успевает вычитывать события - новые события для него отбрасываются
+const subscriptionBuffer = 64
+
+// Subscription подписка на изменения одного Run`а
+type Subscription struct {
+	RunID  int32
+	Events <-chan *pb.WatchRunResponse
+
+	events chan *pb.WatchRunResponse
+	hub    *Hub
+	once   sync.Once
+	stop   func() bool
+}
+
+// Close отписка, канал Events закрывается
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// Hub хранит подписчиков и последнее отправленное состояние ScriptRun`ов, чтобы рассылать только изменения
+type Hub struct {
+	sync.Mutex
+	subscriptions map[int32]map[*Subscription]struct{}
+	scriptRuns    map[int32]map[int32]*pb.ScriptRun
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscriptions: make(map[int32]map[*Subscription]struct{}),
+		scriptRuns:    make(map[int32]map[int32]*pb.ScriptRun),
+	}
+}
+
+// Subscribe подписка на изменения Run`а, закрывается вместе с ctx подписчика
+func (h *Hub) Subscribe(ctx context.Context, runID int32) *Subscription {
+	events := make(chan *pb.WatchRunResponse, subscriptionBuffer)
+	subscription := &Subscription{RunID: runID, Events: events, events: events, hub: h}
+
+	h.Lock()
+	defer h.Unlock()
+
+	if _, ok := h.subscriptions[runID]; !ok {
+		h.subscriptions[runID] = make(map[*Subscription]struct{})
+	}
+	h.subscriptions[runID][subscription] = struct{}{}
+	// Close ждет h.Lock, поэтому отписка по ctx выполнится только после регистрации подписки
+	subscription.stop = context.AfterFunc(ctx, subscription.Close)
+
+	return subscription
+}
+
+func (h *Hub) unsubscribe(subscription *Subscription) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.removeLocked(subscription)
+}
+
+func (h *Hub) removeLocked(subscription *Subscription) {
+	subscription.once.Do(func() {
+		subscription.stop()
+		close(subscription.events)
+	})
+
+	subscriptions, ok := h.subscriptions[subscription.RunID]
+	if !ok {
+		return
+	}
+
+	delete(subscriptions, subscription)
+	if len(subscriptions) == 0 {
+		delete(h.subscriptions, subscription.RunID)
+		delete(h.scriptRuns, subscription.RunID)
+	}
+}
+
+// WatchedRunIDs Run`ы, на которые есть подписчики в этой реплике
+func (h *Hub) WatchedRunIDs() []int32 {
+	h.Lock()
+	defer h.Unlock()
+
+	runIDs := make([]int32, 0, len(h.subscriptions))
+	for runID := range h.subscriptions {
+		runIDs = append(runIDs, runID)
+	}
+
+	return runIDs
+}
+
+// PublishRun рассылка изменившихся ScriptRun`ов. Остановленный Run завершает все подписки на него
+func (h *Hub) PublishRun(run *pb.Run) {
+	if run == nil {
+		return
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	if _, ok := h.subscriptions[run.GetRunId()]; !ok {
+		return
+	}
+
+	last, ok := h.scriptRuns[run.GetRunId()]
+	if !ok {
+		last = make(map[int32]*pb.ScriptRun)
+		h.scriptRuns[run.GetRunId()] = last
+	}
+
+	var changed []*pb.ScriptRun
+	for _, scriptRun := range run.GetScriptRuns() {
+		previous, ok := last[scriptRun.GetRunScriptId()]
+		if ok && previous.GetStatus() == scriptRun.GetStatus() && previous.GetPid() == scriptRun.GetPid() &&
+			previous.GetAgent().GetHostName() == scriptRun.GetAgent().GetHostName() {
+			continue
+		}
+
+		last[scriptRun.GetRunScriptId()] = proto.Clone(scriptRun).(*pb.ScriptRun)
+		changed = append(changed, scriptRun)
+	}
+
+	if run.GetStatus() == pb.Run_STATUS_STOPPED_UNSPECIFIED {
+		h.sendLocked(run.GetRunId(), &pb.WatchRunResponse{
+			Event:      pb.WatchRunResponse_EVENT_FINISHED,
+			RunId:      run.GetRunId(),
+			RunStatus:  run.GetStatus(),
+			ScriptRuns: run.GetScriptRuns(),
+		})
+
+		for subscription := range h.subscriptions[run.GetRunId()] {
+			h.removeLocked(subscription)
+		}
+
+		return
+	}
+
+	if len(changed) > 0 {
+		h.sendLocked(run.GetRunId(), &pb.WatchRunResponse{
+			Event:      pb.WatchRunResponse_EVENT_SCRIPT_RUN,
+			RunId:      run.GetRunId(),
+			RunStatus:  run.GetStatus(),
+			ScriptRuns: changed,
+		})
+	}
+}
+
+// PublishMetrics рассылка текущих метрик ScriptRun`а
+func (h *Hub) PublishMetrics(runID int32, runScriptID int32, metrics *pb.Metrics) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.sendLocked(runID, &pb.WatchRunResponse{
+		Event:       pb.WatchRunResponse_EVENT_METRICS,
+		RunId:       runID,
+		RunScriptId: runScriptID,
+		Metrics:     metrics,
+	})
+}
+
+func (h *Hub) sendLocked(runID int32, event *pb.WatchRunResponse) {
+	event.Timestamp = timestamppb.Now()
+	for subscription := range h.subscriptions[runID] {
+		select {
+		case subscription.events <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package watch
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+)
+
+func TestHub_concurrentSubscribers(t *testing.T) {
+	const subscribers = 20
+
+	hub := NewHub()
+
+	var wg sync.WaitGroup
+
+	received := make(chan int32, subscribers)
+	ready := make(chan struct{}, subscribers)
+
+	for i := 0; i < subscribers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			subscription := hub.Subscribe(context.Background(), 1)
+			defer subscription.Close()
+
+			ready <- struct{}{}
+
+			event := <-subscription.Events
+			received <- event.GetRunScriptId()
+		}()
+	}
+
+	for i := 0; i < subscribers; i++ {
+		<-ready
+	}
+
+	// подписчики другого Run`а событие не получают
+	other := hub.Subscribe(context.Background(), 2)
+	defer other.Close()
+
+	hub.PublishMetrics(1, 7, &pb.Metrics{})
+	wg.Wait()
+	close(received)
+
+	count := 0
+	for runScriptID := range received {
+		if runScriptID != 7 {
+			t.Errorf("Subscription.Events run_script_id = %v, want 7", runScriptID)
+		}
+		count++
+	}
+
+	if count != subscribers {
+		t.Errorf("received %v events, want %v", count, subscribers)
+	}
+
+	if len(other.Events) != 0 {
+		t.Errorf("subscriber of another run received %v events", len(other.Events))
+	}
+
+	if runIDs := hub.WatchedRunIDs(); len(runIDs) != 1 || runIDs[0] != 2 {
+		t.Errorf("WatchedRunIDs() = %v, want [2]", runIDs)
+	}
+}
+
+func TestHub_unsubscribeOnContextCancel(t *testing.T) {
+	hub := NewHub()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription := hub.Subscribe(ctx, 1)
+
+	cancel()
+
+	select {
+	case _, ok := <-subscription.Events:
+		if ok {
+			t.Fatal("Subscription.Events received an event, want closed channel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Subscription.Events is not closed after the context is canceled")
+	}
+
+	if runIDs := hub.WatchedRunIDs(); len(runIDs) != 0 {
+		t.Errorf("WatchedRunIDs() = %v, want none", runIDs)
+	}
+
+	// повторная отписка и публикация после отписки не паникуют
+	subscription.Close()
+	hub.PublishMetrics(1, 7, &pb.Metrics{})
+
+	// подписка на уже отмененном ctx закрывается сразу
+	subscription = hub.Subscribe(ctx, 1)
+	select {
+	case <-subscription.Events:
+	case <-time.After(time.Second):
+		t.Fatal("Subscription.Events is not closed for the canceled context")
+	}
+}
+
+func TestHub_fullSubscriberBuffer(t *testing.T) {
+	hub := NewHub()
+
+	slow := hub.Subscribe(context.Background(), 1)
+	defer slow.Close()
+
+	fast := hub.Subscribe(context.Background(), 1)
+	defer fast.Close()
+
+	// публикация не блокируется медленным подписчиком, быстрый получает все события
+	const published = subscriptionBuffer + 10
+	for i := 0; i < published; i++ {
+		hub.PublishMetrics(1, int32(i), &pb.Metrics{}) //nolint:gosec
+
+		if event := <-fast.Events; event.GetRunScriptId() != int32(i) { //nolint:gosec
+			t.Fatalf("fast subscriber run_script_id = %v, want %v", event.GetRunScriptId(), i)
+		}
+	}
+
+	if len(slow.Events) != subscriptionBuffer {
+		t.Errorf("slow subscriber has %v events, want %v", len(slow.Events), subscriptionBuffer)
+	}
+
+	// при переполнении отбрасываются новые события
+	if event := <-slow.Events; event.GetRunScriptId() != 0 {
+		t.Errorf("first event of slow subscriber run_script_id = %v, want 0", event.GetRunScriptId())
+	}
+}
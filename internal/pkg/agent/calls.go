@@ -0,0 +1,316 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	agentapi2 "github.com/aliexpressru/alilo-backend/pkg/model/agentapi"
+	"github.com/aliexpressru/alilo-backend/pkg/util/httputil"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	retryDelay = 500 * time.Millisecond
+	// httpFallbackTTL после успешного вызова по HTTP агент вызывается по HTTP без попытки gRPC
+	httpFallbackTTL = 5 * time.Minute
+)
+
+// Start запуск скрипта на агенте(host:port). Без повторов: повторный вызов может запустить скрипт дважды
+func (a *Manager) Start(ctx context.Context, agentHost string, rq *agentapi.StartRequest) (
+	*agentapi.StartResponse, error) {
+	if a.isHTTPAgent(agentHost) {
+		return startHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost), rq)
+	}
+
+	rs, err := callAgent(ctx, a, agentHost, 0,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.StartResponse, error) {
+			return c.Start(ctx, rq)
+		})
+	if a.needHTTPFallback(ctx, agentHost, err) {
+		return startHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost), rq)
+	}
+
+	return rs, err
+}
+
+func (a *Manager) Stop(ctx context.Context, agentHost string, pid int64) error {
+	_, err := callAgent(ctx, a, agentHost, config.Get(ctx).AgentCallRetries,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.StopResponse, error) {
+			return c.Stop(ctx, &agentapi.StopRequest{Pid: pid})
+		})
+
+	return err
+}
+
+func (a *Manager) GetStatus(ctx context.Context, agentHost string, pid int64) (*agentapi.GetStatusResponse, error) {
+	if a.isHTTPAgent(agentHost) {
+		return getStatusHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost), pid)
+	}
+
+	rs, err := callAgent(ctx, a, agentHost, config.Get(ctx).AgentCallRetries,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.GetStatusResponse, error) {
+			return c.GetStatus(ctx, &agentapi.GetStatusRequest{Pid: pid})
+		})
+	if a.needHTTPFallback(ctx, agentHost, err) {
+		return getStatusHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost), pid)
+	}
+
+	return rs, err
+}
+
+func (a *Manager) GetAllTasks(ctx context.Context, agentHost string) (*agentapi.GetAllTasksResponse, error) {
+	if a.isHTTPAgent(agentHost) {
+		return getAllTasksHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost))
+	}
+
+	rs, err := callAgent(ctx, a, agentHost, config.Get(ctx).AgentCallRetries,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.GetAllTasksResponse, error) {
+			return c.GetAllTasks(ctx, &agentapi.GetAllTasksRequest{})
+		})
+	if a.needHTTPFallback(ctx, agentHost, err) {
+		return getAllTasksHTTP(ctx, agentHost, a.authHeaders(ctx, agentHost))
+	}
+
+	return rs, err
+}
+
+func (a *Manager) GetTaskLogs(ctx context.Context, agentHost string, rq *agentapi.GetTaskLogsRequest) (
+	*agentapi.GetTaskLogsResponse, error) {
+	return callAgent(ctx, a, agentHost, config.Get(ctx).AgentCallRetries,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.GetTaskLogsResponse, error) {
+			return c.GetTaskLogs(ctx, rq)
+		})
+}
+
+func (a *Manager) Metrics(ctx context.Context, agentHost string) (*agentapi.MetricsResponse, error) {
+	return callAgent(ctx, a, agentHost, config.Get(ctx).AgentCallRetries,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.MetricsResponse, error) {
+			return c.Metrics(ctx, &agentapi.MetricsRequest{})
+		})
+}
+
+// callAgent вызов с таймаутом AGENT_CALL_TIMEOUT на каждую попытку, повтор только при временных ошибках
+func callAgent[T any](ctx context.Context, a *Manager, agentHost string, retries int,
+	call func(context.Context, agentapi.AgentServiceClient) (T, error)) (rs T, err error) {
+	c, err := a.getClient(ctx, agentHost)
+	if err != nil {
+		return rs, err
+	}
+
+	timeout := config.Get(ctx).AgentCallTimeout
+
+	for attempt := 0; ; attempt++ {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		rs, err = call(callCtx, c)
+		cancel()
+
+		if err == nil || attempt >= retries || !isRetryable(err) {
+			return rs, err
+		}
+
+		logger.Warnf(ctx, "Agent{%v} call attempt %v/%v failed: '%v'", agentHost, attempt+1, retries+1, err)
+
+		select {
+		case <-ctx.Done():
+			return rs, err
+		case <-time.After(retryDelay * time.Duration(attempt+1)):
+		}
+	}
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// httpFallbackAllowed агент не поддерживает gRPC(Unimplemented) и включен AGENT_HTTP_FALLBACK.
+// Недоступный агент на HTTP не переводится, а с AGENT_TLS_ENABLED HTTP(без шифрования) не используется вовсе
+func httpFallbackAllowed(cfg *config.Config, err error) bool {
+	if err == nil || !cfg.AgentHTTPFallback || cfg.AgentTLSEnabled {
+		return false
+	}
+
+	return status.Code(err) == codes.Unimplemented
+}
+
+// needHTTPFallback перевод агента на HTTP на httpFallbackTTL
+func (a *Manager) needHTTPFallback(ctx context.Context, agentHost string, err error) bool {
+	if !httpFallbackAllowed(config.Get(ctx), err) {
+		return false
+	}
+
+	logger.Warnf(ctx, "Agent{%v} gRPC call failed, fallback to HTTP: '%v'", agentHost, err)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.httpAgents[agentHost] = time.Now()
+
+	return true
+}
+
+func (a *Manager) isHTTPAgent(agentHost string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	fallbackAt, ok := a.httpAgents[agentHost]
+	if ok && time.Since(fallbackAt) > httpFallbackTTL {
+		delete(a.httpAgents, agentHost)
+
+		return false
+	}
+
+	return ok
+}
+
+func startHTTP(ctx context.Context, agentHost string, headers map[string]string, rq *agentapi.StartRequest) (
+	*agentapi.StartResponse, error) {
+	envs := rq.GetEnvs()
+	startRequest := agentapi2.AgentStartRequest{
+		ScenarioTitle: rq.GetScenarioTitle(),
+		ScriptTitle:   rq.GetScriptTitle(),
+		ScriptURL:     rq.GetScriptURL(),
+		AmmoURL:       envs.GetAmmoUrl(),
+		Params: append([]string{"-e", fmt.Sprintf("RPS=%s", envs.GetRps()),
+			"-e", fmt.Sprintf("DURATION=%s", envs.GetDuration()),
+			"-e", fmt.Sprintf("STEPS=%s", envs.GetSteps()),
+		}, rq.GetParams()...),
+	}
+
+	keys := make([]string, 0, len(envs.GetAdditionalEnv()))
+	for key := range envs.GetAdditionalEnv() {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		startRequest.Params = append(startRequest.Params, "-e", fmt.Sprintf("%s=%s", key, envs.GetAdditionalEnv()[key]))
+	}
+
+	bytes, err := httputil.Post(ctx, fmt.Sprintf("http://%s/api/v1/start", agentHost),
+		"application/json", headers, startRequest)
+	if err != nil {
+		logger.Errorf(ctx, "HTTP Start request failed: %v", err)
+
+		return nil, err
+	}
+
+	rs := &agentapi.StartResponse{}
+	if err = json.Unmarshal(bytes, rs); err != nil {
+		logger.Errorf(ctx, "HTTP Start response{%s} Unmarshal err: %+v", bytes, err)
+
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func getStatusHTTP(ctx context.Context, agentHost string, headers map[string]string, pid int64) (
+	*agentapi.GetStatusResponse, error) {
+	bytes, err := httputil.Post(ctx, fmt.Sprintf("http://%s/api/v1/getStatus", agentHost),
+		"application/json", headers, &agentapi2.GetStatusRequest{Pid: pid})
+	if err != nil {
+		logger.Errorf(ctx, "HTTP get status request failed: %v", err)
+
+		return nil, err
+	}
+
+	rs := &agentapi2.ResponseGetStatus{}
+	if err = json.Unmarshal(bytes, rs); err != nil {
+		logger.Errorf(ctx, "HTTP get status response{%s} Unmarshal err: %v", bytes, err)
+
+		return nil, err
+	}
+
+	if rs.Error != "" {
+		return nil, errors.New(rs.Error)
+	}
+
+	return &agentapi.GetStatusResponse{
+		Task:    httpTaskToPb(rs.Task),
+		Metrics: httpMetricsToPb(rs.Metrics),
+	}, nil
+}
+
+func getAllTasksHTTP(ctx context.Context, agentHost string, headers map[string]string) (
+	*agentapi.GetAllTasksResponse, error) {
+	bytes, err := httputil.GetWithHeaders(ctx, fmt.Sprintf("http://%s/api/v1/getAllTasks", agentHost), headers)
+	if err != nil {
+		logger.Errorf(ctx, "HTTP get all tasks request failed: %v", err)
+
+		return nil, err
+	}
+
+	rs := &agentapi2.ResponseGetAllTasks{}
+	if err = json.Unmarshal(bytes, rs); err != nil {
+		logger.Errorf(ctx, "HTTP get all tasks response{%s} Unmarshal err: %v", bytes, err)
+
+		return nil, err
+	}
+
+	if rs.Error != "" {
+		return nil, errors.New(rs.Error)
+	}
+
+	tasks := make(map[int64]*agentapi.Task, len(rs.Tasks))
+	for pid, task := range rs.Tasks {
+		tasks[pid] = httpTaskToPb(task)
+	}
+
+	return &agentapi.GetAllTasksResponse{Tasks: tasks}, nil
+}
+
+func httpTaskToPb(task *agentapi2.Task) *agentapi.Task {
+	if task == nil {
+		return nil
+	}
+
+	return &agentapi.Task{
+		Pid:            task.Pid,
+		ScenarioTitle:  task.ScenarioTitle,
+		ScriptTitle:    task.ScriptTitle,
+		ScriptUrl:      task.ScriptURL,
+		ScriptFileName: task.ScriptFileName,
+		LogFileName:    task.LogFileName,
+		K6ApiPort:      task.K6ApiPort,
+		PortPrometheus: task.PortPrometheus,
+		Params:         task.Params,
+		StartTime:      timestamppb.New(task.StartTime),
+	}
+}
+
+func httpMetricsToPb(metrics *agentapi2.Metrics) *agentapi.Metrics {
+	if metrics == nil {
+		return nil
+	}
+
+	var failed int64
+	_, _ = fmt.Sscan(metrics.Failed, &failed)
+
+	return &agentapi.Metrics{
+		Rps:          metrics.Rps,
+		Rt_90P:       metrics.Rt90P,
+		Rt_95P:       metrics.Rt95P,
+		RtMax:        metrics.RtMax,
+		Rt_99P:       metrics.Rt99P,
+		Failed:       failed,
+		Vus:          metrics.Vus,
+		DataSent:     metrics.Sent,
+		DataReceived: metrics.Received,
+	}
+}
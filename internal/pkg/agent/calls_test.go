@@ -0,0 +1,54 @@
+package agent
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPFallbackAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		err  error
+		want bool
+	}{
+		{name: "no error", cfg: &config.Config{AgentHTTPFallback: true}},
+		{
+			name: "unimplemented",
+			cfg:  &config.Config{AgentHTTPFallback: true},
+			err:  status.Error(codes.Unimplemented, "unknown service"),
+			want: true,
+		},
+		{
+			name: "unavailable agent stays on gRPC",
+			cfg:  &config.Config{AgentHTTPFallback: true},
+			err:  status.Error(codes.Unavailable, "connection refused"),
+		},
+		{
+			name: "not a gRPC error",
+			cfg:  &config.Config{AgentHTTPFallback: true},
+			err:  errors.New("timeout"),
+		},
+		{
+			name: "fallback disabled",
+			cfg:  &config.Config{},
+			err:  status.Error(codes.Unimplemented, "unknown service"),
+		},
+		{
+			name: "tls enabled",
+			cfg:  &config.Config{AgentHTTPFallback: true, AgentTLSEnabled: true},
+			err:  status.Error(codes.Unimplemented, "unknown service"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := httpFallbackAllowed(tt.cfg, tt.err); got != tt.want {
+				t.Errorf("httpFallbackAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,16 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 	"sync"
 	"time"
 
 	agentclient "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
 
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	"github.com/aliexpressru/alilo-backend/internal/app/data"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
 	v1 "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
@@ -15,22 +20,43 @@ import (
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// agentConn клиент агента и его соединение, соединение закрывается при удалении клиента
+type agentConn struct {
+	client agentclient.AgentServiceClient
+	conn   *grpc.ClientConn
+}
+
 type Manager struct {
 	mu sync.Mutex
-	am map[string]agentclient.AgentServiceClient
+	am map[string]*agentConn
+	// httpAgents агенты без gRPC, вызываемые по HTTP(AGENT_HTTP_FALLBACK), и время перехода на HTTP
+	httpAgents map[string]time.Time
+	// tokens расшифрованные токены агентов(host:port), пустой - агенту токен не выдавался
+	tokens map[string]string
 
 	db *data.Store
+
+	// placementMu размещения выполняются последовательно, чтобы учитывать только что размещенные скрипты
+	placementMu sync.Mutex
+	placement   PlacementStrategy
+	pending     map[string][]time.Time
 }
 
 func NewAgentManager(ctx context.Context, db *data.Store, agentHosts ...string) (*Manager, error) {
 	a := &Manager{
-		mu: sync.Mutex{},
-		am: make(map[string]agentclient.AgentServiceClient),
+		mu:         sync.Mutex{},
+		am:         make(map[string]*agentConn),
+		httpAgents: make(map[string]time.Time),
+		tokens:     make(map[string]string),
 
 		db: db,
+
+		placement: NewPlacementStrategy(config.Get(ctx).AgentPlacementStrategy),
+		pending:   make(map[string][]time.Time),
 	}
 
 	for _, agent := range agentHosts {
@@ -60,7 +86,7 @@ func (a *Manager) getClient(ctx context.Context, agentHost string) (agentclient.
 	logger.Debugf(ctx, "GetAgentClient(%s)", agentHost)
 
 	if c, ok := a.am[agentHost]; ok {
-		return c, nil
+		return c.client, nil
 	}
 
 	newAgentClient, err := a.newClient(ctx, agentHost)
@@ -71,18 +97,67 @@ func (a *Manager) getClient(ctx context.Context, agentHost string) (agentclient.
 
 	a.am[agentHost] = newAgentClient
 
-	return newAgentClient, nil
+	return newAgentClient.client, nil
 }
 
-func (a *Manager) newClient(ctx context.Context, agentHost string) (agentclient.AgentServiceClient, error) {
+// RefreshClient пересоздание клиента агента, например после его перезапуска(RegisterAgent)
+func (a *Manager) RefreshClient(ctx context.Context, agent *models.Agent) error {
+	agentHost := undecided.GetMHost(agent)
+
+	newAgentClient, err := a.newClient(ctx, agentHost)
+	if err != nil {
+		logger.Errorf(ctx, "Error refreshing client agent{%v}: %+v", agentHost, err)
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.closeClientLocked(ctx, agentHost)
+	a.am[agentHost] = newAgentClient
+
+	return nil
+}
+
+// RemoveClient удаление клиента агента, который больше не используется(истек Heartbeat, агент удален)
+func (a *Manager) RemoveClient(ctx context.Context, agent *models.Agent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.closeClientLocked(ctx, undecided.GetMHost(agent))
+	delete(a.tokens, undecided.GetMHost(agent))
+}
+
+func (a *Manager) closeClientLocked(ctx context.Context, agentHost string) {
+	c, ok := a.am[agentHost]
+	if !ok {
+		return
+	}
+
+	delete(a.am, agentHost)
+
+	if err := c.conn.Close(); err != nil {
+		logger.Warnf(ctx, "Error closing conn agent{%v}: %+v", agentHost, err)
+	}
+}
+
+func (a *Manager) newClient(ctx context.Context, agentHost string) (*agentConn, error) {
 	ctx, canc := context.WithTimeout(ctx, 3*time.Second)
 	defer canc()
 
+	transportCredentials, err := newTransportCredentials(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "agent conn{%v} credentials err: %+v", agentHost, err)
+
+		return nil, err
+	}
+
 	cc, err := grpc.NewClient(
 		agentHost,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(transportCredentials),
 		grpc.WithChainUnaryInterceptor(
 			grpczap.UnaryClientInterceptor(zap.L()),
+			a.tokenInterceptor(agentHost),
 		),
 	)
 	if err != nil {
@@ -91,5 +166,35 @@ func (a *Manager) newClient(ctx context.Context, agentHost string) (agentclient.
 		return nil, err
 	}
 
-	return agentclient.NewAgentServiceClient(cc), nil
+	return &agentConn{client: agentclient.NewAgentServiceClient(cc), conn: cc}, nil
+}
+
+// newTransportCredentials TLS, если включен AGENT_TLS_ENABLED, иначе соединение без шифрования
+func newTransportCredentials(ctx context.Context) (credentials.TransportCredentials, error) {
+	cfg := config.Get(ctx)
+	if !cfg.AgentTLSEnabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.AgentTLSServerName,
+		InsecureSkipVerify: cfg.AgentTLSInsecureSkipVerify, //nolint:gosec
+	}
+
+	if cfg.AgentTLSCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.AgentTLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read agent CA file: %w", err)
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("agent CA file '%v' contains no certificates", cfg.AgentTLSCAFile)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
 }
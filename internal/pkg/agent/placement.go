@@ -0,0 +1,275 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+)
+
+const (
+	PlacementLeastLoaded = "least-loaded"
+	PlacementBinPacking  = "bin-packing"
+	PlacementSpread      = "spread"
+
+	// pendingPlacementTTL сколько времени размещенный скрипт учитывается до появления в метриках агента
+	pendingPlacementTTL = time.Minute
+	maxLoading          = 100
+)
+
+// Candidate агент, подходящий для размещения скрипта, и его текущая загрузка
+type Candidate struct {
+	Agent *models.Agent
+	// Scripts выполняющиеся на агенте ScriptRun`ы
+	Scripts int
+	// Pending недавно размещенные на агенте скрипты, еще не отраженные в его метриках
+	Pending int
+	// HostScripts выполняющиеся на хосте агента ScriptRun`ы, с учетом всех его портов
+	HostScripts int
+	// Loading оценка загрузки агента с учетом Pending
+	Loading int32
+}
+
+// PlacementStrategy выбор агента для запуска скрипта из не перегруженных кандидатов
+type PlacementStrategy interface {
+	Name() string
+	Choose(candidates []*Candidate) *Candidate
+}
+
+// NewPlacementStrategy неизвестное название - least-loaded
+func NewPlacementStrategy(name string) PlacementStrategy {
+	switch name {
+	case PlacementBinPacking:
+		return binPacking{}
+	case PlacementSpread:
+		return spread{}
+	default:
+		return leastLoaded{}
+	}
+}
+
+// leastLoaded наименее загруженный агент
+type leastLoaded struct{}
+
+func (leastLoaded) Name() string { return PlacementLeastLoaded }
+
+func (leastLoaded) Choose(candidates []*Candidate) *Candidate {
+	return choose(candidates, func(a, b *Candidate) bool {
+		if a.Loading != b.Loading {
+			return a.Loading < b.Loading
+		}
+
+		return a.Scripts+a.Pending < b.Scripts+b.Pending
+	})
+}
+
+// binPacking наиболее загруженный агент, у которого еще есть запас: свободные агенты остаются под крупные запуски
+type binPacking struct{}
+
+func (binPacking) Name() string { return PlacementBinPacking }
+
+func (binPacking) Choose(candidates []*Candidate) *Candidate {
+	return choose(candidates, func(a, b *Candidate) bool {
+		if a.Loading != b.Loading {
+			return a.Loading > b.Loading
+		}
+
+		return a.Scripts+a.Pending > b.Scripts+b.Pending
+	})
+}
+
+// spread агент на хосте с наименьшим числом скриптов, чтобы нагрузка не упиралась в один хост
+type spread struct{}
+
+func (spread) Name() string { return PlacementSpread }
+
+func (spread) Choose(candidates []*Candidate) *Candidate {
+	return choose(candidates, func(a, b *Candidate) bool {
+		if a.HostScripts != b.HostScripts {
+			return a.HostScripts < b.HostScripts
+		}
+
+		return a.Loading < b.Loading
+	})
+}
+
+func choose(candidates []*Candidate, less func(a, b *Candidate) bool) *Candidate {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return less(candidates[i], candidates[j])
+	})
+
+	return candidates[0]
+}
+
+// placeAgent выбор агента с тегом стратегией размещения. preferredHost(host:port) используется, если он не перегружен
+func (a *Manager) placeAgent(ctx context.Context, tag string, preferredHost string) (*pb.Agent, error) {
+	agents, err := a.GetAllEnabledAndCheckedAgents(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	runningScripts := a.getRunningScriptsByAgent(ctx)
+
+	a.placementMu.Lock()
+	defer a.placementMu.Unlock()
+
+	candidates, overloaded := a.getCandidates(ctx, agents, runningScripts)
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("all agents (tag: %s) are overloaded: %v", tag, overloaded)
+	}
+
+	chosen := preferredCandidate(candidates, preferredHost)
+	if chosen == nil {
+		chosen = a.placement.Choose(candidates)
+	}
+
+	host := undecided.GetMHost(chosen.Agent)
+	a.pending[host] = append(a.pending[host], time.Now())
+
+	logger.Infof(ctx, "Placement '%v': agent '%v' (loading: %v, scripts: %v, pending: %v); overloaded: %v",
+		a.placement.Name(), host, chosen.Loading, chosen.Scripts, chosen.Pending, overloaded)
+
+	return conv.ModelsToPbAgent(ctx, chosen.Agent)
+}
+
+// preferredCandidate кандидат preferredHost(host:port): на одном хосте может быть несколько агентов с разными портами
+func preferredCandidate(candidates []*Candidate, preferredHost string) *Candidate {
+	if preferredHost == "" {
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		if undecided.GetMHost(candidate.Agent) == preferredHost {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
+// getCandidates агенты, не достигшие лимитов, и список перегруженных агентов
+func (a *Manager) getCandidates(ctx context.Context, agents []*models.Agent, runningScripts map[string]int) (
+	candidates []*Candidate, overloaded []string) {
+	cfg := config.Get(ctx)
+
+	hostScripts := make(map[string]int)
+	for _, mAgent := range agents {
+		host := undecided.GetMHost(mAgent)
+		hostScripts[mAgent.HostName] += runningScripts[host] + len(a.getPendingLocked(host))
+	}
+
+	for _, mAgent := range agents {
+		host := undecided.GetMHost(mAgent)
+		pending := len(a.getPendingLocked(host))
+		candidate := &Candidate{
+			Agent:       mAgent,
+			Scripts:     runningScripts[host],
+			Pending:     pending,
+			HostScripts: hostScripts[mAgent.HostName],
+			Loading:     int32(mAgent.TotalLoading) + int32(pending)*cfg.AgentScriptLoadEstimate, //nolint:gosec
+		}
+
+		if reason := overloadReason(candidate, cfg); reason != "" {
+			overloaded = append(overloaded, fmt.Sprintf("%v(%v)", host, reason))
+
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, overloaded
+}
+
+// overloadReason пустая строка - агент может принять еще один скрипт
+func overloadReason(candidate *Candidate, cfg *config.Config) string {
+	mAgent := candidate.Agent
+
+	maxScripts := mAgent.MaxScripts
+	if maxScripts <= 0 {
+		maxScripts = cfg.AgentMaxScripts
+	}
+
+	switch {
+	case cfg.AgentMaxCPUUsed > 0 && mAgent.CPUUsed >= cfg.AgentMaxCPUUsed:
+		return fmt.Sprintf("cpu %v%%", mAgent.CPUUsed)
+	case cfg.AgentMaxMemUsed > 0 && mAgent.MemUsed >= cfg.AgentMaxMemUsed:
+		return fmt.Sprintf("mem %v%%", mAgent.MemUsed)
+	case cfg.AgentMaxPortsUsed > 0 && mAgent.PortsUsed >= cfg.AgentMaxPortsUsed:
+		return fmt.Sprintf("ports %v%%", mAgent.PortsUsed)
+	case maxScripts > 0 && int32(candidate.Scripts+candidate.Pending) >= maxScripts: //nolint:gosec
+		return fmt.Sprintf("scripts %v/%v", candidate.Scripts+candidate.Pending, maxScripts)
+	case candidate.Loading >= maxLoading:
+		return fmt.Sprintf("loading %v%%", candidate.Loading)
+	}
+
+	return ""
+}
+
+// getPendingLocked размещения за последние pendingPlacementTTL, устаревшие удаляются
+func (a *Manager) getPendingLocked(host string) []time.Time {
+	placements := a.pending[host]
+
+	actual := placements[:0]
+	for _, placedAt := range placements {
+		if time.Since(placedAt) < pendingPlacementTTL {
+			actual = append(actual, placedAt)
+		}
+	}
+
+	if len(actual) == 0 {
+		delete(a.pending, host)
+
+		return nil
+	}
+
+	a.pending[host] = actual
+
+	return actual
+}
+
+// getRunningScriptsByAgent количество выполняющихся ScriptRun`ов активных ранов по агентам(host:port)
+func (a *Manager) getRunningScriptsByAgent(ctx context.Context) map[string]int {
+	runningScripts := make(map[string]int)
+
+	mRuns, err := a.db.GetActiveMRuns(ctx)
+	if err != nil {
+		logger.Warnf(ctx, "Placement: running scripts are not taken into account: '%v'", err)
+
+		return runningScripts
+	}
+
+	for _, mRun := range mRuns {
+		for _, scriptRun := range conv.ModelToPBScriptRuns(ctx, mRun.ScriptRuns) {
+			if scriptRun.GetStatus() != pb.ScriptRun_STATUS_RUNNING || scriptRun.GetAgent() == nil {
+				continue
+			}
+
+			runningScripts[undecided.GetPBHost(scriptRun.GetAgent())]++
+		}
+	}
+
+	return runningScripts
+}
+
+// CountRunningScriptRuns количество выполняющихся на агенте(host:port) ScriptRun`ов
+func (a *Manager) CountRunningScriptRuns(ctx context.Context, agentHost string) int {
+	return a.getRunningScriptsByAgent(ctx)[agentHost]
+}
+
+// GetRunningScriptRunsByAgent количество выполняющихся ScriptRun`ов по агентам(host:port)
+func (a *Manager) GetRunningScriptRunsByAgent(ctx context.Context) map[string]int {
+	return a.getRunningScriptsByAgent(ctx)
+}
@@ -0,0 +1,139 @@
+package agent
+
+import (
+	"testing"
+
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+)
+
+func TestPreferredCandidate(t *testing.T) {
+	candidates := []*Candidate{
+		{Agent: &models.Agent{HostName: "agent-1", Port: "8888"}},
+		{Agent: &models.Agent{HostName: "agent-1", Port: "8889"}},
+	}
+
+	tests := []struct {
+		name          string
+		preferredHost string
+		want          *Candidate
+	}{
+		{name: "no preferred host"},
+		{name: "second port of the host", preferredHost: "agent-1:8889", want: candidates[1]},
+		{name: "host name without port", preferredHost: "agent-1"},
+		{name: "unknown host", preferredHost: "agent-2:8888"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := preferredCandidate(candidates, tt.preferredHost); got != tt.want {
+				t.Errorf("preferredCandidate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlacementStrategies(t *testing.T) {
+	// host-1 загружен сильнее всех, host-2 наименее загружен, на host-3 нет скриптов
+	newCandidates := func() []*Candidate {
+		return []*Candidate{
+			{Agent: &models.Agent{HostName: "host-1", Port: "1"}, Loading: 60, Scripts: 1, HostScripts: 3},
+			{Agent: &models.Agent{HostName: "host-2", Port: "1"}, Loading: 10, Scripts: 1, HostScripts: 1},
+			{Agent: &models.Agent{HostName: "host-3", Port: "1"}, Loading: 30, HostScripts: 0},
+		}
+	}
+
+	tests := []struct {
+		strategy string
+		want     string
+	}{
+		{strategy: PlacementLeastLoaded, want: "host-2:1"},
+		{strategy: PlacementBinPacking, want: "host-1:1"},
+		{strategy: PlacementSpread, want: "host-3:1"},
+		{strategy: "unknown", want: "host-2:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			chosen := NewPlacementStrategy(tt.strategy).Choose(newCandidates())
+			if got := chosen.Agent.HostName + ":" + chosen.Agent.Port; got != tt.want {
+				t.Errorf("Choose() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := NewPlacementStrategy(PlacementLeastLoaded).Choose(nil); got != nil {
+		t.Errorf("Choose(nil) = %v, want nil", got)
+	}
+}
+
+func TestPlacementStrategies_tieBreak(t *testing.T) {
+	newCandidates := func() []*Candidate {
+		return []*Candidate{
+			{Agent: &models.Agent{HostName: "busy"}, Loading: 20, Scripts: 2, Pending: 1, HostScripts: 1},
+			{Agent: &models.Agent{HostName: "idle"}, Loading: 20, Scripts: 1, HostScripts: 1},
+		}
+	}
+
+	if got := NewPlacementStrategy(PlacementLeastLoaded).Choose(newCandidates()); got.Agent.HostName != "idle" {
+		t.Errorf("least-loaded Choose() = %v, want idle", got.Agent.HostName)
+	}
+
+	if got := NewPlacementStrategy(PlacementBinPacking).Choose(newCandidates()); got.Agent.HostName != "busy" {
+		t.Errorf("bin-packing Choose() = %v, want busy", got.Agent.HostName)
+	}
+}
+
+func TestOverloadReason(t *testing.T) {
+	cfg := &config.Config{
+		AgentMaxCPUUsed:   85,
+		AgentMaxMemUsed:   85,
+		AgentMaxPortsUsed: 95,
+		AgentMaxScripts:   4,
+	}
+
+	tests := []struct {
+		name      string
+		candidate *Candidate
+		want      string
+	}{
+		{
+			name:      "free agent",
+			candidate: &Candidate{Agent: &models.Agent{CPUUsed: 10, MemUsed: 20, PortsUsed: 1}, Loading: 15},
+		},
+		{
+			name:      "cpu",
+			candidate: &Candidate{Agent: &models.Agent{CPUUsed: 90}},
+			want:      "cpu 90%",
+		},
+		{
+			name:      "mem",
+			candidate: &Candidate{Agent: &models.Agent{MemUsed: 85}},
+			want:      "mem 85%",
+		},
+		{
+			name:      "ports",
+			candidate: &Candidate{Agent: &models.Agent{PortsUsed: 96}},
+			want:      "ports 96%",
+		},
+		{
+			name:      "scripts with pending",
+			candidate: &Candidate{Agent: &models.Agent{}, Scripts: 3, Pending: 1},
+			want:      "scripts 4/4",
+		},
+		{
+			name:      "agent max scripts overrides config",
+			candidate: &Candidate{Agent: &models.Agent{MaxScripts: 10}, Scripts: 5},
+		},
+		{
+			name:      "loading",
+			candidate: &Candidate{Agent: &models.Agent{}, Loading: maxLoading},
+			want:      "loading 100%",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overloadReason(tt.candidate, cfg); got != tt.want {
+				t.Errorf("overloadReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package agent
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	agentapi "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
+	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	tokenHeader = "authorization"
+	tokenScheme = "Bearer "
+	tokenBytes  = 32
+)
+
+// errTokenChanged токен агента в БД изменил другой экземпляр бэкенда, пока выполнялась ротация
+var errTokenChanged = errors.New("the token is rotated by another backend instance")
+
+// TokensEnabled токены выдаются агентам, если задан ключ их шифрования(AGENT_TOKEN_KEY)
+// и соединения с агентами защищены TLS(AGENT_TLS_ENABLED), без TLS токен передавался бы открытым текстом
+func TokensEnabled(ctx context.Context) bool {
+	cfg := config.Get(ctx)
+
+	return cfg.AgentTokenKey != "" && cfg.AgentTLSEnabled
+}
+
+// EnsureToken выдача токена агенту без токена и перевыпуск токена старше AGENT_TOKEN_TTL
+func (a *Manager) EnsureToken(ctx context.Context, mAgent *models.Agent) error {
+	if !TokensEnabled(ctx) {
+		return nil
+	}
+
+	ttl := config.Get(ctx).AgentTokenTTL
+	if mAgent.TokenEncrypted != "" && (ttl <= 0 || time.Since(mAgent.TokenRotatedAt.Time) < ttl) {
+		return nil
+	}
+
+	if err := a.RotateToken(ctx, mAgent); !errors.Is(err, errTokenChanged) {
+		return err
+	}
+
+	logger.Infof(ctx, "Agent '%v' token is already rotated by another backend instance", undecided.GetMHost(mAgent))
+
+	return nil
+}
+
+// RotateToken выпуск нового токена агента: токен отправляется агенту(SetToken) с текущим токеном
+// и только после этого сохраняется в БД, иначе агент и бэкенд разойдутся в токене.
+// Перед отправкой ротация захватывается в БД(token_rotated_at меняется, только если токен не менялся
+// с чтения mAgent), поэтому параллельные ротации на разных экземплярах бэкенда не отправят агенту два токена
+func (a *Manager) RotateToken(ctx context.Context, mAgent *models.Agent) error {
+	cfg := config.Get(ctx)
+	if !TokensEnabled(ctx) {
+		return errors.New("agent tokens are disabled: AGENT_TOKEN_KEY is not set or AGENT_TLS_ENABLED is off")
+	}
+
+	aead, err := newTokenCipher(cfg.AgentTokenKey)
+	if err != nil {
+		return err
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encryptToken(aead, token)
+	if err != nil {
+		return err
+	}
+
+	host := undecided.GetMHost(mAgent)
+	previousRotatedAt := mAgent.TokenRotatedAt
+	// точность timestamp в БД - микросекунды, иначе следующее условное обновление не найдет строку
+	rotatedAt := null.TimeFrom(time.Now().UTC().Truncate(time.Microsecond))
+
+	claimed, err := a.db.UpdateMAgentTokenIf(ctx, mAgent, mAgent.TokenEncrypted, rotatedAt)
+	if err != nil {
+		return err
+	} else if !claimed {
+		return errTokenChanged
+	}
+
+	// без повторов: после потерянного ответа агент уже не примет текущий токен
+	_, err = callAgent(ctx, a, host, 0,
+		func(ctx context.Context, c agentapi.AgentServiceClient) (*agentapi.SetTokenResponse, error) {
+			return c.SetToken(ctx, &agentapi.SetTokenRequest{AccessToken: token, Env: cfg.ENV})
+		})
+	if err != nil {
+		// агент сохранил старый токен: возврат token_rotated_at, чтобы ротация повторилась
+		if _, er := a.db.UpdateMAgentTokenIf(ctx, mAgent, mAgent.TokenEncrypted, previousRotatedAt); er != nil {
+			logger.Errorf(ctx, "Agent '%v' token rotation release: '%v'", host, er)
+		}
+
+		return fmt.Errorf("set token agent '%v': %w", host, err)
+	}
+
+	// агент уже принимает только новый токен, он используется, даже если не сохранится в БД
+	a.setToken(host, token)
+
+	saved, err := a.db.UpdateMAgentTokenIf(ctx, mAgent, encrypted, rotatedAt)
+	if err != nil {
+		return err
+	} else if !saved {
+		return fmt.Errorf("save token agent '%v': %w", host, errTokenChanged)
+	}
+
+	logger.Infof(ctx, "Agent '%v' token is rotated", host)
+
+	return nil
+}
+
+// tokenInterceptor добавление токена агента в каждый вызов. Unauthenticated - токен мог перевыпустить
+// другой экземпляр бэкенда, вызов повторяется с токеном из БД
+func (a *Manager) tokenInterceptor(agentHost string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		token := a.getToken(ctx, agentHost)
+
+		err := invoker(withToken(ctx, token), method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unauthenticated {
+			return err
+		}
+
+		a.forgetToken(agentHost)
+
+		if fresh := a.getToken(ctx, agentHost); fresh != "" && fresh != token {
+			logger.Warnf(ctx, "Agent{%v} rejected the cached token, retry with the token from DB", agentHost)
+
+			return invoker(withToken(ctx, fresh), method, req, reply, cc, opts...)
+		}
+
+		return err
+	}
+}
+
+// authHeaders заголовок с токеном агента для вызовов по HTTP(AGENT_HTTP_FALLBACK)
+func (a *Manager) authHeaders(ctx context.Context, agentHost string) map[string]string {
+	token := a.getToken(ctx, agentHost)
+	if token == "" {
+		return map[string]string{}
+	}
+
+	return map[string]string{"Authorization": tokenScheme + token}
+}
+
+// getToken расшифрованный токен агента(host:port) из кеша или БД, пустой - агенту токен не выдавался
+func (a *Manager) getToken(ctx context.Context, agentHost string) string {
+	if !TokensEnabled(ctx) {
+		return ""
+	}
+
+	cfg := config.Get(ctx)
+
+	a.mu.Lock()
+	token, ok := a.tokens[agentHost]
+	a.mu.Unlock()
+
+	if ok {
+		return token
+	}
+
+	hostName, port, err := net.SplitHostPort(agentHost)
+	if err != nil {
+		logger.Warnf(ctx, "Agent{%v} token: '%v'", agentHost, err)
+
+		return ""
+	}
+
+	mAgent, err := a.db.GetMAgentByHost(ctx, hostName, port)
+	if err != nil {
+		logger.Warnf(ctx, "Agent{%v} token: '%v'", agentHost, err)
+
+		return ""
+	}
+
+	if mAgent != nil && mAgent.TokenEncrypted != "" {
+		token, err = decryptToken(cfg.AgentTokenKey, mAgent.TokenEncrypted)
+		if err != nil {
+			// токен, зашифрованный другим ключом, заменится при следующей ротации
+			logger.Errorf(ctx, "Agent{%v} token: '%v'", agentHost, err)
+		}
+	}
+
+	a.setToken(agentHost, token)
+
+	return token
+}
+
+func (a *Manager) setToken(agentHost string, token string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.tokens[agentHost] = token
+}
+
+func (a *Manager) forgetToken(agentHost string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.tokens, agentHost)
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, tokenHeader, tokenScheme+token)
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate agent token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// newTokenCipher AES-256-GCM по base64 ключу AGENT_TOKEN_KEY
+func newTokenCipher(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, errors.New("agent tokens are disabled: AGENT_TOKEN_KEY is not set")
+	}
+
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decode AGENT_TOKEN_KEY: %w", err)
+	}
+
+	if len(rawKey) != 32 {
+		return nil, fmt.Errorf("AGENT_TOKEN_KEY must be 32 bytes, got %v", len(rawKey))
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("agent token cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encryptToken base64(nonce + шифротекст)
+func encryptToken(aead cipher.AEAD, token string) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("agent token nonce: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(token), nil)), nil
+}
+
+func decryptToken(key string, encrypted string) (string, error) {
+	aead, err := newTokenCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("decode agent token: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("decrypt agent token: token is too short")
+	}
+
+	token, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt agent token: %w", err)
+	}
+
+	return string(token), nil
+}
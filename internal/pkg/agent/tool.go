@@ -3,20 +3,18 @@ package agent
 import (
 	"context"
 	"fmt"
+	"time"
 
-	v1 "github.com/aliexpressru/alilo-backend/pkg/clients/pb/qa/loadtesting/alilo/agent-v2/agent/api/qa/loadtesting/alilo/agent/v1"
-
-	"github.com/aliexpressru/alilo-backend/internal/app/conv"
+	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	models "github.com/aliexpressru/alilo-backend/internal/app/dbmodels"
 	"github.com/aliexpressru/alilo-backend/internal/app/util"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
-	"github.com/aliexpressru/alilo-backend/pkg/util/math"
+	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
 )
 
-const geometricDistributionParam = 0.3
-
-// GetAFreeAgent Функция получения pb.Agent для запуска скрипта.
+// GetAFreeAgent Функция получения pb.Agent для запуска скрипта стратегией размещения(AGENT_PLACEMENT_STRATEGY).
+// Перегруженные агенты не выбираются; если перегружены все - ошибка
 func (a *Manager) GetAFreeAgent(ctx context.Context, tag string) (agentHost *pb.Agent, err error) {
 	logger.Infof(ctx, "Get A Free Agent.")
 
@@ -27,36 +25,37 @@ func (a *Manager) GetAFreeAgent(ctx context.Context, tag string) (agentHost *pb.
 		return nil, err
 	}
 
-	allAgents, message := a.GetAllEnabledAndCheckedAgents(ctx, tag)
-	if message != nil {
-		return nil, message
-	}
-
-	var randAgent *models.Agent
-
-	l := len(allAgents)
+	agentHost, err = a.placeAgent(ctx, tag, "")
+	if err != nil {
+		logger.Warnf(ctx, "GetAFreeAgent: '%v'", err)
 
-	if l <= 0 {
-		return nil, fmt.Errorf("0 free agents (tag: %s) (all: %v)", tag, l)
+		return nil, err
 	}
 
-	var i int
-	if l != 1 {
-		i = math.GeometricRandomValue(ctx, geometricDistributionParam, l)
-	}
+	logger.Debugf(ctx, "Get A Free Agent success. pb: %#v", agentHost)
 
-	randAgent = allAgents[i]
+	return agentHost, nil
+}
 
-	rAgent, err := conv.ModelsToPbAgent(ctx, randAgent)
+// GetAgentOrEquivalent агент host(host:port), если он включен, подходит по тегу и не перегружен,
+// иначе - агент с тегом, выбранный стратегией размещения
+func (a *Manager) GetAgentOrEquivalent(ctx context.Context, host string, tag string) (agentHost *pb.Agent, err error) {
+	tag, err = util.CheckingTagForPresenceInDB(ctx, tag, a.db)
 	if err != nil {
-		logger.Errorf(ctx, "ModelsToPbAgent err: %v", err)
+		return nil, err
+	}
 
+	agentHost, err = a.placeAgent(ctx, tag, host)
+	if err != nil {
 		return nil, err
 	}
 
-	logger.Debugf(ctx, "Get A Free Agent success. db: %#v pb: %#v", rAgent, randAgent)
+	if undecided.GetPBHost(agentHost) != host {
+		logger.Infof(ctx, "Agent '%v' is not available, got an equivalent '%v' by tag '%v'",
+			host, undecided.GetPBHost(agentHost), tag)
+	}
 
-	return rAgent, nil
+	return agentHost, nil
 }
 
 func (a *Manager) GetAllEnabledAndCheckedAgents(ctx context.Context, tag string) (agents []*models.Agent, err error) {
@@ -80,29 +79,25 @@ func (a *Manager) GetAllEnabledAndCheckedAgents(ctx context.Context, tag string)
 		return nil, err
 	}
 
-	//return a.CheckingAgentsAvailability(ctx, agents), nil
+	agents = a.CheckingAgentsAvailability(ctx, agents)
+	if len(agents) == 0 {
+		err = fmt.Errorf("get all mAgents: all available agents are quarantined or draining")
+		logger.Warnf(ctx, "GetAllAgents: message:'%v'", err)
+
+		return nil, err
+	}
+
 	return agents, nil
 }
 
-// CheckingAgentsAvailability Функция проверяет доступность переданных агентов
+// CheckingAgentsAvailability Функция отбрасывает агентов в карантине, выводимых на обслуживание(draining)
+// и саморегистрирующихся агентов без Heartbeat`а.
+// Доступность агентов проверяет AgentsTracker
 func (a *Manager) CheckingAgentsAvailability(ctx context.Context, notCheckedAgents []*models.Agent) (
 	checkedAgents []*models.Agent) {
 	for _, mAgent := range notCheckedAgents {
-		ac, err := a.GetClientM(ctx, mAgent)
-		if err != nil {
-			continue
-		}
-
-		_, err = ac.GetAllTasks(ctx, &v1.GetAllTasksRequest{})
-		if err != nil {
-			logger.Warnf(ctx, "Checking pbAgent, err: '%v'", err)
-
-			mAgent.Enabled = false
-
-			_, err = a.db.UpdateMAgent(ctx, mAgent)
-			if err != nil {
-				logger.Warnf(ctx, "Checking pbAgent, mAgent.Update errorUpdate: '%v'", err)
-			}
+		if reason := UnavailableReason(ctx, mAgent); reason != "" {
+			logger.Debugf(ctx, "Agent '%v' is %v", undecided.GetMHost(mAgent), reason)
 
 			continue
 		}
@@ -112,3 +107,27 @@ func (a *Manager) CheckingAgentsAvailability(ctx context.Context, notCheckedAgen
 
 	return checkedAgents
 }
+
+// UnavailableReason причина исключения включенного агента из размещения, пустая строка - агент доступен
+func UnavailableReason(ctx context.Context, mAgent *models.Agent) string {
+	switch {
+	case mAgent.Quarantined:
+		return fmt.Sprintf("quarantined: '%v'", mAgent.LastError)
+	case mAgent.Draining:
+		return "draining"
+	case IsExpired(ctx, mAgent):
+		return fmt.Sprintf("expired, last heartbeat: '%v'", mAgent.LastHeartbeatAt.Time)
+	default:
+		return ""
+	}
+}
+
+// IsExpired агент зарегистрировался сам и не присылает Heartbeat дольше AGENT_HEARTBEAT_TTL
+func IsExpired(ctx context.Context, mAgent *models.Agent) bool {
+	if !mAgent.SelfRegistered {
+		return false
+	}
+
+	return !mAgent.LastHeartbeatAt.Valid ||
+		time.Since(mAgent.LastHeartbeatAt.Time) > config.Get(ctx).AgentHeartbeatTTL
+}
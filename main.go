@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
 	"time"
+	// часовые пояса для CRON_TZ в расписаниях: в образе нет tzdata
+	_ "time/tzdata"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -28,6 +31,7 @@ import (
 	"github.com/aliexpressru/alilo-backend/internal/pkg/agent"
 	pb "github.com/aliexpressru/alilo-backend/pkg/pb/qa/loadtesting/alilo/backend/v1"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	"github.com/aliexpressru/alilo-backend/pkg/util/mw"
 	strUtils "github.com/aliexpressru/alilo-backend/pkg/util/string"
 	"github.com/aliexpressru/alilo-backend/pkg/util/undecided"
 	"github.com/jmoiron/sqlx"
@@ -84,7 +88,11 @@ func main() {
 	serviceImpl := svc.New(db, pp, am) //fixme pp - не должен туда передаваться!
 
 	// todo open source refactoring ???
-	grpcServer := grpc.NewServer()
+	// паника в обработчике не роняет сервер: recovery внутри logging, чтобы запрос попал в лог с codes.Internal
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(mw.LoggingUnaryServerInterceptor(), mw.RecoveryUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(mw.LoggingStreamServerInterceptor(), mw.RecoveryStreamServerInterceptor()),
+	)
 	gwMux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
@@ -96,11 +104,14 @@ func main() {
 			},
 		}),
 		//runtime.WithErrorHandler(yourErrorHandler),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 	)
 
 	//// register grpc handlers
 	pb.RegisterUploadServiceServer(grpcServer, serviceImpl)
 	pb.RegisterCommandServiceServer(grpcServer, serviceImpl)
+	pb.RegisterRunServiceServer(grpcServer, serviceImpl)
+	pb.RegisterAgentServiceServer(grpcServer, serviceImpl)
 
 	// register http handlers
 	err = pb.RegisterProjectServiceHandlerServer(ctx, gwMux, serviceImpl)
@@ -143,6 +154,10 @@ func main() {
 	if err != nil {
 		logger.Errorf(ctx, "failed to register S3HandlerServer: %v", err)
 	}
+	err = pb.RegisterScheduleServiceHandlerServer(ctx, gwMux, serviceImpl)
+	if err != nil {
+		logger.Errorf(ctx, "failed to register ScheduleServiceHandlerServer: %v", err)
+	}
 
 	// Create main mux that combines gRPC-Gateway and Swagger
 	mainMux := http.NewServeMux()
@@ -162,6 +177,8 @@ func main() {
 		}
 		logger.Infof(ctx, "wrote %d bytes of SwaggerHTML", res)
 	}))
+	// стрим WatchRun по HTTP отдается как server-sent events
+	mainMux.Handle(svc.WatchRunSSEPath, http.HandlerFunc(serviceImpl.WatchRunSSE))
 	mainMux.Handle("/", gwMux) // gRPC-Gateway endpoints last
 
 	// Создаем HTTP-сервер
@@ -178,6 +195,17 @@ func main() {
 		}
 	}()
 
+	go func() {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.ServicePortGRPC))
+		if err != nil {
+			log.Fatalf("failed to listen gRPC: %v", err)
+		}
+		logger.Infof(ctx, "starting gRPC server on port %d", cfg.ServicePortGRPC)
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatalf("failed to serve gRPC: %v", err)
+		}
+	}()
+
 	// Обработка graceful shutdown (опционально)
 	// Например, при получении сигнала SIGINT или SIGTERM
 	quit := make(chan os.Signal, 1)
@@ -185,9 +213,35 @@ func main() {
 	<-quit
 
 	logger.Info(ctx, "shutting down server...")
-	if err := server.Shutdown(ctx); err != nil {
+	// SSE-подписки и стримы WatchRun не завершаются сами, поэтому ожидание ограничено
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
 		logger.Error(ctx, "server shutdown failed", zap.Error(err))
 	}
+
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		logger.Warn(ctx, "gRPC graceful stop timed out, closing connections")
+		grpcServer.Stop()
+	}
+}
+
+// incomingHeaderMatcher пробрасывает в metadata пользователя запроса, остальные заголовки - по умолчанию
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, undecided.XIdentificationHeader) {
+		return undecided.XIdentificationHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
 }
 
 func runningJobs(dataStore *data.Store, am *agent.Manager, cfg *config.Config) *job.ProcessorPool {
@@ -197,15 +251,10 @@ func runningJobs(dataStore *data.Store, am *agent.Manager, cfg *config.Config) *
 	})
 	logger.Warnf(ctx, "FileUploader is running")
 
-	logger.Warnf(ctx, "Running CommandProvider")
-	execPool.Go(func() {
-		job.CommandProvider(ctx, dataStore)
-	})
-	logger.Warnf(ctx, "CommandProvider is running")
-
+	pbStore := datapb.NewStore(dataStore)
 	pp := job.NewProcessorPool(
 		dataStore,
-		datapb.NewStore(dataStore),
+		pbStore,
 		am,
 	)
 	for i := 0; i < cfg.CmdProcessorsCount; i++ {
@@ -222,6 +271,24 @@ func runningJobs(dataStore *data.Store, am *agent.Manager, cfg *config.Config) *
 	})
 	logger.Warnf(ctx, "StatisticTracker is running")
 
+	logger.Warnf(ctx, "Running AgentsTracker")
+	execPool.Go(func() {
+		job.AgentsTracker(ctx, pp)
+	})
+	logger.Warnf(ctx, "AgentsTracker is running")
+
+	logger.Warnf(ctx, "Running CommandCleaner")
+	execPool.Go(func() {
+		job.CommandCleaner(ctx, dataStore)
+	})
+	logger.Warnf(ctx, "CommandCleaner is running")
+
+	logger.Warnf(ctx, "Running Scheduler")
+	execPool.Go(func() {
+		job.Scheduler(ctx, pbStore)
+	})
+	logger.Warnf(ctx, "Scheduler is running")
+
 	return pp
 }
 
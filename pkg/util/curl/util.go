@@ -2,18 +2,19 @@ package curl
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
-	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	"github.com/mattn/go-shellwords"
+	"github.com/pkg/errors"
 )
 
-// var logger = zap.S()
-
 type Headers map[string]string
 
 type QueryParams struct {
@@ -29,13 +30,78 @@ type Request struct {
 	Body        string        `json:"body"`
 }
 
-const user = "user"
-const userAgent = "user-agent"
-const header = "header"
-const data = "data"
-const method = "method"
-const cookie = "cookie"
-const head = "HEAD"
+// Warning флаг команды, который не удалось перенести в запрос
+type Warning struct {
+	Flag    string `json:"flag"`
+	Message string `json:"message"`
+}
+
+const (
+	head             = "HEAD"
+	contentType      = "Content-Type"
+	formContentType  = "application/x-www-form-urlencoded"
+	jsonContentType  = "application/json"
+	compressEncoding = "deflate, gzip, br, zstd"
+	// multipartBoundary фиксированная граница, чтобы тело -F было одинаковым при каждом разборе
+	multipartBoundary = "------------------------alilo"
+)
+
+// flagAliases короткие флаги и их длинные имена
+var flagAliases = map[string]string{
+	"-X": "--request",
+	"-H": "--header",
+	"-A": "--user-agent",
+	"-e": "--referer",
+	"-u": "--user",
+	"-b": "--cookie",
+	"-d": "--data",
+	"-F": "--form",
+	"-G": "--get",
+	"-I": "--head",
+	"-k": "--insecure",
+	"-L": "--location",
+	"-s": "--silent",
+	"-S": "--show-error",
+	"-v": "--verbose",
+	"-i": "--include",
+	"-f": "--fail",
+	"-g": "--globoff",
+	"-N": "--no-buffer",
+	"-o": "--output",
+	"-O": "--remote-name",
+	"-w": "--write-out",
+	"-m": "--max-time",
+	"-x": "--proxy",
+	"-c": "--cookie-jar",
+	"-T": "--upload-file",
+	"-r": "--range",
+	"-E": "--cert",
+	"-0": "--http1.0",
+}
+
+// valueFlags флаги со значением
+var valueFlags = map[string]bool{
+	"--request": true, "--header": true, "--user-agent": true, "--referer": true, "--user": true, "--cookie": true,
+	"--data": true, "--data-ascii": true, "--data-raw": true, "--data-binary": true, "--data-urlencode": true,
+	"--json": true, "--form": true, "--form-string": true, "--url": true, "--output": true, "--write-out": true,
+	"--max-time": true, "--connect-timeout": true, "--retry": true, "--retry-delay": true, "--retry-max-time": true,
+	"--proxy": true, "--proxy-user": true, "--cookie-jar": true, "--upload-file": true, "--range": true,
+	"--cacert": true, "--capath": true, "--cert": true, "--key": true, "--cert-type": true, "--key-type": true,
+	"--resolve": true, "--connect-to": true, "--interface": true, "--limit-rate": true, "--max-redirs": true,
+	"--oauth2-bearer": true, "--request-target": true, "--trace": true, "--trace-ascii": true, "--stderr": true,
+}
+
+// ignoredFlags флаги, не влияющие на отправляемый запрос или учтенные в скрипте(-k: скрипты не проверяют TLS)
+var ignoredFlags = map[string]bool{
+	"--insecure": true, "--location": true, "--silent": true, "--show-error": true, "--verbose": true,
+	"--include": true, "--fail": true, "--globoff": true, "--no-buffer": true, "--output": true,
+	"--remote-name": true, "--write-out": true, "--max-time": true, "--connect-timeout": true, "--retry": true,
+	"--retry-delay": true, "--retry-max-time": true, "--max-redirs": true, "--location-trusted": true,
+	"--http1.0": true, "--http1.1": true, "--http2": true, "--http2-prior-knowledge": true, "--http3": true,
+	"--tlsv1.2": true, "--tlsv1.3": true, "--progress-bar": true, "--no-progress-meter": true,
+	"--path-as-is": true, "--trace": true, "--trace-ascii": true, "--stderr": true, "--limit-rate": true,
+	"--cacert": true, "--capath": true,
+}
 
 func (r *Request) ToJSON(format bool) string {
 	buffer := &bytes.Buffer{}
@@ -51,186 +117,560 @@ func (r *Request) ToJSON(format bool) string {
 	return buffer.String()
 }
 
-func Parse(ctx context.Context, curl string) (*Request, bool) {
-	defer func() {
-		if err := recover(); err != nil {
-			logger.Errorf(ctx, "ParseCUrl failed: '%+v'", err)
+// ToCUrl команда cURL запроса, значения экранируются для bash
+func (r *Request) ToCUrl() string {
+	var b strings.Builder
+
+	b.WriteString("curl")
+
+	method := strings.ToUpper(r.Method)
+
+	switch {
+	case method == head:
+		b.WriteString(" --head")
+	case method != "" && method != "GET" && !(method == "POST" && r.Body != ""):
+		b.WriteString(" -X " + method)
+	}
+
+	b.WriteString(" " + quote(r.FullURL()))
+
+	keys := make([]string, 0, len(r.Headers))
+	for key := range r.Headers {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		b.WriteString(" \\\n  -H " + quote(key+": "+r.Headers[key]))
+	}
+
+	if r.Body != "" {
+		b.WriteString(" \\\n  --data-raw " + quote(r.Body))
+	}
+
+	return b.String()
+}
+
+// FullURL URL с параметрами запроса
+func (r *Request) FullURL() string {
+	if len(r.QueryParams) == 0 {
+		return r.URL
+	}
+
+	pairs := make([]string, 0, len(r.QueryParams))
+
+	for _, param := range r.QueryParams {
+		if param.Value == "" {
+			pairs = append(pairs, param.Key)
+
+			continue
 		}
-	}()
 
-	if strings.Index(curl, "curl ") != 0 {
-		return nil, false
+		pairs = append(pairs, param.Key+"="+param.Value)
 	}
 
-	args, err := shellwords.Parse(curl)
+	return r.URL + "?" + strings.Join(pairs, "&")
+}
+
+// Parse разбор команды cURL(в том числе "Copy as cURL" браузеров). Флаги, которые нельзя перенести в запрос
+// (файлы, прокси, неизвестные флаги), пропускаются с предупреждением
+func Parse(curl string) (request *Request, warnings []Warning, err error) {
+	args, err := splitArgs(curl)
 	if err != nil {
-		return nil, false
-	}
-
-	args = rewrite(args)
-	request := &Request{
-		Method:      "GET",
-		Headers:     Headers{},
-		QueryParams: []QueryParams{},
-	}
-	state := ""
-
-	for _, arg := range args {
-		switch true {
-		case isURL(arg):
-			if strings.Contains(arg, "?") {
-				url := strings.Split(arg, "?")
-				request.URL = url[0]
-
-				if len(url) > 1 {
-					if strings.Contains(url[1], "&") {
-						queryParams := strings.Split(url[1], "&")
-						for _, queryParam := range queryParams {
-							if strings.Contains(queryParam, "=") {
-								param := strings.Split(queryParam, "=")
-								request.QueryParams = append(request.QueryParams,
-									QueryParams{Key: param[0], Value: param[1]})
-							} else {
-								request.QueryParams = append(request.QueryParams, QueryParams{Key: queryParam})
-							}
-						}
-					} else {
-						if strings.Contains(url[1], "=") {
-							param := strings.Split(url[1], "=")
-							request.QueryParams = append(request.QueryParams,
-								QueryParams{Key: param[0], Value: param[1]})
-						} else {
-							request.QueryParams = append(request.QueryParams, QueryParams{Key: url[1]})
-						}
-					}
-				}
-			} else {
-				request.URL = arg
+		return nil, nil, err
+	}
+
+	if len(args) == 0 || (args[0] != "curl" && args[0] != "curl.exe") {
+		return nil, nil, errors.New("Error parse cURL: the command must start with 'curl'")
+	}
+
+	p := &parser{request: &Request{Headers: Headers{}, QueryParams: []QueryParams{}}}
+
+	args = args[1:]
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			p.setURL(arg)
+
+			continue
+		}
+
+		flag, value, hasValue := expandFlag(arg)
+		if flag == "" {
+			// набор коротких флагов без значений: -sSLk
+			for _, short := range arg[1:] {
+				p.apply("-"+string(short), "")
 			}
 
-		case arg == "-A" || arg == "--user-agent":
-			state = userAgent
-
-		case arg == "-H" || arg == "--header":
-			state = header
-
-		case arg == "-d" || arg == "--data" || arg == "--data-ascii" || arg == "--data-raw":
-			state = data
-
-		case arg == "-u" || arg == "--user":
-			state = user
-
-		case arg == "-I" || arg == "--head":
-			request.Method = head
-
-		case arg == "-X" || arg == "--request":
-			state = method
-
-		case arg == "-b" || arg == "--cookie":
-			state = cookie
-		case len(arg) > 0:
-			switch state {
-			case header:
-				fields := parseField(arg)
-				request.Headers[fields[0]] = strings.TrimSpace(fields[1])
-				state = ""
-			case userAgent:
-				request.Headers["User-Agent"] = arg
-				state = ""
-			case data:
-				if request.Method == "GET" || request.Method == head {
-					request.Method = "POST"
-				}
+			continue
+		}
 
-				if !hasContentType(*request) {
-					request.Headers["Content-Type"] = "application/x-www-form-urlencoded"
-				}
+		if long, ok := flagAliases[flag]; ok {
+			flag = long
+		}
 
-				if len(request.Body) == 0 {
-					request.Body = arg
-				} else {
-					request.Body = request.Body + "&" + arg
-				}
+		if valueFlags[flag] && !hasValue {
+			if i+1 >= len(args) {
+				p.warn(arg, "the value is missing")
 
-				state = ""
-			case user:
-				request.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(arg))
-				state = ""
-			case method:
-				request.Method = arg
-				state = ""
-			case cookie:
-				request.Headers["Cookie"] = arg
-				state = ""
-			default:
-				fmt.Println("default: ", state)
+				continue
 			}
+
+			i++
+			value = args[i]
 		}
 
+		p.apply(flag, value)
 	}
 
-	// format json body
-	if value, ok := request.Headers["Content-Type"]; ok && value == "application/json" {
-		decoder := json.NewDecoder(strings.NewReader(request.Body))
-		jsonData := make(map[string]interface{})
+	if err = p.finish(); err != nil {
+		return nil, p.warnings, err
+	}
 
-		if err = decoder.Decode(&jsonData); err == nil {
-			buffer := &bytes.Buffer{}
-			encoder := json.NewEncoder(buffer)
-			encoder.SetEscapeHTML(false)
+	return p.request, p.warnings, nil
+}
 
-			if err = encoder.Encode(jsonData); err == nil {
-				request.Body = strings.ReplaceAll(buffer.String(), "\n", "")
-			}
+type parser struct {
+	request        *Request
+	warnings       []Warning
+	explicitMethod string
+	data           []string
+	form           []formField
+	get            bool
+	head           bool
+	cookies        []string
+	// Часть --data* задана файлами, которые не читаются: метод остается POST, как у curl
+	dataFiles bool
+}
+
+type formField struct {
+	name  string
+	value string
+}
+
+func (p *parser) warn(flag string, message string) {
+	p.warnings = append(p.warnings, Warning{Flag: flag, Message: message})
+}
+
+//nolint:gocyclo
+func (p *parser) apply(flag string, value string) {
+	if long, ok := flagAliases[flag]; ok {
+		flag = long
+	}
+
+	switch flag {
+	case "--url":
+		p.setURL(value)
+	case "--request":
+		p.explicitMethod = strings.ToUpper(value)
+	case "--header":
+		p.setHeader(value)
+	case "--user-agent":
+		p.request.Headers["User-Agent"] = value
+	case "--referer":
+		p.request.Headers["Referer"] = value
+	case "--user":
+		p.request.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(value))
+	case "--oauth2-bearer":
+		p.request.Headers["Authorization"] = "Bearer " + value
+	case "--cookie":
+		if !strings.Contains(value, "=") {
+			p.warn(flag, fmt.Sprintf("cookie file '%v' is not read", value))
+
+			return
+		}
+
+		p.cookies = append(p.cookies, value)
+	case "--data", "--data-ascii", "--data-binary":
+		if strings.HasPrefix(value, "@") {
+			p.warn(flag, fmt.Sprintf("data file '%v' is not read", value[1:]))
+			p.dataFiles = true
+
+			return
 		}
+
+		if flag != "--data-binary" {
+			value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+		}
+
+		p.data = append(p.data, value)
+	case "--data-raw":
+		p.data = append(p.data, value)
+	case "--data-urlencode":
+		encoded, ok := encodeData(value)
+		if !ok {
+			p.warn(flag, fmt.Sprintf("data file of '%v' is not read", value))
+			p.dataFiles = true
+
+			return
+		}
+
+		p.data = append(p.data, encoded)
+	case "--json":
+		if strings.HasPrefix(value, "@") {
+			p.warn(flag, fmt.Sprintf("data file '%v' is not read", value[1:]))
+			p.dataFiles = true
+
+			return
+		}
+
+		p.data = append(p.data, value)
+		p.setDefaultHeader(contentType, jsonContentType)
+		p.setDefaultHeader("Accept", jsonContentType)
+	case "--form", "--form-string":
+		p.addFormField(flag, value)
+	case "--get":
+		p.get = true
+	case "--head":
+		p.head = true
+	case "--compressed":
+		p.setDefaultHeader("Accept-Encoding", compressEncoding)
+	default:
+		switch {
+		case ignoredFlags[flag]:
+		case valueFlags[flag]:
+			p.warn(flag, fmt.Sprintf("the flag is not supported, '%v' is ignored", value))
+		default:
+			p.warn(flag, "the flag is not supported")
+		}
+	}
+}
+
+// setURL URL без схемы curl отправляет по http, параметры запроса отделяются без декодирования
+func (p *parser) setURL(rawURL string) {
+	if p.request.URL != "" {
+		p.warn(rawURL, "only the first URL is used")
+
+		return
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+
+	if i := strings.Index(rawURL, "#"); i >= 0 {
+		rawURL = rawURL[:i]
 	}
 
-	return request, true
+	if i := strings.Index(rawURL, "?"); i >= 0 {
+		p.request.QueryParams = append(p.request.QueryParams, splitQuery(rawURL[i+1:])...)
+		rawURL = rawURL[:i]
+	}
+
+	p.request.URL = rawURL
 }
 
-func rewrite(args []string) []string {
-	res := make([]string, 0)
+// setHeader "Name: value"; "Name;" - пустой заголовок; "Name:" - удаление заголовка
+func (p *parser) setHeader(value string) {
+	if strings.HasPrefix(value, "@") {
+		p.warn("--header", fmt.Sprintf("header file '%v' is not read", value[1:]))
+
+		return
+	}
 
-	for _, arg := range args {
-		arg = strings.TrimSpace(arg)
+	name, headerValue, found := strings.Cut(value, ":")
+	if !found {
+		if name, ok := strings.CutSuffix(strings.TrimSpace(value), ";"); ok {
+			p.request.Headers[name] = ""
 
-		if arg == "\n" {
-			continue
+			return
 		}
 
-		if strings.Contains(arg, "\n") {
-			arg = strings.ReplaceAll(arg, "\n", "")
+		p.warn("--header", fmt.Sprintf("header '%v' has no value", value))
+
+		return
+	}
+
+	name, headerValue = strings.TrimSpace(name), strings.TrimSpace(headerValue)
+	if headerValue == "" {
+		p.deleteHeader(name)
+
+		return
+	}
+
+	p.deleteHeader(name)
+	p.request.Headers[name] = headerValue
+}
+
+func (p *parser) deleteHeader(name string) {
+	for key := range p.request.Headers {
+		if strings.EqualFold(key, name) {
+			delete(p.request.Headers, key)
+		}
+	}
+}
+
+func (p *parser) header(name string) (string, bool) {
+	for key, value := range p.request.Headers {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func (p *parser) setDefaultHeader(name string, value string) {
+	if _, ok := p.header(name); !ok {
+		p.request.Headers[name] = value
+	}
+}
+
+// addFormField поле multipart: name=value, файлы(name=@file, name=<file) не читаются
+func (p *parser) addFormField(flag string, value string) {
+	name, fieldValue, found := strings.Cut(value, "=")
+	if !found {
+		p.warn(flag, fmt.Sprintf("form field '%v' has no value", value))
+
+		return
+	}
+
+	if flag == "--form" {
+		if strings.HasPrefix(fieldValue, "@") || strings.HasPrefix(fieldValue, "<") {
+			p.warn(flag, fmt.Sprintf("form file '%v' of field '%v' is not read", fieldValue[1:], name))
+
+			return
+		}
+
+		// ;type=..., ;filename=... и другие атрибуты поля не переносятся
+		if i := strings.Index(fieldValue, ";"); i >= 0 && !strings.HasPrefix(fieldValue, "\"") {
+			p.warn(flag, fmt.Sprintf("attributes '%v' of field '%v' are ignored", fieldValue[i+1:], name))
+			fieldValue = fieldValue[:i]
+		}
+
+		fieldValue = strings.Trim(fieldValue, "\"")
+	}
+
+	p.form = append(p.form, formField{name: name, value: fieldValue})
+}
+
+func (p *parser) finish() error {
+	request := p.request
+	if request.URL == "" {
+		return errors.New("Error parse cURL: URL is not found")
+	}
+
+	if len(p.cookies) > 0 {
+		request.Headers["Cookie"] = strings.Join(p.cookies, "; ")
+	}
+
+	switch {
+	case len(p.form) > 0 && len(p.data) > 0:
+		p.warn("--form", "the form is ignored: it can not be combined with data")
+		p.setBody()
+	case len(p.form) > 0:
+		if err := p.setForm(); err != nil {
+			return err
+		}
+	case len(p.data) > 0:
+		p.setBody()
+	}
+
+	switch {
+	case p.explicitMethod != "":
+		request.Method = p.explicitMethod
+	case p.head:
+		request.Method = head
+	case request.Body != "":
+		request.Method = "POST"
+	case p.dataFiles && !p.get:
+		p.warn("--data", "the request body is empty: the data is read only from files")
+		request.Method = "POST"
+	default:
+		request.Method = "GET"
+	}
+
+	return nil
+}
+
+// setBody тело из --data*: через "&", с -G - параметры запроса
+func (p *parser) setBody() {
+	body := strings.Join(p.data, "&")
+
+	if p.get {
+		p.request.QueryParams = append(p.request.QueryParams, splitQuery(body)...)
+
+		return
+	}
+
+	p.setDefaultHeader(contentType, formContentType)
+
+	// JSON тело без форматирования
+	if value, _ := p.header(contentType); strings.Contains(value, "json") {
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(body)); err == nil {
+			body = compacted.String()
+		}
+	}
+
+	p.request.Body = body
+}
+
+func (p *parser) setForm() error {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := writer.SetBoundary(multipartBoundary); err != nil {
+		return errors.Wrap(err, "Error parse cURL form")
+	}
+
+	for _, field := range p.form {
+		if err := writer.WriteField(field.name, field.value); err != nil {
+			return errors.Wrap(err, "Error parse cURL form")
 		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "Error parse cURL form")
+	}
+
+	p.deleteHeader(contentType)
+	p.request.Headers[contentType] = writer.FormDataContentType()
+	p.request.Body = body.String()
 
-		// split request method
-		if strings.Index(arg, "-X") == 0 {
-			res = append(res, arg[0:2])
-			res = append(res, arg[2:])
-		} else {
-			res = append(res, arg)
+	return nil
+}
+
+// expandFlag имя флага и значение, слитое с коротким флагом(-XPOST). Пустое имя - набор коротких флагов
+func expandFlag(arg string) (flag string, value string, hasValue bool) {
+	if strings.HasPrefix(arg, "--") || len(arg) == 2 {
+		return arg, "", false
+	}
+
+	short := arg[:2]
+	if long, ok := flagAliases[short]; ok && valueFlags[long] {
+		return short, arg[2:], true
+	}
+
+	for _, c := range arg[1:] {
+		long, ok := flagAliases["-"+string(c)]
+		if !ok || valueFlags[long] {
+			return arg, "", false
 		}
 	}
 
-	return res
+	return "", "", false
 }
 
-func isURL(url string) bool {
-	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
+// encodeData значение --data-urlencode: content, =content, name=content; name@file не поддерживается
+func encodeData(value string) (string, bool) {
+	if i := strings.IndexAny(value, "=@"); i >= 0 {
+		if value[i] == '@' {
+			return "", false
+		}
+
+		if i == 0 {
+			return url.QueryEscape(value[1:]), true
+		}
+
+		return value[:i] + "=" + url.QueryEscape(value[i+1:]), true
+	}
+
+	return url.QueryEscape(value), true
 }
 
-func parseField(arg string) []string {
-	index := strings.Index(arg, ":")
-	if index > 0 {
-		return []string{arg[0:index], arg[index+2:]}
+func splitQuery(query string) []QueryParams {
+	var params []QueryParams
+
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(pair, "=")
+		params = append(params, QueryParams{Key: key, Value: value})
 	}
 
-	return []string{arg, ""}
+	return params
 }
 
-func hasContentType(request Request) bool {
-	if _, ok := request.Headers["Content-Type"]; ok {
-		return true
+// splitArgs разбор команды на аргументы: переносы строк bash(\) и cmd(^), строки $'...' из "Copy as cURL"
+func splitArgs(curl string) ([]string, error) {
+	curl = strings.NewReplacer("\\\r\n", " ", "\\\n", " ", "^\r\n", " ", "^\n", " ").Replace(curl)
+
+	args, err := shellwords.Parse(expandANSIQuotes(strings.TrimSpace(curl)))
+	if err != nil {
+		return nil, errors.Wrap(err, "Error parse cURL")
 	}
 
-	return false
+	return args, nil
+}
+
+// expandANSIQuotes $'...' заменяется строкой в одинарных кавычках с раскрытыми escape-последовательностями
+func expandANSIQuotes(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) || s[i+1] != '\'' || (i > 0 && s[i-1] != ' ' && s[i-1] != '=') {
+			b.WriteByte(s[i])
+
+			continue
+		}
+
+		var value strings.Builder
+
+		j := i + 2
+		for ; j < len(s) && s[j] != '\''; j++ {
+			if s[j] != '\\' || j+1 >= len(s) {
+				value.WriteByte(s[j])
+
+				continue
+			}
+
+			j++
+
+			switch s[j] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			case 'x':
+				if j+2 < len(s) {
+					if code, err := strconv.ParseUint(s[j+1:j+3], 16, 8); err == nil {
+						value.WriteByte(byte(code))
+						j += 2
+
+						continue
+					}
+				}
+
+				value.WriteString("\\x")
+			case 'u':
+				if j+4 < len(s) {
+					if code, err := strconv.ParseUint(s[j+1:j+5], 16, 32); err == nil {
+						value.WriteRune(rune(code))
+						j += 4
+
+						continue
+					}
+				}
+
+				value.WriteString("\\u")
+			case '\\', '\'', '"':
+				value.WriteByte(s[j])
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(s[j])
+			}
+		}
+
+		b.WriteString(quote(value.String()))
+
+		i = j
+	}
+
+	return b.String()
+}
+
+// quote значение в одинарных кавычках bash
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
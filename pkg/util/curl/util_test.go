@@ -0,0 +1,298 @@
+package curl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		curl         string
+		want         *Request
+		wantWarnings []string
+		wantErr      bool
+	}{
+		{
+			name: "get with query",
+			curl: "curl 'https://example.com/api/items?limit=10&sort=desc&flag&a=b=c'",
+			want: &Request{
+				Method: "GET",
+				URL:    "https://example.com/api/items",
+				QueryParams: []QueryParams{
+					{Key: "limit", Value: "10"}, {Key: "sort", Value: "desc"}, {Key: "flag"}, {Key: "a", Value: "b=c"},
+				},
+				Headers: Headers{},
+			},
+		},
+		{
+			name: "url without scheme",
+			curl: "curl example.com/ping#top",
+			want: &Request{Method: "GET", URL: "http://example.com/ping", QueryParams: []QueryParams{}, Headers: Headers{}},
+		},
+		{
+			name: "headers and json data",
+			curl: `curl -X PUT https://example.com/items/1 -H 'Content-Type: application/json' -H 'X-Id:42' ` +
+				`-d '{"name": "item",  "count": 2}'`,
+			want: &Request{
+				Method:      "PUT",
+				URL:         "https://example.com/items/1",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "application/json", "X-Id": "42"},
+				Body:        `{"name":"item","count":2}`,
+			},
+		},
+		{
+			name: "data sets post and form content type",
+			curl: "curl https://example.com/login -d user=a -d 'pass=b'",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/login",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "application/x-www-form-urlencoded"},
+				Body:        "user=a&pass=b",
+			},
+		},
+		{
+			name: "data urlencode",
+			curl: "curl https://example.com/search --data-urlencode 'q=hello world&more' --data-urlencode '=a b' " +
+				"--data-urlencode 'c d'",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/search",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "application/x-www-form-urlencoded"},
+				Body:        "q=hello+world%26more&a+b&c+d",
+			},
+		},
+		{
+			name: "data binary file",
+			curl: "curl https://example.com/upload --data-binary @payload.bin -d @body.json --data-urlencode name@file",
+			// curl отправляет данные из файлов POST`ом, метод не меняется на GET
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/upload",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{},
+			},
+			wantWarnings: []string{"--data-binary", "--data", "--data-urlencode", "--data"},
+		},
+		{
+			name: "data binary keeps newlines",
+			curl: "curl https://example.com/raw --data-binary $'a\\nb' -H 'Content-Type: text/plain'",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/raw",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "text/plain"},
+				Body:        "a\nb",
+			},
+		},
+		{
+			name: "get moves data to query",
+			curl: "curl -G https://example.com/search?x=1 -d q=go --data-urlencode 'tag=a b'",
+			want: &Request{
+				Method:      "GET",
+				URL:         "https://example.com/search",
+				QueryParams: []QueryParams{{Key: "x", Value: "1"}, {Key: "q", Value: "go"}, {Key: "tag", Value: "a+b"}},
+				Headers:     Headers{},
+			},
+		},
+		{
+			name: "multipart form",
+			curl: "curl https://example.com/form -F name=alilo -F 'file=@photo.png' --form-string 'note=@raw'",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/form",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "multipart/form-data; boundary=------------------------alilo"},
+				Body: "--------------------------alilo\r\n" +
+					"Content-Disposition: form-data; name=\"name\"\r\n\r\nalilo\r\n" +
+					"--------------------------alilo\r\n" +
+					"Content-Disposition: form-data; name=\"note\"\r\n\r\n@raw\r\n" +
+					"--------------------------alilo--\r\n",
+			},
+			wantWarnings: []string{"--form"},
+		},
+		{
+			name: "compressed insecure and bundled flags",
+			curl: "curl -sSLk --compressed -XPOST https://example.com/ping",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/ping",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Accept-Encoding": "deflate, gzip, br, zstd"},
+			},
+		},
+		{
+			name: "cookies and cookie file",
+			curl: "curl https://example.com -b 'a=1; b=2' --cookie c=3 -b cookies.txt",
+			want: &Request{
+				Method:      "GET",
+				URL:         "https://example.com",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Cookie": "a=1; b=2; c=3"},
+			},
+			wantWarnings: []string{"--cookie"},
+		},
+		{
+			name: "user and user agent",
+			curl: "curl -u admin:secret -A agent/1.0 -e https://ref.example.com https://example.com -I",
+			want: &Request{
+				Method:      "HEAD",
+				URL:         "https://example.com",
+				QueryParams: []QueryParams{},
+				Headers: Headers{
+					"Authorization": "Basic YWRtaW46c2VjcmV0",
+					"User-Agent":    "agent/1.0",
+					"Referer":       "https://ref.example.com",
+				},
+			},
+		},
+		{
+			name: "json flag",
+			curl: `curl --json '{"a": 1}' https://example.com/api`,
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/api",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"Content-Type": "application/json", "Accept": "application/json"},
+				Body:        `{"a":1}`,
+			},
+		},
+		{
+			name: "browser copy as curl",
+			curl: "curl 'https://example.com/api' \\\n  -H 'accept: */*' \\\n  -H $'x-quote: it\\'s' \\\n" +
+				"  --data-raw $'{\"text\":\"line\\\\nnext\"}' \\\n  --compressed",
+			want: &Request{
+				Method:      "POST",
+				URL:         "https://example.com/api",
+				QueryParams: []QueryParams{},
+				Headers: Headers{
+					"accept":          "*/*",
+					"x-quote":         "it's",
+					"Content-Type":    "application/x-www-form-urlencoded",
+					"Accept-Encoding": "deflate, gzip, br, zstd",
+				},
+				Body: `{"text":"line\nnext"}`,
+			},
+		},
+		{
+			name: "header removal and empty header",
+			curl: "curl https://example.com -H 'X-A: 1' -H 'x-a:' -H 'X-Empty;'",
+			want: &Request{
+				Method:      "GET",
+				URL:         "https://example.com",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{"X-Empty": ""},
+			},
+		},
+		{
+			name: "unsupported flags",
+			curl: "curl --proxy http://proxy:3128 --cert client.pem --unknown https://example.com -o out.txt",
+			want: &Request{
+				Method:      "GET",
+				URL:         "https://example.com",
+				QueryParams: []QueryParams{},
+				Headers:     Headers{},
+			},
+			wantWarnings: []string{"--proxy", "--cert", "--unknown"},
+		},
+		{
+			name:    "not curl",
+			curl:    "wget https://example.com",
+			wantErr: true,
+		},
+		{
+			name:    "no url",
+			curl:    "curl -H 'X-A: 1'",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			curl:    "curl 'https://example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := Parse(tt.curl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %+v, want %+v", got, tt.want)
+			}
+
+			var flags []string
+			for _, warning := range warnings {
+				flags = append(flags, warning.Flag)
+			}
+
+			if !reflect.DeepEqual(flags, tt.wantWarnings) {
+				t.Errorf("Parse() warnings = %+v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestRequest_ToCUrl(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *Request
+		want    string
+	}{
+		{
+			name: "get",
+			request: &Request{
+				Method:      "get",
+				URL:         "https://example.com/items",
+				QueryParams: []QueryParams{{Key: "a", Value: "1"}, {Key: "b"}},
+			},
+			want: "curl 'https://example.com/items?a=1&b'",
+		},
+		{
+			name: "post with quotes",
+			request: &Request{
+				Method:  "post",
+				URL:     "https://example.com/items",
+				Headers: Headers{"X-B": "it's", "Content-Type": "application/json"},
+				Body:    `{"name":"it's"}`,
+			},
+			want: "curl 'https://example.com/items' \\\n  -H 'Content-Type: application/json' \\\n  -H 'X-B: it'\\''s' " +
+				"\\\n  --data-raw '{\"name\":\"it'\\''s\"}'",
+		},
+		{
+			name:    "put without body",
+			request: &Request{Method: "PUT", URL: "https://example.com/items/1"},
+			want:    "curl -X PUT 'https://example.com/items/1'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.request.ToCUrl(); got != tt.want {
+				t.Errorf("ToCUrl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequest_ToCUrl_RoundTrip(t *testing.T) {
+	request := &Request{
+		Method:      "PATCH",
+		URL:         "https://example.com/items/1",
+		QueryParams: []QueryParams{{Key: "q", Value: "a%20b"}},
+		Headers:     Headers{"Content-Type": "application/json", "X-Quote": "'\"$HOME`"},
+		Body:        `{"text":"it's $HOME\n"}`,
+	}
+
+	got, warnings, err := Parse(request.ToCUrl())
+	if err != nil || len(warnings) > 0 {
+		t.Fatalf("Parse() error = %v, warnings = %v", err, warnings)
+	}
+
+	if !reflect.DeepEqual(got, request) {
+		t.Errorf("Parse(ToCUrl()) = %+v, want %+v", got, request)
+	}
+}
@@ -0,0 +1,144 @@
+package har
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/pkg/errors"
+)
+
+// HAR экспорт сетевой активности браузера(HTTP Archive 1.2), используются только поля для построения запросов
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+type Log struct {
+	Entries []Entry `json:"entries"`
+}
+
+type Entry struct {
+	// Тип ресурса по данным браузера(Chrome): xhr, fetch, script, stylesheet, image, ...
+	ResourceType string   `json:"_resourceType"`
+	Request      Request  `json:"request"`
+	Response     Response `json:"response"`
+}
+
+type Request struct {
+	Method   string      `json:"method"`
+	URL      string      `json:"url"`
+	Headers  []NameValue `json:"headers"`
+	PostData *PostData   `json:"postData"`
+}
+
+type Response struct {
+	Status  int     `json:"status"`
+	Content Content `json:"content"`
+}
+
+type Content struct {
+	MimeType string `json:"mimeType"`
+}
+
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+var (
+	apiResourceTypes = []string{"xhr", "fetch"}
+	staticMimeTypes  = []string{"text/css", "text/html", "javascript", "image/", "font/", "video/", "audio/"}
+)
+
+func Parse(data []byte) (*HAR, error) {
+	h := &HAR{}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, errors.Wrap(err, "Error parse HAR")
+	}
+
+	if len(h.Log.Entries) == 0 {
+		return nil, errors.New("HAR contains no entries")
+	}
+
+	return h, nil
+}
+
+// IsStatic ресурс страницы(документ, скрипт, стили, картинка, шрифт), а не вызов API.
+// Без типа ресурса от браузера определяется по типу содержимого ответа
+func (e *Entry) IsStatic() bool {
+	if e.ResourceType != "" {
+		for _, resourceType := range apiResourceTypes {
+			if e.ResourceType == resourceType {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	mimeType := strings.ToLower(e.Response.Content.MimeType)
+	for _, staticMimeType := range staticMimeTypes {
+		if strings.Contains(mimeType, staticMimeType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToRequest запрос записи в формате curl.Request: параметры запроса отделяются от URL без декодирования
+func (e *Entry) ToRequest() *curl.Request {
+	request := &curl.Request{
+		Method:      strings.ToUpper(e.Request.Method),
+		URL:         e.Request.URL,
+		QueryParams: []curl.QueryParams{},
+		Headers:     curl.Headers{},
+	}
+
+	if i := strings.Index(request.URL, "#"); i >= 0 {
+		request.URL = request.URL[:i]
+	}
+
+	if i := strings.Index(request.URL, "?"); i >= 0 {
+		for _, pair := range strings.Split(request.URL[i+1:], "&") {
+			if pair == "" {
+				continue
+			}
+
+			key, value, _ := strings.Cut(pair, "=")
+			request.QueryParams = append(request.QueryParams, curl.QueryParams{Key: key, Value: value})
+		}
+
+		request.URL = request.URL[:i]
+	}
+
+	for _, h := range e.Request.Headers {
+		request.Headers[h.Name] = h.Value
+	}
+
+	if e.Request.PostData != nil {
+		request.Body = e.Request.PostData.Text
+
+		if !hasHeader(request.Headers, "Content-Type") && e.Request.PostData.MimeType != "" {
+			request.Headers["Content-Type"] = e.Request.PostData.MimeType
+		}
+	}
+
+	return request
+}
+
+// hasHeader заголовки HTTP/2 записываются браузером в нижнем регистре
+func hasHeader(headers curl.Headers, name string) bool {
+	for key := range headers {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+
+	return false
+}
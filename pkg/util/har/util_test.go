@@ -0,0 +1,132 @@
+package har
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantEntries int
+		wantErr     bool
+	}{
+		{
+			name:        "entries",
+			data:        `{"log": {"entries": [{"request": {"method": "GET", "url": "https://example.com/"}}]}}`,
+			wantEntries: 1,
+		},
+		{name: "no entries", data: `{"log": {"entries": []}}`, wantErr: true},
+		{name: "not json", data: `<html></html>`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && len(got.Log.Entries) != tt.wantEntries {
+				t.Errorf("Parse() entries = %v, want %v", len(got.Log.Entries), tt.wantEntries)
+			}
+		})
+	}
+}
+
+func TestEntry_IsStatic(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry Entry
+		want  bool
+	}{
+		{name: "xhr", entry: Entry{ResourceType: "xhr"}},
+		{
+			name:  "fetch",
+			entry: Entry{ResourceType: "fetch", Response: Response{Content: Content{MimeType: "text/html"}}},
+		},
+		{name: "script", entry: Entry{ResourceType: "script"}, want: true},
+		{
+			name:  "image by mime type",
+			entry: Entry{Response: Response{Content: Content{MimeType: "image/png"}}},
+			want:  true,
+		},
+		{
+			name:  "javascript by mime type",
+			entry: Entry{Response: Response{Content: Content{MimeType: "application/javascript; charset=utf-8"}}},
+			want:  true,
+		},
+		{name: "json by mime type", entry: Entry{Response: Response{Content: Content{MimeType: "application/json"}}}},
+		{name: "no type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.entry.IsStatic(); got != tt.want {
+				t.Errorf("IsStatic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntry_ToRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry Entry
+		want  *curl.Request
+	}{
+		{
+			name: "query and fragment",
+			entry: Entry{Request: Request{
+				Method:  "get",
+				URL:     "https://example.com/api/items?limit=10&&q=a%20b&flag#top",
+				Headers: []NameValue{{Name: "Accept", Value: "application/json"}},
+			}},
+			want: &curl.Request{
+				Method:      "GET",
+				URL:         "https://example.com/api/items",
+				QueryParams: []curl.QueryParams{{Key: "limit", Value: "10"}, {Key: "q", Value: "a%20b"}, {Key: "flag"}},
+				Headers:     curl.Headers{"Accept": "application/json"},
+			},
+		},
+		{
+			name: "post data sets content type",
+			entry: Entry{Request: Request{
+				Method:   "POST",
+				URL:      "https://example.com/api/items",
+				PostData: &PostData{MimeType: "application/json", Text: `{"name":"item"}`},
+			}},
+			want: &curl.Request{
+				Method:      "POST",
+				URL:         "https://example.com/api/items",
+				QueryParams: []curl.QueryParams{},
+				Headers:     curl.Headers{"Content-Type": "application/json"},
+				Body:        `{"name":"item"}`,
+			},
+		},
+		{
+			name: "content type header is kept",
+			entry: Entry{Request: Request{
+				Method:   "POST",
+				URL:      "https://example.com/api/items",
+				Headers:  []NameValue{{Name: "content-type", Value: "application/json; charset=utf-8"}},
+				PostData: &PostData{MimeType: "application/json", Text: `{}`},
+			}},
+			want: &curl.Request{
+				Method:      "POST",
+				URL:         "https://example.com/api/items",
+				QueryParams: []curl.QueryParams{},
+				Headers:     curl.Headers{"content-type": "application/json; charset=utf-8"},
+				Body:        `{}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.entry.ToRequest(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -127,6 +127,62 @@ func Get(ctx context.Context, uri string) ([]byte, error) {
 	return body, nil
 }
 
+// GetFromHosts Get только с хостов hosts(host или host:port), в т.ч. после редиректов: адрес приходит
+// из запроса пользователя, бэкенд не должен загружать по нему ресурсы внутренней сети
+func GetFromHosts(ctx context.Context, uri string, hosts []string) ([]byte, error) {
+	if !HostAllowed(uri, hosts) {
+		return nil, errors.Errorf("host of '%v' is not allowed", uri)
+	}
+
+	client := &http.Client{
+		Timeout: httpClient.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+
+			if !HostAllowed(req.URL.String(), hosts) {
+				return errors.Errorf("redirect to '%v' is not allowed", req.URL.Redacted())
+			}
+
+			return nil
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, errors.Errorf("request failed with status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// HostAllowed URL http(s) без учетных данных, хост которого есть в hosts(host или host:port)
+func HostAllowed(uri string, hosts []string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || (u.Scheme != SchemeHTTP && u.Scheme != SchemeHTTPS) || u.User != nil || u.Hostname() == "" {
+		return false
+	}
+
+	for _, host := range hosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func Post(ctx context.Context,
 	host string, contentType string, headers map[string]string, rqBody interface{}) (
 	result []byte, err error) {
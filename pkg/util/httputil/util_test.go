@@ -0,0 +1,30 @@
+package httputil
+
+import "testing"
+
+func TestHostAllowed(t *testing.T) {
+	hosts := []string{"specs.example.com", "docs.example.com:8443"}
+
+	tests := []struct {
+		name string
+		uri  string
+		want bool
+	}{
+		{name: "host", uri: "https://specs.example.com/api.yaml", want: true},
+		{name: "host with any port", uri: "http://SPECS.example.com:8080/api.yaml", want: true},
+		{name: "host and port", uri: "https://docs.example.com:8443/api.json", want: true},
+		{name: "other port", uri: "https://docs.example.com/api.json"},
+		{name: "other host", uri: "http://localhost:8080/api.yaml"},
+		{name: "subdomain", uri: "https://evil.specs.example.com/api.yaml"},
+		{name: "user info", uri: "https://specs.example.com@127.0.0.1/api.yaml"},
+		{name: "not http", uri: "file:///etc/passwd"},
+		{name: "relative", uri: "/api.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HostAllowed(tt.uri, hosts); got != tt.want {
+				t.Errorf("HostAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package minio
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	af "github.com/aliexpressru/alilo-backend/pkg/model/ammo"
@@ -34,3 +40,63 @@ func UploadBytes(ctx context.Context, ammo *pb.AmmoFile, finishChan chan bool) (
 
 	return uploadInfo, nil
 }
+
+// PutBytes синхронная загрузка объекта в бакет MINIO_BUCKET
+func PutBytes(ctx context.Context, name string, data []byte, contentType string) error {
+	cfg := config.Get(ctx)
+
+	uploadInfo, err := cfg.MinioClient.PutObject(ctx, cfg.MinioBucket, name, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("put object '%v': %w", name, err)
+	}
+
+	logger.Infof(ctx, "PutBytes uploaded(Size:'%v'; Bucket:'%v'; Key:'%v')",
+		uploadInfo.Size, uploadInfo.Bucket, uploadInfo.Key)
+
+	return nil
+}
+
+// GetBytes объект из бакета MINIO_BUCKET
+func GetBytes(ctx context.Context, name string) ([]byte, error) {
+	cfg := config.Get(ctx)
+
+	object, err := cfg.MinioClient.GetObject(ctx, cfg.MinioBucket, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get object '%v': %w", name, err)
+	}
+	defer func() {
+		if err = object.Close(); err != nil {
+			logger.Warnf(ctx, "GetBytes close object '%v': %v", name, err)
+		}
+	}()
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, fmt.Errorf("read object '%v': %w", name, err)
+	}
+
+	return data, nil
+}
+
+// ObjectName имя объекта бакета MINIO_BUCKET по его URL(S3Url), false - URL указывает не на бакет
+func ObjectName(ctx context.Context, rawURL string) (string, bool) {
+	cfg := config.Get(ctx)
+
+	return objectName(rawURL, cfg.MinioEndpoint, cfg.MinioBucket)
+}
+
+func objectName(rawURL string, endpoint string, bucket string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.User != nil ||
+		endpoint == "" || !strings.EqualFold(u.Host, endpoint) {
+		return "", false
+	}
+
+	name, ok := strings.CutPrefix(u.Path, "/"+bucket+"/")
+	if !ok || name == "" || slices.Contains(strings.Split(name, "/"), "..") {
+		return "", false
+	}
+
+	return name, true
+}
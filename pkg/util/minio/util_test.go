@@ -0,0 +1,36 @@
+package minio
+
+import "testing"
+
+func TestObjectName(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		wantName string
+		wantOk   bool
+	}{
+		{
+			name:     "bucket file",
+			url:      "http://minio:9000/test-data/project/scenario/spec.yaml",
+			wantName: "project/scenario/spec.yaml",
+			wantOk:   true,
+		},
+		{name: "escaped name", url: "https://MINIO:9000/test-data/a%20b.json", wantName: "a b.json", wantOk: true},
+		{name: "other host", url: "http://169.254.169.254/test-data/latest"},
+		{name: "other port", url: "http://minio:9001/test-data/spec.yaml"},
+		{name: "other bucket", url: "http://minio:9000/private/spec.yaml"},
+		{name: "bucket prefix", url: "http://minio:9000/test-data-2/spec.yaml"},
+		{name: "parent directory", url: "http://minio:9000/test-data/../private/spec.yaml"},
+		{name: "user info", url: "http://user@minio:9000/test-data/spec.yaml"},
+		{name: "not http", url: "file://minio:9000/test-data/spec.yaml"},
+		{name: "bucket only", url: "http://minio:9000/test-data/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := objectName(tt.url, "minio:9000", "test-data")
+			if name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("objectName() = %v, %v, want %v, %v", name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
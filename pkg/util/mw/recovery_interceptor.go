@@ -0,0 +1,28 @@
+package mw
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryServerInterceptor recovery unary server interceptor: a handler panic is returned as codes.Internal.
+func RecoveryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoveryHandler))
+}
+
+// RecoveryStreamServerInterceptor recovery stream server interceptor: a handler panic is returned as codes.Internal.
+func RecoveryStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoveryHandler))
+}
+
+func recoveryHandler(ctx context.Context, p interface{}) error {
+	logger.Errorf(ctx, "gRPC handler panic: '%v'\n%s", p, debug.Stack())
+
+	return status.Errorf(codes.Internal, "internal error: %v", p)
+}
@@ -0,0 +1,61 @@
+package mw
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *testServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestRecoveryUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantCode codes.Code
+	}{
+		{
+			name:     "ok",
+			handler:  func(_ context.Context, _ interface{}) (interface{}, error) { return "ok", nil },
+			wantCode: codes.OK,
+		},
+		{
+			name: "error",
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, status.Error(codes.NotFound, "run not found")
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "panic",
+			handler:  func(_ context.Context, _ interface{}) (interface{}, error) { panic("nil map") },
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RecoveryUnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{}, tt.handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("RecoveryUnaryServerInterceptor() code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRecoveryStreamServerInterceptor(t *testing.T) {
+	handler := func(_ interface{}, _ grpc.ServerStream) error { panic("nil map") }
+
+	err := RecoveryStreamServerInterceptor()(nil, &testServerStream{}, &grpc.StreamServerInfo{}, handler)
+	if got := status.Code(err); got != codes.Internal {
+		t.Errorf("RecoveryStreamServerInterceptor() code = %v, want %v", got, codes.Internal)
+	}
+}
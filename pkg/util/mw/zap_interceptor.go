@@ -3,6 +3,7 @@ package mw
 import (
 	"context"
 
+	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"go.uber.org/zap"
@@ -14,7 +15,7 @@ import (
 // LoggingUnaryServerInterceptor logging unary server interceptor.
 func LoggingUnaryServerInterceptor(opts ...grpc_zap.Option) grpc.UnaryServerInterceptor {
 	return grpc_zap.UnaryServerInterceptor(
-		zap.L(),
+		logger.Logger(),
 		append([]grpc_zap.Option{
 			grpc_zap.WithMessageProducer(DefaultMessageProducer),
 		}, opts...)...,
@@ -24,7 +25,7 @@ func LoggingUnaryServerInterceptor(opts ...grpc_zap.Option) grpc.UnaryServerInte
 // LoggingStreamServerInterceptor logging stream server interceptor.
 func LoggingStreamServerInterceptor(opts ...grpc_zap.Option) grpc.StreamServerInterceptor {
 	return grpc_zap.StreamServerInterceptor(
-		zap.L(),
+		logger.Logger(),
 		append([]grpc_zap.Option{
 			grpc_zap.WithMessageProducer(DefaultMessageProducer),
 		}, opts...)...,
@@ -0,0 +1,613 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// maxExampleDepth глубина вложенности примера, построенного по схеме
+const maxExampleDepth = 6
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// Document спецификация OpenAPI 3 или Swagger 2, используются только поля для построения запросов
+type Document struct {
+	Swagger  string              `json:"swagger"`
+	OpenAPI  string              `json:"openapi"`
+	Servers  []Server            `json:"servers"`
+	Paths    map[string]PathItem `json:"paths"`
+	Security []map[string]any    `json:"security"`
+	// OpenAPI 3
+	Components Components `json:"components"`
+	// Swagger 2
+	Host        string                `json:"host"`
+	BasePath    string                `json:"basePath"`
+	Schemes     []string              `json:"schemes"`
+	Definitions map[string]*Schema    `json:"definitions"`
+	Parameters  map[string]*Parameter `json:"parameters"`
+}
+
+type Server struct {
+	URL       string                    `json:"url"`
+	Variables map[string]ServerVariable `json:"variables"`
+}
+
+type ServerVariable struct {
+	Default string `json:"default"`
+}
+
+type Components struct {
+	Schemas       map[string]*Schema      `json:"schemas"`
+	Parameters    map[string]*Parameter   `json:"parameters"`
+	RequestBodies map[string]*RequestBody `json:"requestBodies"`
+	Examples      map[string]*Example     `json:"examples"`
+}
+
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Put        *Operation   `json:"put"`
+	Post       *Operation   `json:"post"`
+	Delete     *Operation   `json:"delete"`
+	Options    *Operation   `json:"options"`
+	Head       *Operation   `json:"head"`
+	Patch      *Operation   `json:"patch"`
+}
+
+type Operation struct {
+	OperationID string       `json:"operationId"`
+	Summary     string       `json:"summary"`
+	Tags        []string     `json:"tags"`
+	Parameters  []*Parameter `json:"parameters"`
+	RequestBody *RequestBody `json:"requestBody"`
+	// nil - действуют требования документа
+	Security *[]map[string]any `json:"security"`
+}
+
+type Parameter struct {
+	Ref      string              `json:"$ref"`
+	Name     string              `json:"name"`
+	In       string              `json:"in"`
+	Required bool                `json:"required"`
+	Schema   *Schema             `json:"schema"`
+	Example  any                 `json:"example"`
+	Examples map[string]*Example `json:"examples"`
+	// Swagger 2: схема параметра не в body задается в самом параметре
+	Type     any     `json:"type"`
+	Format   string  `json:"format"`
+	Default  any     `json:"default"`
+	Enum     []any   `json:"enum"`
+	Items    *Schema `json:"items"`
+	XExample any     `json:"x-example"`
+}
+
+type RequestBody struct {
+	Ref     string               `json:"$ref"`
+	Content map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema   *Schema             `json:"schema"`
+	Example  any                 `json:"example"`
+	Examples map[string]*Example `json:"examples"`
+}
+
+type Example struct {
+	Ref   string `json:"$ref"`
+	Value any    `json:"value"`
+}
+
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       any                `json:"type"`
+	Format     string             `json:"format"`
+	Properties map[string]*Schema `json:"properties"`
+	Items      *Schema            `json:"items"`
+	Example    any                `json:"example"`
+	Examples   []any              `json:"examples"`
+	Default    any                `json:"default"`
+	Enum       []any              `json:"enum"`
+	Minimum    *float64           `json:"minimum"`
+	AllOf      []*Schema          `json:"allOf"`
+	OneOf      []*Schema          `json:"oneOf"`
+	AnyOf      []*Schema          `json:"anyOf"`
+}
+
+// Endpoint операция спецификации в виде запроса с примерами значений параметров и тела
+type Endpoint struct {
+	// Метод и путь спецификации: "GET /users/{id}"
+	Key         string
+	OperationID string
+	Summary     string
+	Tags        []string
+	Path        string
+	Secured     bool
+	Request     *curl.Request
+	// Параметры и тела, для которых нет примера и значение построено по типу
+	Warnings []string
+}
+
+// Parse разбор спецификации в JSON или YAML
+func Parse(data []byte) (*Document, error) {
+	data = bytes.TrimSpace(data)
+
+	if !bytes.HasPrefix(data, []byte("{")) {
+		var raw any
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, errors.Wrap(err, "Error parse OpenAPI YAML")
+		}
+
+		converted, err := json.Marshal(stringKeys(raw))
+		if err != nil {
+			return nil, errors.Wrap(err, "Error parse OpenAPI YAML")
+		}
+
+		data = converted
+	}
+
+	d := &Document{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, errors.Wrap(err, "Error parse OpenAPI")
+	}
+
+	switch {
+	case d.OpenAPI == "" && d.Swagger == "":
+		return nil, errors.New("Error parse OpenAPI: neither 'openapi' nor 'swagger' version is set")
+	case len(d.Paths) == 0:
+		return nil, errors.New("Error parse OpenAPI: the specification contains no paths")
+	}
+
+	return d, nil
+}
+
+// BaseURL адрес сервиса: первый из servers(OpenAPI 3) или schemes, host и basePath(Swagger 2)
+func (d *Document) BaseURL() string {
+	if d.Swagger != "" {
+		if d.Host == "" {
+			return ""
+		}
+
+		scheme := "https"
+		if len(d.Schemes) > 0 {
+			scheme = d.Schemes[0]
+		}
+
+		return scheme + "://" + d.Host + strings.TrimSuffix(d.BasePath, "/")
+	}
+
+	if len(d.Servers) == 0 {
+		return ""
+	}
+
+	baseURL := d.Servers[0].URL
+	for name, variable := range d.Servers[0].Variables {
+		baseURL = strings.ReplaceAll(baseURL, "{"+name+"}", variable.Default)
+	}
+
+	return strings.TrimSuffix(baseURL, "/")
+}
+
+// Endpoints операции спецификации, отсортированные по пути и методу, URL запросов - baseURL + путь
+func (d *Document) Endpoints(baseURL string) []*Endpoint {
+	paths := make([]string, 0, len(d.Paths))
+	for path := range d.Paths {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	var endpoints []*Endpoint
+
+	for _, path := range paths {
+		item := d.Paths[path]
+
+		for _, method := range methods {
+			operation := item.operation(method)
+			if operation == nil {
+				continue
+			}
+
+			endpoints = append(endpoints, d.endpoint(strings.TrimSuffix(baseURL, "/"), path, method, &item, operation))
+		}
+	}
+
+	return endpoints
+}
+
+func (p *PathItem) operation(method string) *Operation {
+	switch method {
+	case "get":
+		return p.Get
+	case "put":
+		return p.Put
+	case "post":
+		return p.Post
+	case "delete":
+		return p.Delete
+	case "options":
+		return p.Options
+	case "head":
+		return p.Head
+	case "patch":
+		return p.Patch
+	}
+
+	return nil
+}
+
+func (d *Document) endpoint(baseURL, path, method string, item *PathItem, operation *Operation) *Endpoint {
+	endpoint := &Endpoint{
+		Key:         strings.ToUpper(method) + " " + path,
+		OperationID: operation.OperationID,
+		Summary:     operation.Summary,
+		Tags:        operation.Tags,
+		Path:        path,
+		Secured:     len(d.Security) > 0,
+		Request: &curl.Request{
+			Method:      strings.ToUpper(method),
+			QueryParams: []curl.QueryParams{},
+			Headers:     curl.Headers{},
+		},
+	}
+
+	if operation.Security != nil {
+		endpoint.Secured = len(*operation.Security) > 0
+	}
+
+	resolvedPath := path
+
+	// параметры операции переопределяют параметры пути с тем же именем и расположением
+	parameters := make(map[string]*Parameter)
+
+	var order []string
+
+	for _, parameter := range append(append([]*Parameter{}, item.Parameters...), operation.Parameters...) {
+		parameter = d.resolveParameter(parameter)
+		if parameter == nil {
+			continue
+		}
+
+		key := parameter.In + ":" + parameter.Name
+		if _, ok := parameters[key]; !ok {
+			order = append(order, key)
+		}
+
+		parameters[key] = parameter
+	}
+
+	for _, key := range order {
+		parameter := parameters[key]
+
+		switch parameter.In {
+		case "path":
+			value := d.parameterValue(endpoint, parameter)
+			resolvedPath = strings.ReplaceAll(resolvedPath, "{"+parameter.Name+"}", url.PathEscape(value))
+		case "query":
+			// необязательные параметры без примера не добавляются
+			if !parameter.Required && parameter.example() == nil {
+				continue
+			}
+
+			endpoint.Request.QueryParams = append(endpoint.Request.QueryParams, curl.QueryParams{
+				Key:   url.QueryEscape(parameter.Name),
+				Value: url.QueryEscape(d.parameterValue(endpoint, parameter)),
+			})
+		case "header":
+			if !parameter.Required && parameter.example() == nil {
+				continue
+			}
+
+			endpoint.Request.Headers[parameter.Name] = d.parameterValue(endpoint, parameter)
+		case "body":
+			d.setBody(endpoint, "application/json", parameter.Example, nil, parameter.Schema)
+		}
+	}
+
+	if operation.RequestBody != nil {
+		d.setRequestBody(endpoint, d.resolveRequestBody(operation.RequestBody))
+	}
+
+	endpoint.Request.URL = baseURL + resolvedPath
+
+	return endpoint
+}
+
+func (d *Document) setRequestBody(endpoint *Endpoint, requestBody *RequestBody) {
+	if requestBody == nil || len(requestBody.Content) == 0 {
+		return
+	}
+
+	contentType := ""
+
+	for candidate := range requestBody.Content {
+		if strings.Contains(candidate, "json") && (contentType == "" || candidate < contentType) {
+			contentType = candidate
+		}
+	}
+
+	if contentType == "" {
+		for candidate := range requestBody.Content {
+			if contentType == "" || candidate < contentType {
+				contentType = candidate
+			}
+		}
+
+		endpoint.Warnings = append(endpoint.Warnings, fmt.Sprintf("request body '%v' is not JSON", contentType))
+	}
+
+	mediaType := requestBody.Content[contentType]
+	d.setBody(endpoint, contentType, mediaType.Example, mediaType.Examples, mediaType.Schema)
+}
+
+func (d *Document) setBody(endpoint *Endpoint, contentType string, example any, examples map[string]*Example,
+	schema *Schema) {
+	if example == nil {
+		example = d.firstExample(examples)
+	}
+
+	if example == nil {
+		example = d.schemaExample(schema, 0, map[string]bool{})
+		endpoint.Warnings = append(endpoint.Warnings, "request body example is taken from the schema")
+	}
+
+	body, err := json.Marshal(example)
+	if err != nil {
+		endpoint.Warnings = append(endpoint.Warnings, fmt.Sprintf("request body example: %v", err))
+
+		return
+	}
+
+	endpoint.Request.Body = string(body)
+	endpoint.Request.Headers["Content-Type"] = contentType
+}
+
+// parameterValue пример параметра, без примера - значение по типу
+func (d *Document) parameterValue(endpoint *Endpoint, parameter *Parameter) string {
+	value := parameter.example()
+	if value == nil {
+		value = d.firstExample(parameter.Examples)
+	}
+
+	if value == nil {
+		schema := parameter.Schema
+		if schema == nil {
+			schema = &Schema{Type: parameter.Type, Format: parameter.Format, Enum: parameter.Enum, Items: parameter.Items}
+		}
+
+		value = d.schemaExample(schema, 0, map[string]bool{})
+		endpoint.Warnings = append(endpoint.Warnings,
+			fmt.Sprintf("%v parameter '%v' has no example, '%v' is used", parameter.In, parameter.Name, value))
+	}
+
+	if values, ok := value.([]any); ok {
+		parts := make([]string, 0, len(values))
+		for _, v := range values {
+			parts = append(parts, fmt.Sprint(v))
+		}
+
+		return strings.Join(parts, ",")
+	}
+
+	return fmt.Sprint(value)
+}
+
+func (p *Parameter) example() any {
+	switch {
+	case p.Example != nil:
+		return p.Example
+	case p.XExample != nil:
+		return p.XExample
+	case p.Default != nil:
+		return p.Default
+	case p.Schema != nil && p.Schema.Example != nil:
+		return p.Schema.Example
+	case p.Schema != nil && p.Schema.Default != nil:
+		return p.Schema.Default
+	}
+
+	return nil
+}
+
+func (d *Document) firstExample(examples map[string]*Example) any {
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		example := examples[name]
+		if example != nil && example.Ref != "" {
+			example = d.Components.Examples[refName(example.Ref)]
+		}
+
+		if example != nil && example.Value != nil {
+			return example.Value
+		}
+	}
+
+	return nil
+}
+
+// schemaExample значение по схеме: example, default, первый из enum или значение по типу и формату
+func (d *Document) schemaExample(schema *Schema, depth int, refs map[string]bool) any {
+	if schema == nil || depth > maxExampleDepth {
+		return nil
+	}
+
+	if schema.Ref != "" {
+		// рекурсивные схемы обрываются
+		if refs[schema.Ref] {
+			return nil
+		}
+
+		refs[schema.Ref] = true
+		defer delete(refs, schema.Ref)
+
+		return d.schemaExample(d.resolveSchema(schema.Ref), depth, refs)
+	}
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case len(schema.Examples) > 0:
+		return schema.Examples[0]
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) > 0:
+		merged := map[string]any{}
+
+		for _, part := range schema.AllOf {
+			if value, ok := d.schemaExample(part, depth+1, refs).(map[string]any); ok {
+				for k, v := range value {
+					merged[k] = v
+				}
+			}
+		}
+
+		return merged
+	case len(schema.OneOf) > 0:
+		return d.schemaExample(schema.OneOf[0], depth+1, refs)
+	case len(schema.AnyOf) > 0:
+		return d.schemaExample(schema.AnyOf[0], depth+1, refs)
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		value := map[string]any{}
+
+		for name, property := range schema.Properties {
+			if v := d.schemaExample(property, depth+1, refs); v != nil {
+				value[name] = v
+			}
+		}
+
+		return value
+	case "array":
+		if item := d.schemaExample(schema.Items, depth+1, refs); item != nil {
+			return []any{item}
+		}
+
+		return []any{}
+	case "integer", "number":
+		if schema.Minimum != nil {
+			return *schema.Minimum
+		}
+
+		return 1
+	case "boolean":
+		return true
+	case "string":
+		return stringExample(schema.Format)
+	}
+
+	return nil
+}
+
+func schemaType(schema *Schema) string {
+	switch t := schema.Type.(type) {
+	case string:
+		return t
+	case []any:
+		// OpenAPI 3.1: ["string", "null"]
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+
+	if len(schema.Properties) > 0 {
+		return "object"
+	}
+
+	return ""
+}
+
+func stringExample(format string) string {
+	switch format {
+	case "date":
+		return "2024-01-01"
+	case "date-time":
+		return "2024-01-01T00:00:00Z"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	}
+
+	return "string"
+}
+
+func (d *Document) resolveSchema(ref string) *Schema {
+	if strings.HasPrefix(ref, "#/definitions/") {
+		return d.Definitions[refName(ref)]
+	}
+
+	return d.Components.Schemas[refName(ref)]
+}
+
+func (d *Document) resolveParameter(parameter *Parameter) *Parameter {
+	if parameter == nil || parameter.Ref == "" {
+		return parameter
+	}
+
+	if strings.HasPrefix(parameter.Ref, "#/parameters/") {
+		return d.Parameters[refName(parameter.Ref)]
+	}
+
+	return d.Components.Parameters[refName(parameter.Ref)]
+}
+
+func (d *Document) resolveRequestBody(requestBody *RequestBody) *RequestBody {
+	if requestBody.Ref == "" {
+		return requestBody
+	}
+
+	return d.Components.RequestBodies[refName(requestBody.Ref)]
+}
+
+// refName имя компонента из локальной ссылки "#/components/schemas/User", внешние ссылки не поддерживаются
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// stringKeys ключи YAML(например, коды ответов 200) приводятся к строкам для преобразования в JSON
+func stringKeys(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = stringKeys(item)
+		}
+
+		return v
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = stringKeys(item)
+		}
+
+		return converted
+	case []any:
+		for i, item := range v {
+			v[i] = stringKeys(item)
+		}
+
+		return v
+	}
+
+	return value
+}
@@ -0,0 +1,267 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+)
+
+const petstore = `{
+  "openapi": "3.0.0",
+  "servers": [{"url": "https://{env}.example.com/v1/", "variables": {"env": {"default": "api"}}}],
+  "security": [{"bearer": []}],
+  "paths": {
+    "/pets/{petId}": {
+      "parameters": [{"name": "petId", "in": "path", "required": true, "schema": {"type": "integer"}}],
+      "get": {
+        "operationId": "getPet",
+        "tags": ["pets"],
+        "parameters": [
+          {"name": "fields", "in": "query", "example": "name,tag"},
+          {"name": "debug", "in": "query", "schema": {"type": "boolean"}},
+          {"$ref": "#/components/parameters/RequestID"}
+        ]
+      }
+    },
+    "/pets": {
+      "post": {
+        "operationId": "createPet",
+        "security": [],
+        "requestBody": {"$ref": "#/components/requestBodies/Pet"}
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "RequestID": {"name": "X-Request-Id", "in": "header", "required": true, "example": "42"}
+    },
+    "requestBodies": {
+      "Pet": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}
+    },
+    "schemas": {
+      "Pet": {
+        "type": "object",
+        "properties": {
+          "name": {"type": "string", "example": "Rex"},
+          "born": {"type": "string", "format": "date"},
+          "kind": {"type": "string", "enum": ["dog", "cat"]},
+          "parent": {"$ref": "#/components/schemas/Pet"}
+        }
+      }
+    }
+  }
+}`
+
+const swagger = `
+swagger: "2.0"
+host: petstore.example.com
+basePath: /api/
+schemes: [http]
+paths:
+  /pets:
+    post:
+      parameters:
+        - name: limit
+          in: query
+          required: true
+          type: integer
+        - name: body
+          in: body
+          schema:
+            $ref: "#/definitions/Pet"
+      responses:
+        200:
+          description: ok
+definitions:
+  Pet:
+    properties:
+      tags:
+        type: array
+        items:
+          type: string
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "openapi json", data: petstore},
+		{name: "swagger yaml with integer keys", data: swagger},
+		{name: "no version", data: `{"paths": {"/": {}}}`, wantErr: true},
+		{name: "no paths", data: `openapi: 3.0.0`, wantErr: true},
+		{name: "invalid yaml", data: "openapi: [", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.data)); (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDocument_BaseURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		document *Document
+		want     string
+	}{
+		{
+			name: "server variables",
+			document: &Document{OpenAPI: "3.0.0", Servers: []Server{{
+				URL: "https://{env}.example.com/v1/", Variables: map[string]ServerVariable{"env": {Default: "api"}},
+			}}},
+			want: "https://api.example.com/v1",
+		},
+		{
+			name:     "relative server",
+			document: &Document{OpenAPI: "3.0.0", Servers: []Server{{URL: "/api"}}},
+			want:     "/api",
+		},
+		{name: "no servers", document: &Document{OpenAPI: "3.0.0"}},
+		{
+			name:     "swagger",
+			document: &Document{Swagger: "2.0", Host: "example.com", BasePath: "/api/", Schemes: []string{"http"}},
+			want:     "http://example.com/api",
+		},
+		{
+			name:     "swagger default scheme",
+			document: &Document{Swagger: "2.0", Host: "example.com"},
+			want:     "https://example.com",
+		},
+		{name: "swagger without host", document: &Document{Swagger: "2.0", BasePath: "/api"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.document.BaseURL(); got != tt.want {
+				t.Errorf("BaseURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocument_Endpoints(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want []*Endpoint
+	}{
+		{
+			name: "openapi",
+			spec: petstore,
+			want: []*Endpoint{
+				{
+					Key:         "POST /pets",
+					OperationID: "createPet",
+					Path:        "/pets",
+					Request: &curl.Request{
+						Method:      "POST",
+						URL:         "https://api.example.com/v1/pets",
+						QueryParams: []curl.QueryParams{},
+						Headers:     curl.Headers{"Content-Type": "application/json"},
+						// рекурсивная ссылка на схему обрывается
+						Body: `{"born":"2024-01-01","kind":"dog","name":"Rex"}`,
+					},
+					Warnings: []string{"request body example is taken from the schema"},
+				},
+				{
+					Key:         "GET /pets/{petId}",
+					OperationID: "getPet",
+					Tags:        []string{"pets"},
+					Path:        "/pets/{petId}",
+					Secured:     true,
+					Request: &curl.Request{
+						Method: "GET",
+						URL:    "https://api.example.com/v1/pets/1",
+						// необязательный параметр без примера не добавляется
+						QueryParams: []curl.QueryParams{{Key: "fields", Value: "name%2Ctag"}},
+						Headers:     curl.Headers{"X-Request-Id": "42"},
+					},
+					Warnings: []string{"path parameter 'petId' has no example, '1' is used"},
+				},
+			},
+		},
+		{
+			name: "swagger",
+			spec: swagger,
+			want: []*Endpoint{
+				{
+					Key:  "POST /pets",
+					Path: "/pets",
+					Request: &curl.Request{
+						Method:      "POST",
+						URL:         "http://petstore.example.com/api/pets",
+						QueryParams: []curl.QueryParams{{Key: "limit", Value: "1"}},
+						Headers:     curl.Headers{"Content-Type": "application/json"},
+						Body:        `{"tags":["string"]}`,
+					},
+					Warnings: []string{
+						"query parameter 'limit' has no example, '1' is used",
+						"request body example is taken from the schema",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := Parse([]byte(tt.spec))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got := document.Endpoints(document.BaseURL())
+			if len(got) != len(tt.want) {
+				t.Fatalf("Endpoints() = %v endpoints, want %v", len(got), len(tt.want))
+			}
+
+			for i := range got {
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("Endpoints()[%v] = %+v %+v, want %+v %+v", i, got[i], got[i].Request,
+						tt.want[i], tt.want[i].Request)
+				}
+			}
+		})
+	}
+}
+
+func TestDocument_schemaExample(t *testing.T) {
+	minimum := 10.0
+	d := &Document{Components: Components{Schemas: map[string]*Schema{
+		"Node": {Type: "object", Properties: map[string]*Schema{"next": {Ref: "#/components/schemas/Node"}}},
+	}}}
+
+	tests := []struct {
+		name   string
+		schema *Schema
+		want   any
+	}{
+		{name: "nil", want: nil},
+		{name: "example", schema: &Schema{Type: "string", Example: "value"}, want: "value"},
+		{name: "enum", schema: &Schema{Type: "string", Enum: []any{"a", "b"}}, want: "a"},
+		{name: "minimum", schema: &Schema{Type: "integer", Minimum: &minimum}, want: 10.0},
+		{name: "nullable type", schema: &Schema{Type: []any{"null", "boolean"}}, want: true},
+		{name: "uuid", schema: &Schema{Type: "string", Format: "uuid"}, want: "00000000-0000-0000-0000-000000000000"},
+		{
+			name: "all of",
+			schema: &Schema{AllOf: []*Schema{
+				{Properties: map[string]*Schema{"a": {Type: "integer"}}},
+				{Properties: map[string]*Schema{"b": {Type: "string"}}},
+			}},
+			want: map[string]any{"a": 1, "b": "string"},
+		},
+		{name: "one of", schema: &Schema{OneOf: []*Schema{{Type: "number"}, {Type: "string"}}}, want: 1},
+		{name: "recursive ref", schema: &Schema{Ref: "#/components/schemas/Node"}, want: map[string]any{}},
+		{name: "empty array", schema: &Schema{Type: "array"}, want: []any{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.schemaExample(tt.schema, 0, map[string]bool{}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("schemaExample() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package postman
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+	"github.com/pkg/errors"
+)
+
+// Collection коллекция Postman v2.1(v2.0), используются только поля для построения запросов
+type Collection struct {
+	Info     Info       `json:"info"`
+	Item     []Item     `json:"item"`
+	Variable []Variable `json:"variable"`
+	Auth     *Auth      `json:"auth"`
+}
+
+type Info struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+// Item запрос или папка(item не пустой)
+type Item struct {
+	Name    string   `json:"name"`
+	Item    []Item   `json:"item"`
+	Request *Request `json:"request"`
+	Auth    *Auth    `json:"auth"`
+}
+
+type Request struct {
+	Method string     `json:"method"`
+	URL    URL        `json:"url"`
+	Header []KeyValue `json:"header"`
+	Body   *Body      `json:"body"`
+	Auth   *Auth      `json:"auth"`
+}
+
+type URL struct {
+	Raw   string     `json:"raw"`
+	Query []KeyValue `json:"query"`
+}
+
+type Body struct {
+	Mode       string     `json:"mode"`
+	Raw        string     `json:"raw"`
+	URLEncoded []KeyValue `json:"urlencoded"`
+	GraphQL    *GraphQL   `json:"graphql"`
+}
+
+type GraphQL struct {
+	Query     string `json:"query"`
+	Variables string `json:"variables"`
+}
+
+// Auth авторизация: параметры типа type в поле с тем же именем(bearer, basic, apikey, ...)
+type Auth struct {
+	Type   string     `json:"type"`
+	Bearer []KeyValue `json:"bearer"`
+	Basic  []KeyValue `json:"basic"`
+	APIKey []KeyValue `json:"apikey"`
+	OAuth2 []KeyValue `json:"oauth2"`
+}
+
+type KeyValue struct {
+	Key      string `json:"key"`
+	Value    any    `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
+
+type Variable struct {
+	Key      string `json:"key"`
+	Value    any    `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
+
+// Folder папка коллекции с ее запросами(без вложенных папок), Path пустой - запросы корня коллекции
+type Folder struct {
+	Path     []string
+	Requests []*NamedRequest
+}
+
+type NamedRequest struct {
+	Name    string
+	Request *curl.Request
+	// Неподдерживаемая авторизация, неизвестные и динамические переменные
+	Warnings []string
+}
+
+var variablePattern = regexp.MustCompile(`{{\s*([^{}]+?)\s*}}`)
+
+// UnmarshalJSON запрос может быть задан строкой URL
+func (r *Request) UnmarshalJSON(data []byte) error {
+	var rawURL string
+	if err := json.Unmarshal(data, &rawURL); err == nil {
+		*r = Request{Method: "GET", URL: URL{Raw: rawURL}}
+
+		return nil
+	}
+
+	type request Request
+
+	return json.Unmarshal(data, (*request)(r))
+}
+
+// UnmarshalJSON URL может быть задан строкой
+func (u *URL) UnmarshalJSON(data []byte) error {
+	var rawURL string
+	if err := json.Unmarshal(data, &rawURL); err == nil {
+		*u = URL{Raw: rawURL}
+
+		return nil
+	}
+
+	type url URL
+
+	return json.Unmarshal(data, (*url)(u))
+}
+
+func Parse(data []byte) (*Collection, error) {
+	c := &Collection{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, errors.Wrap(err, "Error parse Postman collection")
+	}
+
+	if !strings.Contains(c.Info.Schema, "collection/v2") {
+		return nil, errors.Errorf("Error parse Postman collection: schema '%v' is not v2.1", c.Info.Schema)
+	}
+
+	if len(c.Item) == 0 {
+		return nil, errors.New("Postman collection contains no requests")
+	}
+
+	return c, nil
+}
+
+// Variables включенные переменные коллекции
+func (c *Collection) Variables() map[string]string {
+	variables := make(map[string]string, len(c.Variable))
+
+	for _, variable := range c.Variable {
+		if !variable.Disabled && variable.Key != "" {
+			variables[variable.Key] = valueString(variable.Value)
+		}
+	}
+
+	return variables
+}
+
+// Folders папки коллекции с запросами в порядке коллекции. В запросах подставлены переменные коллекции
+// и авторизация запроса, ближайшей папки или коллекции
+func (c *Collection) Folders() []*Folder {
+	var folders []*Folder
+
+	c.walk(c.Item, nil, c.Auth, c.Variables(), &folders)
+
+	return folders
+}
+
+func (c *Collection) walk(items []Item, path []string, auth *Auth, variables map[string]string, folders *[]*Folder) {
+	folder := &Folder{Path: path}
+
+	// вложенные папки следуют за родительской
+	var children []*Folder
+
+	for i := range items {
+		item := &items[i]
+
+		itemAuth := auth
+		if item.Auth != nil {
+			itemAuth = item.Auth
+		}
+
+		if item.Request == nil {
+			c.walk(item.Item, append(append([]string{}, path...), item.Name), itemAuth, variables, &children)
+
+			continue
+		}
+
+		if item.Request.Auth != nil {
+			itemAuth = item.Request.Auth
+		}
+
+		folder.Requests = append(folder.Requests, toRequest(item.Name, item.Request, itemAuth, variables))
+	}
+
+	if len(folder.Requests) > 0 {
+		*folders = append(*folders, folder)
+	}
+
+	*folders = append(*folders, children...)
+}
+
+func toRequest(name string, r *Request, auth *Auth, variables map[string]string) *NamedRequest {
+	named := &NamedRequest{Name: name}
+	substitute := func(s string) string {
+		return variablePattern.ReplaceAllStringFunc(s, func(match string) string {
+			key := variablePattern.FindStringSubmatch(match)[1]
+			if value, ok := variables[key]; ok {
+				return value
+			}
+
+			if strings.HasPrefix(key, "$") {
+				named.Warnings = append(named.Warnings, fmt.Sprintf("dynamic variable '%v' is not supported", key))
+			} else {
+				named.Warnings = append(named.Warnings, fmt.Sprintf("variable '%v' is not defined in the collection", key))
+			}
+
+			return match
+		})
+	}
+
+	method := strings.ToUpper(r.Method)
+	if method == "" {
+		method = "GET"
+	}
+
+	request := &curl.Request{
+		Method:      method,
+		QueryParams: []curl.QueryParams{},
+		Headers:     curl.Headers{},
+	}
+
+	rawURL, queries := substitute(r.URL.Raw), r.URL.Query
+	if i := strings.Index(rawURL, "#"); i >= 0 {
+		rawURL = rawURL[:i]
+	}
+
+	if i := strings.Index(rawURL, "?"); i >= 0 {
+		// query задан отдельно с признаком disabled, иначе берется из raw
+		if queries == nil {
+			for _, pair := range strings.Split(rawURL[i+1:], "&") {
+				if key, value, _ := strings.Cut(pair, "="); key != "" {
+					queries = append(queries, KeyValue{Key: key, Value: value})
+				}
+			}
+		}
+
+		rawURL = rawURL[:i]
+	}
+
+	// URL без схемы Postman отправляет по http
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+
+	request.URL = rawURL
+
+	for _, query := range queries {
+		if !query.Disabled {
+			request.QueryParams = append(request.QueryParams,
+				curl.QueryParams{Key: substitute(query.Key), Value: substitute(valueString(query.Value))})
+		}
+	}
+
+	for _, header := range r.Header {
+		if !header.Disabled {
+			request.Headers[substitute(header.Key)] = substitute(valueString(header.Value))
+		}
+	}
+
+	if body, contentType := bodyString(r.Body, substitute); body != "" {
+		request.Body = body
+
+		if !hasHeader(request.Headers, "Content-Type") && contentType != "" {
+			request.Headers["Content-Type"] = contentType
+		}
+	}
+
+	if warning := setAuth(request, auth, substitute); warning != "" {
+		named.Warnings = append(named.Warnings, warning)
+	}
+
+	named.Request = request
+
+	return named
+}
+
+// bodyString тело и его Content-Type, переменные подставляются до проверки JSON
+func bodyString(body *Body, substitute func(string) string) (string, string) {
+	if body == nil {
+		return "", ""
+	}
+
+	switch body.Mode {
+	case "raw":
+		raw := substitute(body.Raw)
+		if json.Valid([]byte(raw)) {
+			return raw, "application/json"
+		}
+
+		return raw, ""
+	case "urlencoded":
+		pairs := make([]string, 0, len(body.URLEncoded))
+		for _, pair := range body.URLEncoded {
+			if !pair.Disabled {
+				pairs = append(pairs, substitute(pair.Key)+"="+substitute(valueString(pair.Value)))
+			}
+		}
+
+		return strings.Join(pairs, "&"), "application/x-www-form-urlencoded"
+	case "graphql":
+		if body.GraphQL == nil {
+			return "", ""
+		}
+
+		graphQL := map[string]any{"query": substitute(body.GraphQL.Query)}
+		if variables := strings.TrimSpace(substitute(body.GraphQL.Variables)); json.Valid([]byte(variables)) {
+			graphQL["variables"] = json.RawMessage(variables)
+		}
+
+		data, err := json.Marshal(graphQL)
+		if err != nil {
+			return "", ""
+		}
+
+		return string(data), "application/json"
+	}
+
+	return "", ""
+}
+
+// setAuth заголовок(или параметр запроса) авторизации. Предупреждение для неподдерживаемого типа и о переносе
+// в запрос учетных данных: в скрипте они хранятся открытым текстом
+func setAuth(request *curl.Request, auth *Auth, substitute func(string) string) (warning string) {
+	if auth == nil {
+		return ""
+	}
+
+	switch auth.Type {
+	case "", "noauth":
+		return ""
+	case "bearer":
+		request.Headers["Authorization"] = "Bearer " + substitute(authValue(auth.Bearer, "token"))
+	case "basic":
+		credentials := substitute(authValue(auth.Basic, "username")) + ":" + substitute(authValue(auth.Basic, "password"))
+		request.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	case "apikey":
+		key, value := substitute(authValue(auth.APIKey, "key")), substitute(authValue(auth.APIKey, "value"))
+		if authValue(auth.APIKey, "in") == "query" {
+			request.QueryParams = append(request.QueryParams, curl.QueryParams{Key: key, Value: value})
+		} else {
+			request.Headers[key] = value
+		}
+	case "oauth2":
+		token := authValue(auth.OAuth2, "accessToken")
+		if token == "" {
+			return "oauth2 authorization without an access token is not supported"
+		}
+
+		request.Headers["Authorization"] = "Bearer " + substitute(token)
+	default:
+		return fmt.Sprintf("authorization '%v' is not supported", auth.Type)
+	}
+
+	return fmt.Sprintf("authorization '%v' adds credentials to the request", auth.Type)
+}
+
+func authValue(values []KeyValue, key string) string {
+	for _, value := range values {
+		if value.Key == key {
+			return valueString(value.Value)
+		}
+	}
+
+	return ""
+}
+
+// valueString значения переменных могут быть не строками
+func valueString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
+
+func hasHeader(headers curl.Headers, name string) bool {
+	for key := range headers {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+
+	return false
+}
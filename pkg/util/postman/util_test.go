@@ -0,0 +1,248 @@
+package postman
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aliexpressru/alilo-backend/pkg/util/curl"
+)
+
+const collection = `{
+  "info": {"name": "Shop", "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+  "variable": [
+    {"key": "host", "value": "api.example.com"},
+    {"key": "limit", "value": 10},
+    {"key": "unused", "value": "x", "disabled": true}
+  ],
+  "auth": {"type": "bearer", "bearer": [{"key": "token", "value": "{{token}}"}]},
+  "item": [
+    {"name": "Ping", "request": "https://{{host}}/ping"},
+    {
+      "name": "Items",
+      "auth": {"type": "noauth"},
+      "item": [
+        {
+          "name": "List items",
+          "request": {
+            "method": "get",
+            "url": {
+              "raw": "{{host}}/items?limit={{limit}}&debug=1#top",
+              "query": [{"key": "limit", "value": "{{limit}}"}, {"key": "debug", "value": "1", "disabled": true}]
+            },
+            "header": [{"key": "X-Request-Id", "value": "{{$guid}}"}, {"key": "X-Off", "value": "1", "disabled": true}]
+          }
+        },
+        {
+          "name": "Create item",
+          "request": {
+            "method": "POST",
+            "url": "https://{{host}}/items",
+            "body": {"mode": "raw", "raw": "{\"limit\": {{limit}}}"},
+            "auth": {
+              "type": "basic",
+              "basic": [{"key": "username", "value": "user"}, {"key": "password", "value": "pass"}]
+            }
+          }
+        },
+        {
+          "name": "Admin",
+          "item": [
+            {
+              "name": "Login",
+              "request": {
+                "method": "POST",
+                "url": "https://{{host}}/login",
+                "body": {
+                  "mode": "urlencoded",
+                  "urlencoded": [{"key": "user", "value": "a"}, {"key": "x", "disabled": true}]
+                },
+                "auth": {
+                  "type": "apikey",
+                  "apikey": [
+                    {"key": "key", "value": "api_key"}, {"key": "value", "value": "k"}, {"key": "in", "value": "query"}
+                  ]
+                }
+              }
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "collection", data: collection},
+		{
+			name:    "v1 schema",
+			data:    `{"info": {"schema": "https://schema.getpostman.com/json/collection/v1.0.0/"}, "item": [{}]}`,
+			wantErr: true,
+		},
+		{name: "no requests", data: `{"info": {"schema": "collection/v2.1.0"}}`, wantErr: true},
+		{name: "not json", data: `name: shop`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.data)); (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCollection_Variables(t *testing.T) {
+	c, err := Parse([]byte(collection))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := map[string]string{"host": "api.example.com", "limit": "10"}
+	if got := c.Variables(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+}
+
+func TestCollection_Folders(t *testing.T) {
+	c, err := Parse([]byte(collection))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []*Folder{
+		{
+			Requests: []*NamedRequest{
+				{
+					Name: "Ping",
+					Request: &curl.Request{
+						Method:      "GET",
+						URL:         "https://api.example.com/ping",
+						QueryParams: []curl.QueryParams{},
+						// переменная token не задана в коллекции и остается как есть
+						Headers: curl.Headers{"Authorization": "Bearer {{token}}"},
+					},
+					Warnings: []string{
+						"variable 'token' is not defined in the collection",
+						"authorization 'bearer' adds credentials to the request",
+					},
+				},
+			},
+		},
+		{
+			Path: []string{"Items"},
+			Requests: []*NamedRequest{
+				{
+					Name: "List items",
+					Request: &curl.Request{
+						Method:      "GET",
+						URL:         "http://api.example.com/items",
+						QueryParams: []curl.QueryParams{{Key: "limit", Value: "10"}},
+						Headers:     curl.Headers{"X-Request-Id": "{{$guid}}"},
+					},
+					Warnings: []string{"dynamic variable '$guid' is not supported"},
+				},
+				{
+					Name: "Create item",
+					Request: &curl.Request{
+						Method:      "POST",
+						URL:         "https://api.example.com/items",
+						QueryParams: []curl.QueryParams{},
+						Headers: curl.Headers{
+							"Content-Type": "application/json", "Authorization": "Basic dXNlcjpwYXNz",
+						},
+						Body: `{"limit": 10}`,
+					},
+					Warnings: []string{"authorization 'basic' adds credentials to the request"},
+				},
+			},
+		},
+		{
+			Path: []string{"Items", "Admin"},
+			Requests: []*NamedRequest{
+				{
+					Name: "Login",
+					Request: &curl.Request{
+						Method:      "POST",
+						URL:         "https://api.example.com/login",
+						QueryParams: []curl.QueryParams{{Key: "api_key", Value: "k"}},
+						Headers:     curl.Headers{"Content-Type": "application/x-www-form-urlencoded"},
+						Body:        "user=a",
+					},
+					Warnings: []string{"authorization 'apikey' adds credentials to the request"},
+				},
+			},
+		},
+	}
+
+	got := c.Folders()
+	if len(got) != len(want) {
+		t.Fatalf("Folders() = %v folders, want %v", len(got), len(want))
+	}
+
+	for i := range got {
+		if !reflect.DeepEqual(got[i].Path, want[i].Path) || len(got[i].Requests) != len(want[i].Requests) {
+			t.Fatalf("Folders()[%v] = %v with %v requests, want %v with %v requests", i,
+				got[i].Path, len(got[i].Requests), want[i].Path, len(want[i].Requests))
+		}
+
+		for j := range got[i].Requests {
+			gotRequest, wantRequest := got[i].Requests[j], want[i].Requests[j]
+			if !reflect.DeepEqual(gotRequest, wantRequest) {
+				t.Errorf("Folders()[%v].Requests[%v] = %+v %+v, want %+v %+v", i, j,
+					gotRequest, gotRequest.Request, wantRequest, wantRequest.Request)
+			}
+		}
+	}
+}
+
+func TestSetAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		auth        *Auth
+		wantHeaders curl.Headers
+		wantWarning string
+	}{
+		{name: "no auth", wantHeaders: curl.Headers{}},
+		{name: "noauth", auth: &Auth{Type: "noauth"}, wantHeaders: curl.Headers{}},
+		{
+			name:        "oauth2",
+			auth:        &Auth{Type: "oauth2", OAuth2: []KeyValue{{Key: "accessToken", Value: "t"}}},
+			wantHeaders: curl.Headers{"Authorization": "Bearer t"},
+			wantWarning: "authorization 'oauth2' adds credentials to the request",
+		},
+		{
+			name:        "oauth2 without token",
+			auth:        &Auth{Type: "oauth2"},
+			wantHeaders: curl.Headers{},
+			wantWarning: "oauth2 authorization without an access token is not supported",
+		},
+		{
+			name: "apikey header",
+			auth: &Auth{
+				Type: "apikey", APIKey: []KeyValue{{Key: "key", Value: "X-Key"}, {Key: "value", Value: "k"}},
+			},
+			wantHeaders: curl.Headers{"X-Key": "k"},
+			wantWarning: "authorization 'apikey' adds credentials to the request",
+		},
+		{
+			name:        "unsupported",
+			auth:        &Auth{Type: "digest"},
+			wantHeaders: curl.Headers{},
+			wantWarning: "authorization 'digest' is not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &curl.Request{Headers: curl.Headers{}}
+
+			warning := setAuth(request, tt.auth, func(s string) string { return s })
+			if warning != tt.wantWarning || !reflect.DeepEqual(request.Headers, tt.wantHeaders) {
+				t.Errorf("setAuth() = %v, %v, want %v, %v", request.Headers, warning, tt.wantHeaders, tt.wantWarning)
+			}
+		})
+	}
+}
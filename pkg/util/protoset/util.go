@@ -0,0 +1,176 @@
+package protoset
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Service сервис gRPC из набора дескрипторов
+type Service struct {
+	// Полное имя: package.Service
+	Name    string
+	Methods []Method
+}
+
+type Method struct {
+	Name            string
+	InputType       string
+	OutputType      string
+	ClientStreaming bool
+	ServerStreaming bool
+}
+
+// Compile набор дескрипторов(protoset) из файла: .proto компилируется(импортировать можно только
+// стандартные google/protobuf/*.proto), иначе файл - сериализованный FileDescriptorSet(protoc --descriptor_set_out
+// --include_imports)
+func Compile(ctx context.Context, fileName string, data []byte) (*descriptorpb.FileDescriptorSet, error) {
+	if !strings.HasSuffix(fileName, ".proto") {
+		set := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(data, set); err != nil {
+			return nil, errors.Wrap(err, "Error parse descriptor set")
+		}
+
+		if len(set.GetFile()) == 0 {
+			return nil, errors.New("Error parse descriptor set: no files")
+		}
+
+		return set, nil
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{fileName: string(data)}),
+		}),
+	}
+
+	files, err := compiler.Compile(ctx, fileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error compile proto")
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	added := make(map[string]bool)
+
+	for _, file := range files {
+		addFile(set, file, added)
+	}
+
+	return set, nil
+}
+
+// addFile файл с зависимостями, зависимости раньше файла
+func addFile(set *descriptorpb.FileDescriptorSet, file protoreflect.FileDescriptor, added map[string]bool) {
+	if added[file.Path()] {
+		return
+	}
+
+	added[file.Path()] = true
+
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		addFile(set, imports.Get(i).FileDescriptor, added)
+	}
+
+	set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+}
+
+func Marshal(set *descriptorpb.FileDescriptorSet) ([]byte, error) {
+	data, err := proto.Marshal(set)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshal descriptor set")
+	}
+
+	return data, nil
+}
+
+// Unmarshal набор дескрипторов, сохраненный Marshal
+func Unmarshal(data []byte) (*protoregistry.Files, error) {
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, errors.Wrap(err, "Error parse descriptor set")
+	}
+
+	return Files(set)
+}
+
+func Files(set *descriptorpb.FileDescriptorSet) (*protoregistry.Files, error) {
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error link descriptor set")
+	}
+
+	return files, nil
+}
+
+// Services сервисы набора дескрипторов в алфавитном порядке
+func Services(files *protoregistry.Files) []Service {
+	var services []Service
+
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		for i := 0; i < file.Services().Len(); i++ {
+			sd := file.Services().Get(i)
+			service := Service{Name: string(sd.FullName())}
+
+			for j := 0; j < sd.Methods().Len(); j++ {
+				md := sd.Methods().Get(j)
+				service.Methods = append(service.Methods, Method{
+					Name:            string(md.Name()),
+					InputType:       string(md.Input().FullName()),
+					OutputType:      string(md.Output().FullName()),
+					ClientStreaming: md.IsStreamingClient(),
+					ServerStreaming: md.IsStreamingServer(),
+				})
+			}
+
+			services = append(services, service)
+		}
+
+		return true
+	})
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+
+	return services
+}
+
+// FindMethod метод сервиса(полное имя package.Service)
+func FindMethod(files *protoregistry.Files, service string, method string) (protoreflect.MethodDescriptor, error) {
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, errors.Errorf("service '%v' is not found", service)
+	}
+
+	sd, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errors.Errorf("'%v' is not a service", service)
+	}
+
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, errors.Errorf("method '%v' is not found in service '%v'", method, service)
+	}
+
+	return md, nil
+}
+
+// ValidateMessage проверка, что JSON - сообщение типа md
+func ValidateMessage(md protoreflect.MessageDescriptor, message string) error {
+	if err := protojson.Unmarshal([]byte(message), dynamicpb.NewMessage(md)); err != nil {
+		return errors.Wrapf(err, "message is not '%v'", md.FullName())
+	}
+
+	return nil
+}
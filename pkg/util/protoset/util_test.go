@@ -0,0 +1,228 @@
+package protoset
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const shopProto = `syntax = "proto3";
+
+package shop.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message GetItemRequest {
+  int64 id = 1;
+  google.protobuf.Timestamp at = 2;
+}
+
+message Item {
+  int64 id = 1;
+  string name = 2;
+}
+
+service ItemService {
+  rpc GetItem(GetItemRequest) returns (Item);
+  rpc WatchItems(GetItemRequest) returns (stream Item);
+}
+
+service CartService {
+  rpc AddItems(stream Item) returns (Item);
+}
+`
+
+func compileShop(t *testing.T) (*descriptorpb.FileDescriptorSet, *protoregistry.Files) {
+	t.Helper()
+
+	set, err := Compile(context.Background(), "shop.proto", []byte(shopProto))
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	files, err := Files(set)
+	if err != nil {
+		t.Fatalf("Files() error = %v", err)
+	}
+
+	return set, files
+}
+
+func TestCompile(t *testing.T) {
+	set, _ := compileShop(t)
+
+	setData, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatalf("proto.Marshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		fileName  string
+		data      []byte
+		wantFiles []string
+		wantErr   bool
+	}{
+		{
+			name:     "proto with standard import",
+			fileName: "shop.proto",
+			data:     []byte(shopProto),
+			// зависимости раньше файла
+			wantFiles: []string{"google/protobuf/timestamp.proto", "shop.proto"},
+		},
+		{
+			name:      "descriptor set",
+			fileName:  "shop.protoset",
+			data:      setData,
+			wantFiles: []string{"google/protobuf/timestamp.proto", "shop.proto"},
+		},
+		{
+			name:     "unknown import",
+			fileName: "order.proto",
+			data:     []byte(`syntax = "proto3"; import "shop.proto";`),
+			wantErr:  true,
+		},
+		{name: "invalid proto", fileName: "shop.proto", data: []byte(`message {`), wantErr: true},
+		{name: "empty descriptor set", fileName: "shop.pb", wantErr: true},
+		{name: "invalid descriptor set", fileName: "shop.pb", data: []byte("shop"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compile(context.Background(), tt.fileName, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var gotFiles []string
+			for _, file := range got.GetFile() {
+				gotFiles = append(gotFiles, file.GetName())
+			}
+
+			if !reflect.DeepEqual(gotFiles, tt.wantFiles) {
+				t.Errorf("Compile() files = %v, want %v", gotFiles, tt.wantFiles)
+			}
+		})
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	set, _ := compileShop(t)
+
+	data, err := Marshal(set)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	files, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if files.NumFiles() != 2 {
+		t.Errorf("Unmarshal() files = %v, want 2", files.NumFiles())
+	}
+
+	if _, err = Unmarshal([]byte("shop")); err == nil {
+		t.Error("Unmarshal() error = nil, want error")
+	}
+}
+
+func TestFiles_missingDependency(t *testing.T) {
+	set, _ := compileShop(t)
+
+	// без google/protobuf/timestamp.proto набор не связывается
+	if _, err := Files(&descriptorpb.FileDescriptorSet{File: set.GetFile()[1:]}); err == nil {
+		t.Error("Files() error = nil, want error")
+	}
+}
+
+func TestServices(t *testing.T) {
+	_, files := compileShop(t)
+
+	want := []Service{
+		{
+			Name: "shop.v1.CartService",
+			Methods: []Method{
+				{Name: "AddItems", InputType: "shop.v1.Item", OutputType: "shop.v1.Item", ClientStreaming: true},
+			},
+		},
+		{
+			Name: "shop.v1.ItemService",
+			Methods: []Method{
+				{Name: "GetItem", InputType: "shop.v1.GetItemRequest", OutputType: "shop.v1.Item"},
+				{
+					Name: "WatchItems", InputType: "shop.v1.GetItemRequest", OutputType: "shop.v1.Item",
+					ServerStreaming: true,
+				},
+			},
+		},
+	}
+	if got := Services(files); !reflect.DeepEqual(got, want) {
+		t.Errorf("Services() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFindMethod(t *testing.T) {
+	_, files := compileShop(t)
+
+	tests := []struct {
+		name    string
+		service string
+		method  string
+		want    string
+		wantErr bool
+	}{
+		{name: "method", service: "shop.v1.ItemService", method: "GetItem", want: "shop.v1.ItemService.GetItem"},
+		{name: "short service name", service: "ItemService", method: "GetItem", wantErr: true},
+		{name: "not a service", service: "shop.v1.Item", method: "GetItem", wantErr: true},
+		{name: "unknown method", service: "shop.v1.ItemService", method: "DeleteItem", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindMethod(files, tt.service, tt.method)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindMethod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && string(got.FullName()) != tt.want {
+				t.Errorf("FindMethod() = %v, want %v", got.FullName(), tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+	_, files := compileShop(t)
+
+	md, err := FindMethod(files, "shop.v1.ItemService", "GetItem")
+	if err != nil {
+		t.Fatalf("FindMethod() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		message string
+		wantErr bool
+	}{
+		{name: "message", message: `{"id": "42", "at": "2024-01-01T00:00:00Z"}`},
+		{name: "empty message", message: `{}`},
+		{name: "unknown field", message: `{"name": "item"}`, wantErr: true},
+		{name: "wrong type", message: `{"id": "item"}`, wantErr: true},
+		{name: "not json", message: `id: 42`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateMessage(md.Input(), tt.message); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,26 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliexpressru/alilo-backend/internal/app/config"
 	"github.com/aliexpressru/alilo-backend/pkg/util/logger"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
 // var logger = zap.S()
 
+const dialTimeout = 10 * time.Second
+
 // keyPass Пока пустой, не используем пароль для расшифровки ключа, может пригодиться если начнем
 var keyPass string
 
-func SendCommandForAll(ctx context.Context, commandType string) {
-	hosts := []string{ // TODO подумать куда вынести список машин
-	}
-	for _, host := range hosts {
-		go SendCommand(ctx, commandType, host)
-	}
-}
-
 func readPubKey(ctx context.Context) ssh.AuthMethod {
 	var key ssh.Signer
 
@@ -59,60 +55,104 @@ func readPubKey(ctx context.Context) ssh.AuthMethod {
 	return ssh.PublicKeys(key)
 }
 
-func SendCommand(ctx context.Context, commandType string, host string) {
+// hostKeyCallback проверка ключа хоста по SSH_KNOWN_HOSTS_FILE, без него подключение не выполняется
+func hostKeyCallback(ctx context.Context) (ssh.HostKeyCallback, error) {
+	knownHostsFile := config.Get(ctx).SSHKnownHostsFile
+	if knownHostsFile == "" {
+		return nil, errors.New("SSH_KNOWN_HOSTS_FILE is not set, host keys can't be verified")
+	}
+
+	callback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading known hosts")
+	}
+
+	return callback, nil
+}
+
+// SendCommand выполнение команды на хосте агента по SSH, используется только как крайняя мера(EmergencyStop),
+// если агент не ответил на Stop
+func SendCommand(ctx context.Context, commandType string, host string) error {
 	logger.Infof(ctx, "Get command:%v; for host:%v;", commandType, host)
 
 	pubKey := readPubKey(ctx)
-	if pubKey != nil {
-		conf := &ssh.ClientConfig{
-			User: "stopper",
-			Auth: []ssh.AuthMethod{
-				pubKey,
-			},
-			//nolint
-			HostKeyCallback: ssh.InsecureIgnoreHostKey(), //TODO переделать на статичный список ключей
-		}
+	if pubKey == nil {
+		return errors.New("SSH key is not provided")
+	}
 
-		client, err := ssh.Dial("tcp", fmt.Sprint(host, ":22"), conf)
-		if err != nil {
-			err = errors.Wrap(err, "SSH dial error")
-			logger.Errorf(ctx, "Error while dial host: '%v' Error: '%v'", host, err)
+	callback, err := hostKeyCallback(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "SSH host key callback error: '%v'", err)
 
-			return
-		}
+		return err
+	}
 
-		session, err := client.NewSession()
-		if err != nil {
-			err = errors.Wrap(err, "SSH dial error")
-			logger.Errorf(ctx, "failed to create ssh session on host: '%v' Error: '%v'", host, err)
+	conf := &ssh.ClientConfig{
+		User: "stopper",
+		Auth: []ssh.AuthMethod{
+			pubKey,
+		},
+		HostKeyCallback: callback,
+		Timeout:         dialTimeout,
+	}
 
-			return
-		}
+	client, err := ssh.Dial("tcp", fmt.Sprint(host, ":22"), conf)
+	if err != nil {
+		err = errors.Wrap(err, "SSH dial error")
+		logger.Errorf(ctx, "Error while dial host: '%v' Error: '%v'", host, err)
 
-		defer func(session *ssh.Session) {
-			errOnClose := session.Close()
-			if errOnClose != nil {
-				logger.Errorf(ctx, "Error while closing session: '%v'", errOnClose)
-			}
-		}(session)
-		logger.Infof(ctx, "Get command type: '%v'", commandType)
-
-		cmd := "sudo ufw default allow outgoing" // RESUME - NOT USED ANYMORE
-		if commandType == "stop" {
-			cmd = "sudo ufw default deny outgoing" // PAUSE - NOT USED ANYMORE
-		}
+		return err
+	}
 
-		if commandType == "kill" {
-			cmd = "sudo killall -s SIGKILL k6 | sudo killall -s SIGKILL k6yaml | sudo killall -s SIGKILL ghz" // STOP
+	defer func(client *ssh.Client) {
+		if errOnClose := client.Close(); errOnClose != nil {
+			logger.Warnf(ctx, "Error while closing client: '%v'", errOnClose)
 		}
+	}(client)
 
-		err = session.Run(cmd)
-		if err != nil {
-			err = errors.Wrap(err, "SSH dial error")
-			logger.Errorf(ctx, "failed to run command over SSH: '%v' Error: '%v'", host, err)
+	session, err := client.NewSession()
+	if err != nil {
+		err = errors.Wrap(err, "SSH session error")
+		logger.Errorf(ctx, "failed to create ssh session on host: '%v' Error: '%v'", host, err)
+
+		return err
+	}
+
+	defer func(session *ssh.Session) {
+		errOnClose := session.Close()
+		if errOnClose != nil {
+			logger.Errorf(ctx, "Error while closing session: '%v'", errOnClose)
 		}
+	}(session)
+	logger.Infof(ctx, "Get command type: '%v'", commandType)
+
+	cmd := "sudo ufw default allow outgoing" // RESUME - NOT USED ANYMORE, see RunService.ResumeScenario
+	if commandType == "stop" {
+		cmd = "sudo ufw default deny outgoing" // PAUSE - NOT USED ANYMORE, see RunService.PauseScenario
+	}
+
+	if commandType == "kill" {
+		cmd = "sudo killall -q -s SIGKILL k6 k6yaml ghz" // STOP
+	}
+
+	logger.Infof(ctx, "Sending to host: '%v' Command : '%v' ", host, cmd)
+
+	err = session.Run(cmd)
 
-		logger.Infof(ctx, "Sending to host: '%v' Command : '%v' ", host, cmd)
-		logger.Infof(ctx, "commandType: '%v'", commandType)
+	// killall завершается с кодом 1, если процессов для остановки нет
+	var exitErr *ssh.ExitError
+	if commandType == "kill" && errors.As(err, &exitErr) && exitErr.ExitStatus() == 1 {
+		logger.Infof(ctx, "No processes to kill on host: '%v'", host)
+
+		return nil
 	}
+
+	if err != nil {
+		err = errors.Wrap(err, "SSH run error")
+		logger.Errorf(ctx, "failed to run command over SSH: '%v' Error: '%v'", host, err)
+
+		return err
+	}
+
+	return nil
 }
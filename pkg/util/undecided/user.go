@@ -0,0 +1,32 @@
+package undecided
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// XIdentificationHeader пользователь, от имени которого пришел запрос
+	XIdentificationHeader = "x-identification"
+)
+
+// UserFromContext пользователь запроса из metadata, пустая строка - пользователь не известен
+func UserFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if users := md.Get(XIdentificationHeader); len(users) > 0 {
+			return users[0]
+		}
+	}
+
+	return ""
+}
+
+// ContextWithUser подмена пользователя в контексте, например для команд, созданных фоновыми задачами
+func ContextWithUser(ctx context.Context, user string) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(XIdentificationHeader, user)
+
+	return metadata.NewIncomingContext(ctx, md)
+}